@@ -52,19 +52,36 @@ func realMain() int {
 	case args[0] == "state":
 		err = showStat()
 	case args[0] == "load":
-		if len(args[1:]) > 0 {
-			err = loadVertex(args[1:])
+		var ids []string
+		if ids, err = selectAlgorithmByFlag("load", args[1:]); err != nil {
+			break
+		}
+		if len(ids) > 0 {
+			err = loadVertex(ids)
 		} else {
 			err = loadPartition()
 		}
 	case args[0] == "start":
+		if _, err = selectAlgorithmByFlag("start", args[1:]); err != nil {
+			break
+		}
 		err = startSuperStep()
+	case args[0] == "pipeline":
+		if len(args) > 1 && args[1] == "start" {
+			err = startPipeline(args[2:])
+		} else {
+			err = showPipeline()
+		}
 	case args[0] == "watch":
 		err = watch()
 	case args[0] == "agg":
 		err = showAggregatedValue()
 	case args[0] == "shutdown":
 		err = sendShutdown()
+	case args[0] == "worker":
+		err = changeWorker(args[1:])
+	case args[0] == "partitions":
+		err = showPartitions()
 	case args[0] == "value":
 		if len(args) > 1 {
 			err = getVertexValue(args[1])
@@ -193,6 +210,22 @@ func printStat(s *command.CoordinatorStatsAck) {
 	sb.WriteString(strconv.FormatUint(s.NrOfActiveVertex, 10))
 	sb.WriteString(" sent=")
 	sb.WriteString(strconv.FormatUint(s.NrOfSentMessages, 10))
+	if s.Algorithm != "" {
+		sb.WriteString(" algo=")
+		sb.WriteString(s.Algorithm)
+	}
+	if s.Stage != "" {
+		sb.WriteString(" stage=")
+		sb.WriteString(s.Stage)
+	}
+	if len(s.Workers) > 0 {
+		sb.WriteString(" workers=")
+		sb.WriteString(strings.Join(s.Workers, ","))
+	}
+	if s.Error != "" {
+		sb.WriteString(" error=")
+		sb.WriteString(strconv.Quote(s.Error))
+	}
 	log.Print(sb.String())
 }
 
@@ -247,6 +280,23 @@ func loadPartition() error {
 
 }
 
+// selectAlgorithmByFlag selects active algorithm if --algo is specified, then returns rest of args
+func selectAlgorithmByFlag(name string, args []string) ([]string, error) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	algo := fs.String("algo", "", "name of algorithm to be activated")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *algo == "" {
+		return fs.Args(), nil
+	}
+	if err := requestAsJSON(http.MethodPost, worker.APIPathSelectAlgorithm, &command.SelectAlgorithm{Name: *algo}, nil); err != nil {
+		return nil, err
+	}
+	log.Printf("algorithm %s is selected\n", *algo)
+	return fs.Args(), nil
+}
+
 func startSuperStep() error {
 	if err := requestAsJSON(http.MethodPost, worker.APIPathStartSuperStep, nil, nil); err != nil {
 		return err
@@ -262,3 +312,130 @@ func getVertexValue(id string) error {
 	log.Println("value = " + ack.Value)
 	return nil
 }
+
+// parseStage parses stage definition formatted as name[:key=value,key=value...]
+func parseStage(def string) (*command.PipelineStage, error) {
+	stage := &command.PipelineStage{Params: make(map[string]string)}
+	kv := strings.SplitN(def, ":", 2)
+	stage.Name = kv[0]
+	if stage.Name == "" {
+		return nil, fmt.Errorf("no stage name: %s", def)
+	}
+	if len(kv) == 1 || kv[1] == "" {
+		return stage, nil
+	}
+	for _, param := range strings.Split(kv[1], ",") {
+		p := strings.SplitN(param, "=", 2)
+		if len(p) != 2 || p[0] == "" {
+			return nil, fmt.Errorf("invalid stage parameter: %s", param)
+		}
+		stage.Params[p[0]] = p[1]
+	}
+	return stage, nil
+}
+
+func startPipeline(defs []string) error {
+	if len(defs) == 0 {
+		return errors.New("no stages specified")
+	}
+	req := &command.StartPipeline{}
+	for _, d := range defs {
+		stage, err := parseStage(d)
+		if err != nil {
+			return err
+		}
+		req.Stages = append(req.Stages, stage)
+	}
+	if err := requestAsJSON(http.MethodPost, worker.APIPathStartPipeline, req, nil); err != nil {
+		return err
+	}
+	if err := watch(); err != nil {
+		return err
+	}
+	return showPipeline()
+}
+
+func showPipeline() error {
+	var ack command.ShowPipelineAck
+	if err := requestAsJSON(http.MethodGet, worker.APIPathShowPipeline, nil, &ack); err != nil {
+		return err
+	}
+	for i, s := range ack.Stages {
+		log.Printf("[%d] %s completed=%v supersteps=%d sent=%d elapsed=%dms\n",
+			i, s.Name, s.Completed, s.SuperSteps, s.NrOfSentMessages, s.ElapsedMillis)
+	}
+	return nil
+}
+
+// changeWorker adds a worker running on host:port, drains a worker shown by state command or rebalances workers
+func changeWorker(args []string) error {
+	if len(args) == 1 && args[0] == "rebalance" {
+		var ack command.RebalanceWorkersAck
+		if err := requestAsJSON(http.MethodPost, worker.APIPathRebalanceWorkers, nil, &ack); err != nil {
+			return err
+		}
+		log.Printf("%d partitions are moved\n", ack.MovedPartitions)
+		return showStat()
+	}
+	if len(args) < 2 {
+		return errors.New("usage: worker add <host:port> | worker drain <worker> | worker rebalance")
+	}
+	switch args[0] {
+	case "add":
+		var ack command.AddWorkerAck
+		if err := requestAsJSON(http.MethodPost, worker.APIPathAddWorker, &command.AddWorker{Remote: true, HostAndPort: args[1]}, &ack); err != nil {
+			return err
+		}
+		log.Printf("worker %s/%s is added\n", ack.WorkerPid.GetAddress(), ack.WorkerPid.GetId())
+	case "drain":
+		if err := requestAsJSON(http.MethodPost, worker.APIPathDrainWorker, &command.DrainWorker{Worker: args[1]}, nil); err != nil {
+			return err
+		}
+		log.Printf("worker %s is drained\n", args[1])
+	default:
+		return fmt.Errorf("worker %s - no such command", args[0])
+	}
+	return showStat()
+}
+
+// showPartitions prints edge cut, local/remote message ratio and stats of each partition
+func showPartitions() error {
+	var ack command.ShowPartitionsAck
+	if err := requestAsJSON(http.MethodGet, worker.APIPathShowPartitions, nil, &ack); err != nil {
+		return err
+	}
+	log.Printf("edge cut=%s (%d/%d edges)\n", percentage(ack.CutEdges, ack.Edges), ack.CutEdges, ack.Edges)
+	log.Printf("messages local=%s remote=%s (%d/%d in %d supersteps)\n",
+		percentage(ack.LocalMessages, ack.LocalMessages+ack.RemoteMessages),
+		percentage(ack.RemoteMessages, ack.LocalMessages+ack.RemoteMessages),
+		ack.LocalMessages, ack.RemoteMessages, ack.SuperSteps)
+	if b := ack.MessageBatches; b != nil && b.Batches > 0 {
+		log.Printf("message batches=%d avg size=%d bytes (%d messages) max size=%d bytes (%d messages) compression ratio=%.2f (%d/%d bytes)\n",
+			b.Batches, b.AvgBatchBytes(), b.AvgBatchMessages(), b.MaxBatchBytes, b.MaxBatchMessages, b.CompressionRatio(), b.EncodedBytes, b.RawBytes)
+	}
+	if f := ack.FlowControl; f != nil {
+		log.Printf("flow control stalled=%d messages for %v, max mailbox vertex=%d partition=%d worker=%d\n",
+			f.StalledMessages, f.StallTime(), f.MaxVertexMailbox, f.MaxPartitionMailbox, f.MaxWorkerMailbox)
+	}
+	if ack.MovedVertices > 0 {
+		log.Printf("%d vertices have been moved to partitions they talk to most\n", ack.MovedVertices)
+	}
+	for _, p := range ack.Partitions {
+		log.Printf("[%d] worker=%s vertices=%d edges=%d cut=%d sent=%d stored=%d bytes edge store=%d bytes\n",
+			p.Stats.PartitionId, p.Worker, p.Stats.Vertices, p.Stats.Edges, p.Stats.CutEdges, p.Stats.MessagesSent, p.Stats.VertexBytes, p.Stats.EdgeBytes)
+	}
+	if len(ack.Traffic) > 0 {
+		log.Println("messages between partitions:")
+	}
+	for _, t := range ack.Traffic {
+		log.Printf("%d -> %d local=%d remote=%d\n", t.SrcPartition, t.DestPartition, t.LocalMessages, t.RemoteMessages)
+	}
+	return nil
+}
+
+func percentage(n, total uint64) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)*100/float64(total))
+}
@@ -4,6 +4,8 @@
 package command
 
 import (
+	bytes "bytes"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	actor "github.com/AsynkronIT/protoactor-go/actor"
 	proto "github.com/gogo/protobuf/proto"
@@ -12,6 +14,7 @@ import (
 	io "io"
 	math "math"
 	reflect "reflect"
+	strconv "strconv"
 	strings "strings"
 )
 
@@ -26,6 +29,27 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type BatchCompression int32
+
+const (
+	BATCH_COMPRESSION_NONE BatchCompression = 0
+	BATCH_COMPRESSION_GZIP BatchCompression = 1
+)
+
+var BatchCompression_name = map[int32]string{
+	0: "BATCH_COMPRESSION_NONE",
+	1: "BATCH_COMPRESSION_GZIP",
+}
+
+var BatchCompression_value = map[string]int32{
+	"BATCH_COMPRESSION_NONE": 0,
+	"BATCH_COMPRESSION_GZIP": 1,
+}
+
+func (BatchCompression) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{0}
+}
+
 type LoadVertex struct {
 	VertexId string `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
 }
@@ -164,8 +188,9 @@ func (m *LoadPartitionVertices) GetNumOfPartitions() uint64 {
 }
 
 type LoadPartitionVerticesAck struct {
-	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
-	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	PartitionId uint64          `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Error       string          `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Stats       *PartitionStats `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (m *LoadPartitionVerticesAck) Reset()      { *m = LoadPartitionVerticesAck{} }
@@ -214,8 +239,16 @@ func (m *LoadPartitionVerticesAck) GetError() string {
 	return ""
 }
 
+func (m *LoadPartitionVerticesAck) GetStats() *PartitionStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
 type LoadPartitionVerticesWorkerAck struct {
-	WorkerPid *actor.PID `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	WorkerPid  *actor.PID        `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	Partitions []*PartitionStats `protobuf:"bytes,2,rep,name=partitions,proto3" json:"partitions,omitempty"`
 }
 
 func (m *LoadPartitionVerticesWorkerAck) Reset()      { *m = LoadPartitionVerticesWorkerAck{} }
@@ -257,6 +290,179 @@ func (m *LoadPartitionVerticesWorkerAck) GetWorkerPid() *actor.PID {
 	return nil
 }
 
+func (m *LoadPartitionVerticesWorkerAck) GetPartitions() []*PartitionStats {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+// PartitionStats is load of a partition used to balance workers
+type PartitionStats struct {
+	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Vertices    uint64 `protobuf:"varint,2,opt,name=vertices,proto3" json:"vertices,omitempty"`
+	Edges       uint64 `protobuf:"varint,3,opt,name=edges,proto3" json:"edges,omitempty"`
+	// messages sent in the last superstep
+	MessagesSent uint64 `protobuf:"varint,4,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	// edges to vertices in other partitions
+	CutEdges uint64 `protobuf:"varint,5,opt,name=cut_edges,json=cutEdges,proto3" json:"cut_edges,omitempty"`
+	// bytes of vertices in the partition store
+	VertexBytes uint64 `protobuf:"varint,6,opt,name=vertex_bytes,json=vertexBytes,proto3" json:"vertex_bytes,omitempty"`
+	// bytes of outgoing edges stored by the partition
+	EdgeBytes uint64 `protobuf:"varint,7,opt,name=edge_bytes,json=edgeBytes,proto3" json:"edge_bytes,omitempty"`
+}
+
+func (m *PartitionStats) Reset()      { *m = PartitionStats{} }
+func (*PartitionStats) ProtoMessage() {}
+func (*PartitionStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{5}
+}
+func (m *PartitionStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStats.Merge(m, src)
+}
+func (m *PartitionStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionStats proto.InternalMessageInfo
+
+func (m *PartitionStats) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetVertices() uint64 {
+	if m != nil {
+		return m.Vertices
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetEdges() uint64 {
+	if m != nil {
+		return m.Edges
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetMessagesSent() uint64 {
+	if m != nil {
+		return m.MessagesSent
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetCutEdges() uint64 {
+	if m != nil {
+		return m.CutEdges
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetVertexBytes() uint64 {
+	if m != nil {
+		return m.VertexBytes
+	}
+	return 0
+}
+
+func (m *PartitionStats) GetEdgeBytes() uint64 {
+	if m != nil {
+		return m.EdgeBytes
+	}
+	return 0
+}
+
+// PartitionTraffic is the number of messages sent from vertices in src_partition to vertices in dest_partition
+type PartitionTraffic struct {
+	SrcPartition  uint64 `protobuf:"varint,1,opt,name=src_partition,json=srcPartition,proto3" json:"src_partition,omitempty"`
+	DestPartition uint64 `protobuf:"varint,2,opt,name=dest_partition,json=destPartition,proto3" json:"dest_partition,omitempty"`
+	// messages delivered in the same worker
+	LocalMessages uint64 `protobuf:"varint,3,opt,name=local_messages,json=localMessages,proto3" json:"local_messages,omitempty"`
+	// messages sent to other workers
+	RemoteMessages uint64 `protobuf:"varint,4,opt,name=remote_messages,json=remoteMessages,proto3" json:"remote_messages,omitempty"`
+}
+
+func (m *PartitionTraffic) Reset()      { *m = PartitionTraffic{} }
+func (*PartitionTraffic) ProtoMessage() {}
+func (*PartitionTraffic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{6}
+}
+func (m *PartitionTraffic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionTraffic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionTraffic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PartitionTraffic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionTraffic.Merge(m, src)
+}
+func (m *PartitionTraffic) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionTraffic) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionTraffic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionTraffic proto.InternalMessageInfo
+
+func (m *PartitionTraffic) GetSrcPartition() uint64 {
+	if m != nil {
+		return m.SrcPartition
+	}
+	return 0
+}
+
+func (m *PartitionTraffic) GetDestPartition() uint64 {
+	if m != nil {
+		return m.DestPartition
+	}
+	return 0
+}
+
+func (m *PartitionTraffic) GetLocalMessages() uint64 {
+	if m != nil {
+		return m.LocalMessages
+	}
+	return 0
+}
+
+func (m *PartitionTraffic) GetRemoteMessages() uint64 {
+	if m != nil {
+		return m.RemoteMessages
+	}
+	return 0
+}
+
 type GetVertexValue struct {
 	VertexId string `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
 }
@@ -264,7 +470,7 @@ type GetVertexValue struct {
 func (m *GetVertexValue) Reset()      { *m = GetVertexValue{} }
 func (*GetVertexValue) ProtoMessage() {}
 func (*GetVertexValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{5}
+	return fileDescriptor_213c0bb044472049, []int{7}
 }
 func (m *GetVertexValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,7 +514,7 @@ type GetVertexValueAck struct {
 func (m *GetVertexValueAck) Reset()      { *m = GetVertexValueAck{} }
 func (*GetVertexValueAck) ProtoMessage() {}
 func (*GetVertexValueAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{6}
+	return fileDescriptor_213c0bb044472049, []int{8}
 }
 func (m *GetVertexValueAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -351,13 +557,15 @@ func (m *GetVertexValueAck) GetValue() string {
 	return ""
 }
 
+// SuperStepBarrier starts a run of supersteps. Later supersteps are started by Compute unless the legacy barrier is enabled
 type SuperStepBarrier struct {
+	SuperStep uint64 `protobuf:"varint,1,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
 }
 
 func (m *SuperStepBarrier) Reset()      { *m = SuperStepBarrier{} }
 func (*SuperStepBarrier) ProtoMessage() {}
 func (*SuperStepBarrier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{7}
+	return fileDescriptor_213c0bb044472049, []int{9}
 }
 func (m *SuperStepBarrier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,6 +594,13 @@ func (m *SuperStepBarrier) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuperStepBarrier proto.InternalMessageInfo
 
+func (m *SuperStepBarrier) GetSuperStep() uint64 {
+	if m != nil {
+		return m.SuperStep
+	}
+	return 0
+}
+
 type SuperStepBarrierAck struct {
 	VertexId string `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
 }
@@ -393,7 +608,7 @@ type SuperStepBarrierAck struct {
 func (m *SuperStepBarrierAck) Reset()      { *m = SuperStepBarrierAck{} }
 func (*SuperStepBarrierAck) ProtoMessage() {}
 func (*SuperStepBarrierAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{8}
+	return fileDescriptor_213c0bb044472049, []int{10}
 }
 func (m *SuperStepBarrierAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +651,7 @@ type SuperStepBarrierPartitionAck struct {
 func (m *SuperStepBarrierPartitionAck) Reset()      { *m = SuperStepBarrierPartitionAck{} }
 func (*SuperStepBarrierPartitionAck) ProtoMessage() {}
 func (*SuperStepBarrierPartitionAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{9}
+	return fileDescriptor_213c0bb044472049, []int{11}
 }
 func (m *SuperStepBarrierPartitionAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,7 +694,7 @@ type SuperStepBarrierWorkerAck struct {
 func (m *SuperStepBarrierWorkerAck) Reset()      { *m = SuperStepBarrierWorkerAck{} }
 func (*SuperStepBarrierWorkerAck) ProtoMessage() {}
 func (*SuperStepBarrierWorkerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{10}
+	return fileDescriptor_213c0bb044472049, []int{12}
 }
 func (m *SuperStepBarrierWorkerAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,12 +733,19 @@ func (m *SuperStepBarrierWorkerAck) GetWorkerPid() *actor.PID {
 type Compute struct {
 	SuperStep        uint64                `protobuf:"varint,1,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
 	AggregatedValues map[string]*types.Any `protobuf:"bytes,2,rep,name=aggregated_values,json=aggregatedValues,proto3" json:"aggregated_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Stage            string                `protobuf:"bytes,3,opt,name=stage,proto3" json:"stage,omitempty"`
+	StageParams      map[string]string     `protobuf:"bytes,4,rep,name=stage_params,json=stageParams,proto3" json:"stage_params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// count messages by source vertex to find vertices to be moved
+	TrackVertexTraffic bool `protobuf:"varint,5,opt,name=track_vertex_traffic,json=trackVertexTraffic,proto3" json:"track_vertex_traffic,omitempty"`
+	// vertices send messages by integer IDs
+	NumericVertexIds bool         `protobuf:"varint,6,opt,name=numeric_vertex_ids,json=numericVertexIds,proto3" json:"numeric_vertex_ids,omitempty"`
+	FlowControl      *FlowControl `protobuf:"bytes,7,opt,name=flow_control,json=flowControl,proto3" json:"flow_control,omitempty"`
 }
 
 func (m *Compute) Reset()      { *m = Compute{} }
 func (*Compute) ProtoMessage() {}
 func (*Compute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{11}
+	return fileDescriptor_213c0bb044472049, []int{13}
 }
 func (m *Compute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -566,16 +788,54 @@ func (m *Compute) GetAggregatedValues() map[string]*types.Any {
 	return nil
 }
 
+func (m *Compute) GetStage() string {
+	if m != nil {
+		return m.Stage
+	}
+	return ""
+}
+
+func (m *Compute) GetStageParams() map[string]string {
+	if m != nil {
+		return m.StageParams
+	}
+	return nil
+}
+
+func (m *Compute) GetTrackVertexTraffic() bool {
+	if m != nil {
+		return m.TrackVertexTraffic
+	}
+	return false
+}
+
+func (m *Compute) GetNumericVertexIds() bool {
+	if m != nil {
+		return m.NumericVertexIds
+	}
+	return false
+}
+
+func (m *Compute) GetFlowControl() *FlowControl {
+	if m != nil {
+		return m.FlowControl
+	}
+	return nil
+}
+
 type ComputeAck struct {
 	VertexId         string                `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
 	Halted           bool                  `protobuf:"varint,2,opt,name=halted,proto3" json:"halted,omitempty"`
 	AggregatedValues map[string]*types.Any `protobuf:"bytes,3,rep,name=aggregated_values,json=aggregatedValues,proto3" json:"aggregated_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MessagesSent     uint64                `protobuf:"varint,4,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	// error returned by Compute() of the vertex
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *ComputeAck) Reset()      { *m = ComputeAck{} }
 func (*ComputeAck) ProtoMessage() {}
 func (*ComputeAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{12}
+	return fileDescriptor_213c0bb044472049, []int{14}
 }
 func (m *ComputeAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,15 +885,36 @@ func (m *ComputeAck) GetAggregatedValues() map[string]*types.Any {
 	return nil
 }
 
+func (m *ComputeAck) GetMessagesSent() uint64 {
+	if m != nil {
+		return m.MessagesSent
+	}
+	return 0
+}
+
+func (m *ComputeAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type ComputePartitionAck struct {
 	PartitionId      uint64                `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
 	AggregatedValues map[string]*types.Any `protobuf:"bytes,2,rep,name=aggregated_values,json=aggregatedValues,proto3" json:"aggregated_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Stats            *PartitionStats       `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`
+	// messages delivered within the partition by source vertex
+	InternalMessages map[string]uint64 `protobuf:"bytes,4,rep,name=internal_messages,json=internalMessages,proto3" json:"internal_messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// the first error returned by Compute() of vertices of the partition
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// messages delivered between vertices of the partition, they don't reach the worker
+	LocalMessages uint64 `protobuf:"varint,6,opt,name=local_messages,json=localMessages,proto3" json:"local_messages,omitempty"`
 }
 
 func (m *ComputePartitionAck) Reset()      { *m = ComputePartitionAck{} }
 func (*ComputePartitionAck) ProtoMessage() {}
 func (*ComputePartitionAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{13}
+	return fileDescriptor_213c0bb044472049, []int{15}
 }
 func (m *ComputePartitionAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -676,15 +957,50 @@ func (m *ComputePartitionAck) GetAggregatedValues() map[string]*types.Any {
 	return nil
 }
 
+func (m *ComputePartitionAck) GetStats() *PartitionStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *ComputePartitionAck) GetInternalMessages() map[string]uint64 {
+	if m != nil {
+		return m.InternalMessages
+	}
+	return nil
+}
+
+func (m *ComputePartitionAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ComputePartitionAck) GetLocalMessages() uint64 {
+	if m != nil {
+		return m.LocalMessages
+	}
+	return 0
+}
+
 type ComputeWorkerAck struct {
 	WorkerPid        *actor.PID            `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
 	AggregatedValues map[string]*types.Any `protobuf:"bytes,2,rep,name=aggregated_values,json=aggregatedValues,proto3" json:"aggregated_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Partitions       []*PartitionStats     `protobuf:"bytes,3,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Traffic          []*PartitionTraffic   `protobuf:"bytes,4,rep,name=traffic,proto3" json:"traffic,omitempty"`
+	VertexMoves      []*VertexMove         `protobuf:"bytes,5,rep,name=vertex_moves,json=vertexMoves,proto3" json:"vertex_moves,omitempty"`
+	MessageBatches   *MessageBatchStats    `protobuf:"bytes,6,opt,name=message_batches,json=messageBatches,proto3" json:"message_batches,omitempty"`
+	FlowControl      *FlowControlStats     `protobuf:"bytes,7,opt,name=flow_control,json=flowControl,proto3" json:"flow_control,omitempty"`
+	// the first error returned by Compute() of vertices of the worker
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 }
 
 func (m *ComputeWorkerAck) Reset()      { *m = ComputeWorkerAck{} }
 func (*ComputeWorkerAck) ProtoMessage() {}
 func (*ComputeWorkerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{14}
+	return fileDescriptor_213c0bb044472049, []int{16}
 }
 func (m *ComputeWorkerAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -727,25 +1043,68 @@ func (m *ComputeWorkerAck) GetAggregatedValues() map[string]*types.Any {
 	return nil
 }
 
-type SuperStepMessage struct {
-	Uuid         string     `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	SuperStep    uint64     `protobuf:"varint,2,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
-	SrcVertexId  string     `protobuf:"bytes,3,opt,name=src_vertex_id,json=srcVertexId,proto3" json:"src_vertex_id,omitempty"`
-	DestVertexId string     `protobuf:"bytes,4,opt,name=dest_vertex_id,json=destVertexId,proto3" json:"dest_vertex_id,omitempty"`
-	Message      *types.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+func (m *ComputeWorkerAck) GetPartitions() []*PartitionStats {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
 }
 
-func (m *SuperStepMessage) Reset()      { *m = SuperStepMessage{} }
-func (*SuperStepMessage) ProtoMessage() {}
-func (*SuperStepMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{15}
+func (m *ComputeWorkerAck) GetTraffic() []*PartitionTraffic {
+	if m != nil {
+		return m.Traffic
+	}
+	return nil
 }
-func (m *SuperStepMessage) XXX_Unmarshal(b []byte) error {
+
+func (m *ComputeWorkerAck) GetVertexMoves() []*VertexMove {
+	if m != nil {
+		return m.VertexMoves
+	}
+	return nil
+}
+
+func (m *ComputeWorkerAck) GetMessageBatches() *MessageBatchStats {
+	if m != nil {
+		return m.MessageBatches
+	}
+	return nil
+}
+
+func (m *ComputeWorkerAck) GetFlowControl() *FlowControlStats {
+	if m != nil {
+		return m.FlowControl
+	}
+	return nil
+}
+
+func (m *ComputeWorkerAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// VertexMove is a vertex which sends more messages to another partition than its own
+type VertexMove struct {
+	VertexId string `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
+	From     uint64 `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To       uint64 `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	// messages to the destination partition minus messages within the current partition
+	Gain uint64 `protobuf:"varint,4,opt,name=gain,proto3" json:"gain,omitempty"`
+}
+
+func (m *VertexMove) Reset()      { *m = VertexMove{} }
+func (*VertexMove) ProtoMessage() {}
+func (*VertexMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{17}
+}
+func (m *VertexMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SuperStepMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *VertexMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SuperStepMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_VertexMove.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -755,23 +1114,95 @@ func (m *SuperStepMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SuperStepMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SuperStepMessage.Merge(m, src)
+func (m *VertexMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VertexMove.Merge(m, src)
 }
-func (m *SuperStepMessage) XXX_Size() int {
+func (m *VertexMove) XXX_Size() int {
 	return m.Size()
 }
-func (m *SuperStepMessage) XXX_DiscardUnknown() {
+func (m *VertexMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_VertexMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VertexMove proto.InternalMessageInfo
+
+func (m *VertexMove) GetVertexId() string {
+	if m != nil {
+		return m.VertexId
+	}
+	return ""
+}
+
+func (m *VertexMove) GetFrom() uint64 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *VertexMove) GetTo() uint64 {
+	if m != nil {
+		return m.To
+	}
+	return 0
+}
+
+func (m *VertexMove) GetGain() uint64 {
+	if m != nil {
+		return m.Gain
+	}
+	return 0
+}
+
+type SuperStepMessage struct {
+	// seq is numbered by the sender in ascending order, it's acked by SuperStepMessageAck
+	Seq          uint64     `protobuf:"varint,8,opt,name=seq,proto3" json:"seq,omitempty"`
+	SuperStep    uint64     `protobuf:"varint,2,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
+	SrcVertexId  string     `protobuf:"bytes,3,opt,name=src_vertex_id,json=srcVertexId,proto3" json:"src_vertex_id,omitempty"`
+	DestVertexId string     `protobuf:"bytes,4,opt,name=dest_vertex_id,json=destVertexId,proto3" json:"dest_vertex_id,omitempty"`
+	Message      *types.Any `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	// set instead of src_vertex_id and dest_vertex_id with numeric vertex IDs
+	SrcNumericId  uint64 `protobuf:"fixed64,6,opt,name=src_numeric_id,json=srcNumericId,proto3" json:"src_numeric_id,omitempty"`
+	DestNumericId uint64 `protobuf:"fixed64,7,opt,name=dest_numeric_id,json=destNumericId,proto3" json:"dest_numeric_id,omitempty"`
+}
+
+func (m *SuperStepMessage) Reset()      { *m = SuperStepMessage{} }
+func (*SuperStepMessage) ProtoMessage() {}
+func (*SuperStepMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{18}
+}
+func (m *SuperStepMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuperStepMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuperStepMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuperStepMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperStepMessage.Merge(m, src)
+}
+func (m *SuperStepMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuperStepMessage) XXX_DiscardUnknown() {
 	xxx_messageInfo_SuperStepMessage.DiscardUnknown(m)
 }
 
 var xxx_messageInfo_SuperStepMessage proto.InternalMessageInfo
 
-func (m *SuperStepMessage) GetUuid() string {
+func (m *SuperStepMessage) GetSeq() uint64 {
 	if m != nil {
-		return m.Uuid
+		return m.Seq
 	}
-	return ""
+	return 0
 }
 
 func (m *SuperStepMessage) GetSuperStep() uint64 {
@@ -802,14 +1233,28 @@ func (m *SuperStepMessage) GetMessage() *types.Any {
 	return nil
 }
 
+func (m *SuperStepMessage) GetSrcNumericId() uint64 {
+	if m != nil {
+		return m.SrcNumericId
+	}
+	return 0
+}
+
+func (m *SuperStepMessage) GetDestNumericId() uint64 {
+	if m != nil {
+		return m.DestNumericId
+	}
+	return 0
+}
+
 type SuperStepMessageAck struct {
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
 func (m *SuperStepMessageAck) Reset()      { *m = SuperStepMessageAck{} }
 func (*SuperStepMessageAck) ProtoMessage() {}
 func (*SuperStepMessageAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{16}
+	return fileDescriptor_213c0bb044472049, []int{19}
 }
 func (m *SuperStepMessageAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -838,28 +1283,35 @@ func (m *SuperStepMessageAck) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SuperStepMessageAck proto.InternalMessageInfo
 
-func (m *SuperStepMessageAck) GetUuid() string {
+func (m *SuperStepMessageAck) GetSeq() uint64 {
 	if m != nil {
-		return m.Uuid
+		return m.Seq
 	}
-	return ""
+	return 0
 }
 
-type InitPartition struct {
-	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+// SuperStepMessageBatch is messages sent to another worker at once.
+// The payload dictionary-encodes type URLs and vertex IDs and carries raw bytes of messages, see message_batch.go
+type SuperStepMessageBatch struct {
+	Seq              uint64           `protobuf:"varint,7,opt,name=seq,proto3" json:"seq,omitempty"`
+	SuperStep        uint64           `protobuf:"varint,2,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
+	Count            uint32           `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	NumericVertexIds bool             `protobuf:"varint,4,opt,name=numeric_vertex_ids,json=numericVertexIds,proto3" json:"numeric_vertex_ids,omitempty"`
+	Compression      BatchCompression `protobuf:"varint,5,opt,name=compression,proto3,enum=BatchCompression" json:"compression,omitempty"`
+	Payload          []byte           `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
 }
 
-func (m *InitPartition) Reset()      { *m = InitPartition{} }
-func (*InitPartition) ProtoMessage() {}
-func (*InitPartition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{17}
+func (m *SuperStepMessageBatch) Reset()      { *m = SuperStepMessageBatch{} }
+func (*SuperStepMessageBatch) ProtoMessage() {}
+func (*SuperStepMessageBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{20}
 }
-func (m *InitPartition) XXX_Unmarshal(b []byte) error {
+func (m *SuperStepMessageBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SuperStepMessageBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitPartition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SuperStepMessageBatch.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -869,83 +1321,75 @@ func (m *InitPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *InitPartition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitPartition.Merge(m, src)
+func (m *SuperStepMessageBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperStepMessageBatch.Merge(m, src)
 }
-func (m *InitPartition) XXX_Size() int {
+func (m *SuperStepMessageBatch) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitPartition) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitPartition.DiscardUnknown(m)
+func (m *SuperStepMessageBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperStepMessageBatch.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitPartition proto.InternalMessageInfo
+var xxx_messageInfo_SuperStepMessageBatch proto.InternalMessageInfo
 
-func (m *InitPartition) GetPartitionId() uint64 {
+func (m *SuperStepMessageBatch) GetSeq() uint64 {
 	if m != nil {
-		return m.PartitionId
+		return m.Seq
 	}
 	return 0
 }
 
-type InitPartitionAck struct {
-	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+func (m *SuperStepMessageBatch) GetSuperStep() uint64 {
+	if m != nil {
+		return m.SuperStep
+	}
+	return 0
 }
 
-func (m *InitPartitionAck) Reset()      { *m = InitPartitionAck{} }
-func (*InitPartitionAck) ProtoMessage() {}
-func (*InitPartitionAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{18}
-}
-func (m *InitPartitionAck) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *InitPartitionAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_InitPartitionAck.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *SuperStepMessageBatch) GetCount() uint32 {
+	if m != nil {
+		return m.Count
 	}
+	return 0
 }
-func (m *InitPartitionAck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitPartitionAck.Merge(m, src)
-}
-func (m *InitPartitionAck) XXX_Size() int {
-	return m.Size()
-}
-func (m *InitPartitionAck) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitPartitionAck.DiscardUnknown(m)
+
+func (m *SuperStepMessageBatch) GetNumericVertexIds() bool {
+	if m != nil {
+		return m.NumericVertexIds
+	}
+	return false
 }
 
-var xxx_messageInfo_InitPartitionAck proto.InternalMessageInfo
+func (m *SuperStepMessageBatch) GetCompression() BatchCompression {
+	if m != nil {
+		return m.Compression
+	}
+	return BATCH_COMPRESSION_NONE
+}
 
-func (m *InitPartitionAck) GetPartitionId() uint64 {
+func (m *SuperStepMessageBatch) GetPayload() []byte {
 	if m != nil {
-		return m.PartitionId
+		return m.Payload
 	}
-	return 0
+	return nil
 }
 
-type ClusterInfo struct {
-	WorkerInfo []*ClusterInfo_WorkerInfo `protobuf:"bytes,1,rep,name=worker_info,json=workerInfo,proto3" json:"worker_info,omitempty"`
+type SuperStepMessageBatchAck struct {
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
-func (*ClusterInfo) ProtoMessage() {}
-func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{19}
+func (m *SuperStepMessageBatchAck) Reset()      { *m = SuperStepMessageBatchAck{} }
+func (*SuperStepMessageBatchAck) ProtoMessage() {}
+func (*SuperStepMessageBatchAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{21}
 }
-func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
+func (m *SuperStepMessageBatchAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SuperStepMessageBatchAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SuperStepMessageBatchAck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -955,41 +1399,49 @@ func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ClusterInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterInfo.Merge(m, src)
+func (m *SuperStepMessageBatchAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuperStepMessageBatchAck.Merge(m, src)
 }
-func (m *ClusterInfo) XXX_Size() int {
+func (m *SuperStepMessageBatchAck) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClusterInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterInfo.DiscardUnknown(m)
+func (m *SuperStepMessageBatchAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuperStepMessageBatchAck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
+var xxx_messageInfo_SuperStepMessageBatchAck proto.InternalMessageInfo
 
-func (m *ClusterInfo) GetWorkerInfo() []*ClusterInfo_WorkerInfo {
+func (m *SuperStepMessageBatchAck) GetSeq() uint64 {
 	if m != nil {
-		return m.WorkerInfo
+		return m.Seq
 	}
-	return nil
+	return 0
 }
 
-type ClusterInfo_WorkerInfo struct {
-	WorkerPid  *actor.PID `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
-	Partitions []uint64   `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+// MessageBatchStats is size of batches sent to other workers
+type MessageBatchStats struct {
+	Batches  uint64 `protobuf:"varint,1,opt,name=batches,proto3" json:"batches,omitempty"`
+	Messages uint64 `protobuf:"varint,2,opt,name=messages,proto3" json:"messages,omitempty"`
+	// size of the messages sent one by one as SuperStepMessage
+	RawBytes uint64 `protobuf:"varint,3,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
+	// size of the batches after encoding and compression
+	EncodedBytes uint64 `protobuf:"varint,4,opt,name=encoded_bytes,json=encodedBytes,proto3" json:"encoded_bytes,omitempty"`
+	// the largest batch in messages and in encoded size
+	MaxBatchMessages uint64 `protobuf:"varint,5,opt,name=max_batch_messages,json=maxBatchMessages,proto3" json:"max_batch_messages,omitempty"`
+	MaxBatchBytes    uint64 `protobuf:"varint,6,opt,name=max_batch_bytes,json=maxBatchBytes,proto3" json:"max_batch_bytes,omitempty"`
 }
 
-func (m *ClusterInfo_WorkerInfo) Reset()      { *m = ClusterInfo_WorkerInfo{} }
-func (*ClusterInfo_WorkerInfo) ProtoMessage() {}
-func (*ClusterInfo_WorkerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{19, 0}
+func (m *MessageBatchStats) Reset()      { *m = MessageBatchStats{} }
+func (*MessageBatchStats) ProtoMessage() {}
+func (*MessageBatchStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{22}
 }
-func (m *ClusterInfo_WorkerInfo) XXX_Unmarshal(b []byte) error {
+func (m *MessageBatchStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterInfo_WorkerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageBatchStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterInfo_WorkerInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MessageBatchStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -999,48 +1451,81 @@ func (m *ClusterInfo_WorkerInfo) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ClusterInfo_WorkerInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterInfo_WorkerInfo.Merge(m, src)
+func (m *MessageBatchStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageBatchStats.Merge(m, src)
 }
-func (m *ClusterInfo_WorkerInfo) XXX_Size() int {
+func (m *MessageBatchStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClusterInfo_WorkerInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterInfo_WorkerInfo.DiscardUnknown(m)
+func (m *MessageBatchStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageBatchStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterInfo_WorkerInfo proto.InternalMessageInfo
+var xxx_messageInfo_MessageBatchStats proto.InternalMessageInfo
 
-func (m *ClusterInfo_WorkerInfo) GetWorkerPid() *actor.PID {
+func (m *MessageBatchStats) GetBatches() uint64 {
 	if m != nil {
-		return m.WorkerPid
+		return m.Batches
 	}
-	return nil
+	return 0
 }
 
-func (m *ClusterInfo_WorkerInfo) GetPartitions() []uint64 {
+func (m *MessageBatchStats) GetMessages() uint64 {
 	if m != nil {
-		return m.Partitions
+		return m.Messages
 	}
-	return nil
+	return 0
 }
 
-type InitWorker struct {
-	Coordinator *actor.PID `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
-	Partitions  []uint64   `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+func (m *MessageBatchStats) GetRawBytes() uint64 {
+	if m != nil {
+		return m.RawBytes
+	}
+	return 0
 }
 
-func (m *InitWorker) Reset()      { *m = InitWorker{} }
-func (*InitWorker) ProtoMessage() {}
-func (*InitWorker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{20}
+func (m *MessageBatchStats) GetEncodedBytes() uint64 {
+	if m != nil {
+		return m.EncodedBytes
+	}
+	return 0
 }
-func (m *InitWorker) XXX_Unmarshal(b []byte) error {
+
+func (m *MessageBatchStats) GetMaxBatchMessages() uint64 {
+	if m != nil {
+		return m.MaxBatchMessages
+	}
+	return 0
+}
+
+func (m *MessageBatchStats) GetMaxBatchBytes() uint64 {
+	if m != nil {
+		return m.MaxBatchBytes
+	}
+	return 0
+}
+
+// FlowControl limits messages in flight, i.e. sent and not acked yet. Messages beyond the limit are held by the sender until acks come back. 0 means no limit
+type FlowControl struct {
+	// messages sent by each vertex
+	VertexInflight uint32 `protobuf:"varint,1,opt,name=vertex_inflight,json=vertexInflight,proto3" json:"vertex_inflight,omitempty"`
+	// messages sent by vertices of each partition
+	PartitionInflight uint32 `protobuf:"varint,2,opt,name=partition_inflight,json=partitionInflight,proto3" json:"partition_inflight,omitempty"`
+	// messages sent by each worker to each of other workers, batches are split into this size
+	WorkerInflight uint32 `protobuf:"varint,3,opt,name=worker_inflight,json=workerInflight,proto3" json:"worker_inflight,omitempty"`
+}
+
+func (m *FlowControl) Reset()      { *m = FlowControl{} }
+func (*FlowControl) ProtoMessage() {}
+func (*FlowControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{23}
+}
+func (m *FlowControl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FlowControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitWorker.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FlowControl.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1050,47 +1535,62 @@ func (m *InitWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *InitWorker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitWorker.Merge(m, src)
+func (m *FlowControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowControl.Merge(m, src)
 }
-func (m *InitWorker) XXX_Size() int {
+func (m *FlowControl) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitWorker) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitWorker.DiscardUnknown(m)
+func (m *FlowControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowControl.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitWorker proto.InternalMessageInfo
+var xxx_messageInfo_FlowControl proto.InternalMessageInfo
 
-func (m *InitWorker) GetCoordinator() *actor.PID {
+func (m *FlowControl) GetVertexInflight() uint32 {
 	if m != nil {
-		return m.Coordinator
+		return m.VertexInflight
 	}
-	return nil
+	return 0
 }
 
-func (m *InitWorker) GetPartitions() []uint64 {
+func (m *FlowControl) GetPartitionInflight() uint32 {
 	if m != nil {
-		return m.Partitions
+		return m.PartitionInflight
 	}
-	return nil
+	return 0
 }
 
-type InitWorkerAck struct {
-	WorkerPid *actor.PID `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+func (m *FlowControl) GetWorkerInflight() uint32 {
+	if m != nil {
+		return m.WorkerInflight
+	}
+	return 0
 }
 
-func (m *InitWorkerAck) Reset()      { *m = InitWorkerAck{} }
-func (*InitWorkerAck) ProtoMessage() {}
-func (*InitWorkerAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{21}
+// FlowControlStats is how long senders held messages by FlowControl, and how deep mailboxes got
+type FlowControlStats struct {
+	// messages held by senders until others are acked
+	StalledMessages uint64 `protobuf:"varint,1,opt,name=stalled_messages,json=stalledMessages,proto3" json:"stalled_messages,omitempty"`
+	// total time senders held messages
+	StallNanos int64 `protobuf:"varint,2,opt,name=stall_nanos,json=stallNanos,proto3" json:"stall_nanos,omitempty"`
+	// maximum number of messages queued in a single mailbox of vertices, partitions and workers of a worker process
+	MaxVertexMailbox    uint64 `protobuf:"varint,3,opt,name=max_vertex_mailbox,json=maxVertexMailbox,proto3" json:"max_vertex_mailbox,omitempty"`
+	MaxPartitionMailbox uint64 `protobuf:"varint,4,opt,name=max_partition_mailbox,json=maxPartitionMailbox,proto3" json:"max_partition_mailbox,omitempty"`
+	MaxWorkerMailbox    uint64 `protobuf:"varint,5,opt,name=max_worker_mailbox,json=maxWorkerMailbox,proto3" json:"max_worker_mailbox,omitempty"`
 }
-func (m *InitWorkerAck) XXX_Unmarshal(b []byte) error {
+
+func (m *FlowControlStats) Reset()      { *m = FlowControlStats{} }
+func (*FlowControlStats) ProtoMessage() {}
+func (*FlowControlStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{24}
+}
+func (m *FlowControlStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InitWorkerAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FlowControlStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InitWorkerAck.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FlowControlStats.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1100,41 +1600,72 @@ func (m *InitWorkerAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *InitWorkerAck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InitWorkerAck.Merge(m, src)
+func (m *FlowControlStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowControlStats.Merge(m, src)
 }
-func (m *InitWorkerAck) XXX_Size() int {
+func (m *FlowControlStats) XXX_Size() int {
 	return m.Size()
 }
-func (m *InitWorkerAck) XXX_DiscardUnknown() {
-	xxx_messageInfo_InitWorkerAck.DiscardUnknown(m)
+func (m *FlowControlStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowControlStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InitWorkerAck proto.InternalMessageInfo
+var xxx_messageInfo_FlowControlStats proto.InternalMessageInfo
 
-func (m *InitWorkerAck) GetWorkerPid() *actor.PID {
+func (m *FlowControlStats) GetStalledMessages() uint64 {
 	if m != nil {
-		return m.WorkerPid
+		return m.StalledMessages
 	}
-	return nil
+	return 0
 }
 
-type NewCluster struct {
-	Workers        []*NewCluster_WorkerReq `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
-	NrOfPartitions uint64                  `protobuf:"varint,2,opt,name=nr_of_partitions,json=nrOfPartitions,proto3" json:"nr_of_partitions,omitempty"`
+func (m *FlowControlStats) GetStallNanos() int64 {
+	if m != nil {
+		return m.StallNanos
+	}
+	return 0
 }
 
-func (m *NewCluster) Reset()      { *m = NewCluster{} }
-func (*NewCluster) ProtoMessage() {}
-func (*NewCluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{22}
+func (m *FlowControlStats) GetMaxVertexMailbox() uint64 {
+	if m != nil {
+		return m.MaxVertexMailbox
+	}
+	return 0
 }
-func (m *NewCluster) XXX_Unmarshal(b []byte) error {
+
+func (m *FlowControlStats) GetMaxPartitionMailbox() uint64 {
+	if m != nil {
+		return m.MaxPartitionMailbox
+	}
+	return 0
+}
+
+func (m *FlowControlStats) GetMaxWorkerMailbox() uint64 {
+	if m != nil {
+		return m.MaxWorkerMailbox
+	}
+	return 0
+}
+
+// MessageSpill spills messages queued by vertices and workers to local disk once they exceed memory_limit bytes in a worker process
+type MessageSpill struct {
+	// 0 keeps all messages in memory
+	MemoryLimit uint64 `protobuf:"varint,1,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
+	// directory of spill files, the temporary directory by default
+	Dir string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+}
+
+func (m *MessageSpill) Reset()      { *m = MessageSpill{} }
+func (*MessageSpill) ProtoMessage() {}
+func (*MessageSpill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{25}
+}
+func (m *MessageSpill) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NewCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MessageSpill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NewCluster.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MessageSpill.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1144,48 +1675,52 @@ func (m *NewCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *NewCluster) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewCluster.Merge(m, src)
+func (m *MessageSpill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageSpill.Merge(m, src)
 }
-func (m *NewCluster) XXX_Size() int {
+func (m *MessageSpill) XXX_Size() int {
 	return m.Size()
 }
-func (m *NewCluster) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewCluster.DiscardUnknown(m)
+func (m *MessageSpill) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageSpill.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NewCluster proto.InternalMessageInfo
+var xxx_messageInfo_MessageSpill proto.InternalMessageInfo
 
-func (m *NewCluster) GetWorkers() []*NewCluster_WorkerReq {
+func (m *MessageSpill) GetMemoryLimit() uint64 {
 	if m != nil {
-		return m.Workers
+		return m.MemoryLimit
 	}
-	return nil
+	return 0
 }
 
-func (m *NewCluster) GetNrOfPartitions() uint64 {
+func (m *MessageSpill) GetDir() string {
 	if m != nil {
-		return m.NrOfPartitions
+		return m.Dir
 	}
-	return 0
+	return ""
 }
 
-type NewCluster_WorkerReq struct {
-	Remote      bool   `protobuf:"varint,1,opt,name=remote,proto3" json:"remote,omitempty"`
-	HostAndPort string `protobuf:"bytes,2,opt,name=host_and_port,json=hostAndPort,proto3" json:"host_and_port,omitempty"`
+// PartitionStore keeps vertices of partitions serialized on local disk, they are paged in to compute
+type PartitionStore struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// directory of store files, the temporary directory by default
+	Dir string `protobuf:"bytes,2,opt,name=dir,proto3" json:"dir,omitempty"`
+	// partitions of a worker are computed in turns so that their stored vertices fit this number of bytes, 0 computes all partitions at once
+	MemoryLimit uint64 `protobuf:"varint,3,opt,name=memory_limit,json=memoryLimit,proto3" json:"memory_limit,omitempty"`
 }
 
-func (m *NewCluster_WorkerReq) Reset()      { *m = NewCluster_WorkerReq{} }
-func (*NewCluster_WorkerReq) ProtoMessage() {}
-func (*NewCluster_WorkerReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{22, 0}
+func (m *PartitionStore) Reset()      { *m = PartitionStore{} }
+func (*PartitionStore) ProtoMessage() {}
+func (*PartitionStore) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{26}
 }
-func (m *NewCluster_WorkerReq) XXX_Unmarshal(b []byte) error {
+func (m *PartitionStore) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NewCluster_WorkerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PartitionStore) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NewCluster_WorkerReq.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PartitionStore.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1195,46 +1730,56 @@ func (m *NewCluster_WorkerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *NewCluster_WorkerReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewCluster_WorkerReq.Merge(m, src)
+func (m *PartitionStore) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionStore.Merge(m, src)
 }
-func (m *NewCluster_WorkerReq) XXX_Size() int {
+func (m *PartitionStore) XXX_Size() int {
 	return m.Size()
 }
-func (m *NewCluster_WorkerReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewCluster_WorkerReq.DiscardUnknown(m)
+func (m *PartitionStore) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionStore.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NewCluster_WorkerReq proto.InternalMessageInfo
+var xxx_messageInfo_PartitionStore proto.InternalMessageInfo
 
-func (m *NewCluster_WorkerReq) GetRemote() bool {
+func (m *PartitionStore) GetEnabled() bool {
 	if m != nil {
-		return m.Remote
+		return m.Enabled
 	}
 	return false
 }
 
-func (m *NewCluster_WorkerReq) GetHostAndPort() string {
+func (m *PartitionStore) GetDir() string {
 	if m != nil {
-		return m.HostAndPort
+		return m.Dir
 	}
 	return ""
 }
 
-type NewClusterAck struct {
+func (m *PartitionStore) GetMemoryLimit() uint64 {
+	if m != nil {
+		return m.MemoryLimit
+	}
+	return 0
 }
 
-func (m *NewClusterAck) Reset()      { *m = NewClusterAck{} }
-func (*NewClusterAck) ProtoMessage() {}
-func (*NewClusterAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{23}
+type InitPartition struct {
+	PartitionId      uint64          `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	NumericVertexIds bool            `protobuf:"varint,2,opt,name=numeric_vertex_ids,json=numericVertexIds,proto3" json:"numeric_vertex_ids,omitempty"`
+	Store            *PartitionStore `protobuf:"bytes,3,opt,name=store,proto3" json:"store,omitempty"`
 }
-func (m *NewClusterAck) XXX_Unmarshal(b []byte) error {
+
+func (m *InitPartition) Reset()      { *m = InitPartition{} }
+func (*InitPartition) ProtoMessage() {}
+func (*InitPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{27}
+}
+func (m *InitPartition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NewClusterAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InitPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NewClusterAck.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InitPartition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1244,32 +1789,54 @@ func (m *NewClusterAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *NewClusterAck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NewClusterAck.Merge(m, src)
+func (m *InitPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitPartition.Merge(m, src)
 }
-func (m *NewClusterAck) XXX_Size() int {
+func (m *InitPartition) XXX_Size() int {
 	return m.Size()
 }
-func (m *NewClusterAck) XXX_DiscardUnknown() {
-	xxx_messageInfo_NewClusterAck.DiscardUnknown(m)
+func (m *InitPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitPartition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NewClusterAck proto.InternalMessageInfo
+var xxx_messageInfo_InitPartition proto.InternalMessageInfo
 
-type CoordinatorStats struct {
+func (m *InitPartition) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
 }
 
-func (m *CoordinatorStats) Reset()      { *m = CoordinatorStats{} }
-func (*CoordinatorStats) ProtoMessage() {}
-func (*CoordinatorStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{24}
+func (m *InitPartition) GetNumericVertexIds() bool {
+	if m != nil {
+		return m.NumericVertexIds
+	}
+	return false
 }
-func (m *CoordinatorStats) XXX_Unmarshal(b []byte) error {
+
+func (m *InitPartition) GetStore() *PartitionStore {
+	if m != nil {
+		return m.Store
+	}
+	return nil
+}
+
+type InitPartitionAck struct {
+	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+}
+
+func (m *InitPartitionAck) Reset()      { *m = InitPartitionAck{} }
+func (*InitPartitionAck) ProtoMessage() {}
+func (*InitPartitionAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{28}
+}
+func (m *InitPartitionAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CoordinatorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InitPartitionAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CoordinatorStats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InitPartitionAck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1279,36 +1846,42 @@ func (m *CoordinatorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *CoordinatorStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CoordinatorStats.Merge(m, src)
+func (m *InitPartitionAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitPartitionAck.Merge(m, src)
 }
-func (m *CoordinatorStats) XXX_Size() int {
+func (m *InitPartitionAck) XXX_Size() int {
 	return m.Size()
 }
-func (m *CoordinatorStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_CoordinatorStats.DiscardUnknown(m)
+func (m *InitPartitionAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitPartitionAck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CoordinatorStats proto.InternalMessageInfo
+var xxx_messageInfo_InitPartitionAck proto.InternalMessageInfo
 
-type CoordinatorStatsAck struct {
-	SuperStep        uint64 `protobuf:"varint,1,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
-	NrOfActiveVertex uint64 `protobuf:"varint,2,opt,name=nr_of_active_vertex,json=nrOfActiveVertex,proto3" json:"nr_of_active_vertex,omitempty"`
-	NrOfSentMessages uint64 `protobuf:"varint,3,opt,name=nr_of_sent_messages,json=nrOfSentMessages,proto3" json:"nr_of_sent_messages,omitempty"`
-	State            string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+func (m *InitPartitionAck) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
+	}
+	return 0
 }
 
-func (m *CoordinatorStatsAck) Reset()      { *m = CoordinatorStatsAck{} }
-func (*CoordinatorStatsAck) ProtoMessage() {}
-func (*CoordinatorStatsAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{25}
+type ClusterInfo struct {
+	WorkerInfo []*ClusterInfo_WorkerInfo `protobuf:"bytes,1,rep,name=worker_info,json=workerInfo,proto3" json:"worker_info,omitempty"`
+	// partitions of vertices moved from the partition given by the plugin
+	MovedVertices map[string]uint64 `protobuf:"bytes,2,rep,name=moved_vertices,json=movedVertices,proto3" json:"moved_vertices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
-func (m *CoordinatorStatsAck) XXX_Unmarshal(b []byte) error {
+
+func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
+func (*ClusterInfo) ProtoMessage() {}
+func (*ClusterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{29}
+}
+func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CoordinatorStatsAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CoordinatorStatsAck.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1318,60 +1891,48 @@ func (m *CoordinatorStatsAck) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *CoordinatorStatsAck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CoordinatorStatsAck.Merge(m, src)
+func (m *ClusterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInfo.Merge(m, src)
 }
-func (m *CoordinatorStatsAck) XXX_Size() int {
+func (m *ClusterInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *CoordinatorStatsAck) XXX_DiscardUnknown() {
-	xxx_messageInfo_CoordinatorStatsAck.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CoordinatorStatsAck proto.InternalMessageInfo
-
-func (m *CoordinatorStatsAck) GetSuperStep() uint64 {
-	if m != nil {
-		return m.SuperStep
-	}
-	return 0
+func (m *ClusterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInfo.DiscardUnknown(m)
 }
 
-func (m *CoordinatorStatsAck) GetNrOfActiveVertex() uint64 {
-	if m != nil {
-		return m.NrOfActiveVertex
-	}
-	return 0
-}
+var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 
-func (m *CoordinatorStatsAck) GetNrOfSentMessages() uint64 {
+func (m *ClusterInfo) GetWorkerInfo() []*ClusterInfo_WorkerInfo {
 	if m != nil {
-		return m.NrOfSentMessages
+		return m.WorkerInfo
 	}
-	return 0
+	return nil
 }
 
-func (m *CoordinatorStatsAck) GetState() string {
+func (m *ClusterInfo) GetMovedVertices() map[string]uint64 {
 	if m != nil {
-		return m.State
+		return m.MovedVertices
 	}
-	return ""
+	return nil
 }
 
-type StartSuperStep struct {
+type ClusterInfo_WorkerInfo struct {
+	WorkerPid  *actor.PID `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	Partitions []uint64   `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
 }
 
-func (m *StartSuperStep) Reset()      { *m = StartSuperStep{} }
-func (*StartSuperStep) ProtoMessage() {}
-func (*StartSuperStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{26}
+func (m *ClusterInfo_WorkerInfo) Reset()      { *m = ClusterInfo_WorkerInfo{} }
+func (*ClusterInfo_WorkerInfo) ProtoMessage() {}
+func (*ClusterInfo_WorkerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{29, 0}
 }
-func (m *StartSuperStep) XXX_Unmarshal(b []byte) error {
+func (m *ClusterInfo_WorkerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StartSuperStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterInfo_WorkerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StartSuperStep.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterInfo_WorkerInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1381,32 +1942,50 @@ func (m *StartSuperStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *StartSuperStep) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StartSuperStep.Merge(m, src)
+func (m *ClusterInfo_WorkerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInfo_WorkerInfo.Merge(m, src)
 }
-func (m *StartSuperStep) XXX_Size() int {
+func (m *ClusterInfo_WorkerInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *StartSuperStep) XXX_DiscardUnknown() {
-	xxx_messageInfo_StartSuperStep.DiscardUnknown(m)
+func (m *ClusterInfo_WorkerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInfo_WorkerInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StartSuperStep proto.InternalMessageInfo
+var xxx_messageInfo_ClusterInfo_WorkerInfo proto.InternalMessageInfo
 
-type ShowAggregatedValue struct {
+func (m *ClusterInfo_WorkerInfo) GetWorkerPid() *actor.PID {
+	if m != nil {
+		return m.WorkerPid
+	}
+	return nil
 }
 
-func (m *ShowAggregatedValue) Reset()      { *m = ShowAggregatedValue{} }
-func (*ShowAggregatedValue) ProtoMessage() {}
-func (*ShowAggregatedValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{27}
+func (m *ClusterInfo_WorkerInfo) GetPartitions() []uint64 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
 }
-func (m *ShowAggregatedValue) XXX_Unmarshal(b []byte) error {
+
+type PluginFingerprint struct {
+	ProtocolVersion uint32   `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Version         string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Aggregators     []string `protobuf:"bytes,3,rep,name=aggregators,proto3" json:"aggregators,omitempty"`
+	MessageTypes    []string `protobuf:"bytes,4,rep,name=message_types,json=messageTypes,proto3" json:"message_types,omitempty"`
+}
+
+func (m *PluginFingerprint) Reset()      { *m = PluginFingerprint{} }
+func (*PluginFingerprint) ProtoMessage() {}
+func (*PluginFingerprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{30}
+}
+func (m *PluginFingerprint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ShowAggregatedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PluginFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ShowAggregatedValue.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PluginFingerprint.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1416,33 +1995,72 @@ func (m *ShowAggregatedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ShowAggregatedValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowAggregatedValue.Merge(m, src)
+func (m *PluginFingerprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PluginFingerprint.Merge(m, src)
 }
-func (m *ShowAggregatedValue) XXX_Size() int {
+func (m *PluginFingerprint) XXX_Size() int {
 	return m.Size()
 }
-func (m *ShowAggregatedValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowAggregatedValue.DiscardUnknown(m)
+func (m *PluginFingerprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_PluginFingerprint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowAggregatedValue proto.InternalMessageInfo
+var xxx_messageInfo_PluginFingerprint proto.InternalMessageInfo
 
-type ShowAggregatedValueAck struct {
-	AggregatedValues map[string]string `protobuf:"bytes,1,rep,name=aggregated_values,json=aggregatedValues,proto3" json:"aggregated_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+func (m *PluginFingerprint) GetProtocolVersion() uint32 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
 }
 
-func (m *ShowAggregatedValueAck) Reset()      { *m = ShowAggregatedValueAck{} }
-func (*ShowAggregatedValueAck) ProtoMessage() {}
-func (*ShowAggregatedValueAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{28}
+func (m *PluginFingerprint) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
 }
-func (m *ShowAggregatedValueAck) XXX_Unmarshal(b []byte) error {
+
+func (m *PluginFingerprint) GetAggregators() []string {
+	if m != nil {
+		return m.Aggregators
+	}
+	return nil
+}
+
+func (m *PluginFingerprint) GetMessageTypes() []string {
+	if m != nil {
+		return m.MessageTypes
+	}
+	return nil
+}
+
+type InitWorker struct {
+	Coordinator *actor.PID         `protobuf:"bytes,1,opt,name=coordinator,proto3" json:"coordinator,omitempty"`
+	Partitions  []uint64           `protobuf:"varint,2,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
+	Fingerprint *PluginFingerprint `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// partitions of up to this number of vertices are cached by the worker, 0 disables the cache
+	VertexCacheSize uint32 `protobuf:"varint,4,opt,name=vertex_cache_size,json=vertexCacheSize,proto3" json:"vertex_cache_size,omitempty"`
+	// vertices are identified by integer IDs in messages
+	NumericVertexIds bool `protobuf:"varint,5,opt,name=numeric_vertex_ids,json=numericVertexIds,proto3" json:"numeric_vertex_ids,omitempty"`
+	// messages to other workers are sent by SuperStepMessageBatch
+	MessageBatch       bool             `protobuf:"varint,6,opt,name=message_batch,json=messageBatch,proto3" json:"message_batch,omitempty"`
+	MessageCompression BatchCompression `protobuf:"varint,7,opt,name=message_compression,json=messageCompression,proto3,enum=BatchCompression" json:"message_compression,omitempty"`
+	MessageSpill       *MessageSpill    `protobuf:"bytes,8,opt,name=message_spill,json=messageSpill,proto3" json:"message_spill,omitempty"`
+	PartitionStore     *PartitionStore  `protobuf:"bytes,9,opt,name=partition_store,json=partitionStore,proto3" json:"partition_store,omitempty"`
+}
+
+func (m *InitWorker) Reset()      { *m = InitWorker{} }
+func (*InitWorker) ProtoMessage() {}
+func (*InitWorker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{31}
+}
+func (m *InitWorker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ShowAggregatedValueAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InitWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ShowAggregatedValueAck.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InitWorker.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1452,39 +2070,98 @@ func (m *ShowAggregatedValueAck) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ShowAggregatedValueAck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowAggregatedValueAck.Merge(m, src)
+func (m *InitWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitWorker.Merge(m, src)
 }
-func (m *ShowAggregatedValueAck) XXX_Size() int {
+func (m *InitWorker) XXX_Size() int {
 	return m.Size()
 }
-func (m *ShowAggregatedValueAck) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowAggregatedValueAck.DiscardUnknown(m)
+func (m *InitWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitWorker.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowAggregatedValueAck proto.InternalMessageInfo
+var xxx_messageInfo_InitWorker proto.InternalMessageInfo
 
-func (m *ShowAggregatedValueAck) GetAggregatedValues() map[string]string {
+func (m *InitWorker) GetCoordinator() *actor.PID {
 	if m != nil {
-		return m.AggregatedValues
+		return m.Coordinator
 	}
 	return nil
 }
 
-type Shutdown struct {
+func (m *InitWorker) GetPartitions() []uint64 {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
 }
 
-func (m *Shutdown) Reset()      { *m = Shutdown{} }
-func (*Shutdown) ProtoMessage() {}
-func (*Shutdown) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{29}
+func (m *InitWorker) GetFingerprint() *PluginFingerprint {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return nil
 }
-func (m *Shutdown) XXX_Unmarshal(b []byte) error {
+
+func (m *InitWorker) GetVertexCacheSize() uint32 {
+	if m != nil {
+		return m.VertexCacheSize
+	}
+	return 0
+}
+
+func (m *InitWorker) GetNumericVertexIds() bool {
+	if m != nil {
+		return m.NumericVertexIds
+	}
+	return false
+}
+
+func (m *InitWorker) GetMessageBatch() bool {
+	if m != nil {
+		return m.MessageBatch
+	}
+	return false
+}
+
+func (m *InitWorker) GetMessageCompression() BatchCompression {
+	if m != nil {
+		return m.MessageCompression
+	}
+	return BATCH_COMPRESSION_NONE
+}
+
+func (m *InitWorker) GetMessageSpill() *MessageSpill {
+	if m != nil {
+		return m.MessageSpill
+	}
+	return nil
+}
+
+func (m *InitWorker) GetPartitionStore() *PartitionStore {
+	if m != nil {
+		return m.PartitionStore
+	}
+	return nil
+}
+
+type InitWorkerAck struct {
+	WorkerPid   *actor.PID         `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	Algorithms  []string           `protobuf:"bytes,2,rep,name=algorithms,proto3" json:"algorithms,omitempty"`
+	Fingerprint *PluginFingerprint `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+}
+
+func (m *InitWorkerAck) Reset()      { *m = InitWorkerAck{} }
+func (*InitWorkerAck) ProtoMessage() {}
+func (*InitWorkerAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{32}
+}
+func (m *InitWorkerAck) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Shutdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InitWorkerAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Shutdown.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InitWorkerAck.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1494,32 +2171,77 @@ func (m *Shutdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Shutdown) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Shutdown.Merge(m, src)
+func (m *InitWorkerAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitWorkerAck.Merge(m, src)
 }
-func (m *Shutdown) XXX_Size() int {
+func (m *InitWorkerAck) XXX_Size() int {
 	return m.Size()
 }
-func (m *Shutdown) XXX_DiscardUnknown() {
-	xxx_messageInfo_Shutdown.DiscardUnknown(m)
+func (m *InitWorkerAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitWorkerAck.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Shutdown proto.InternalMessageInfo
+var xxx_messageInfo_InitWorkerAck proto.InternalMessageInfo
 
-type ShutdownAck struct {
+func (m *InitWorkerAck) GetWorkerPid() *actor.PID {
+	if m != nil {
+		return m.WorkerPid
+	}
+	return nil
 }
 
-func (m *ShutdownAck) Reset()      { *m = ShutdownAck{} }
-func (*ShutdownAck) ProtoMessage() {}
-func (*ShutdownAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_213c0bb044472049, []int{30}
+func (m *InitWorkerAck) GetAlgorithms() []string {
+	if m != nil {
+		return m.Algorithms
+	}
+	return nil
 }
-func (m *ShutdownAck) XXX_Unmarshal(b []byte) error {
+
+func (m *InitWorkerAck) GetFingerprint() *PluginFingerprint {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return nil
+}
+
+type NewCluster struct {
+	Workers        []*NewCluster_WorkerReq `protobuf:"bytes,1,rep,name=workers,proto3" json:"workers,omitempty"`
+	NrOfPartitions uint64                  `protobuf:"varint,2,opt,name=nr_of_partitions,json=nrOfPartitions,proto3" json:"nr_of_partitions,omitempty"`
+	// the cluster is formed after this number of workers register themselves in addition to workers
+	MinRegisteredWorkers      uint32 `protobuf:"varint,3,opt,name=min_registered_workers,json=minRegisteredWorkers,proto3" json:"min_registered_workers,omitempty"`
+	RegistrationTimeoutMillis int64  `protobuf:"varint,4,opt,name=registration_timeout_millis,json=registrationTimeoutMillis,proto3" json:"registration_timeout_millis,omitempty"`
+	// workers are also discovered by looking up DNS, A/AAAA records of host:port or SRV records if worker_dns_srv is set
+	WorkerDns    string `protobuf:"bytes,5,opt,name=worker_dns,json=workerDns,proto3" json:"worker_dns,omitempty"`
+	WorkerDnsSrv bool   `protobuf:"varint,6,opt,name=worker_dns_srv,json=workerDnsSrv,proto3" json:"worker_dns_srv,omitempty"`
+	// partitions are moved between supersteps when the most loaded worker exceeds the average by this ratio, 0 disables it
+	RebalanceThreshold float64 `protobuf:"fixed64,7,opt,name=rebalance_threshold,json=rebalanceThreshold,proto3" json:"rebalance_threshold,omitempty"`
+	// vertices are moved to the partition they send most messages to between supersteps, up to this number per superstep. 0 disables it
+	MaxVertexMoves uint32 `protobuf:"varint,8,opt,name=max_vertex_moves,json=maxVertexMoves,proto3" json:"max_vertex_moves,omitempty"`
+	// partitions of up to this number of vertices are cached by each worker and the coordinator, 0 disables the cache
+	VertexCacheSize uint32 `protobuf:"varint,9,opt,name=vertex_cache_size,json=vertexCacheSize,proto3" json:"vertex_cache_size,omitempty"`
+	// vertex IDs are integers, messages carry them as fixed64 and partitions index vertices by them
+	NumericVertexIds bool `protobuf:"varint,10,opt,name=numeric_vertex_ids,json=numericVertexIds,proto3" json:"numeric_vertex_ids,omitempty"`
+	// messages to other workers are sent by SuperStepMessageBatch per destination worker, compressed by message_compression
+	MessageBatch       bool             `protobuf:"varint,11,opt,name=message_batch,json=messageBatch,proto3" json:"message_batch,omitempty"`
+	MessageCompression BatchCompression `protobuf:"varint,12,opt,name=message_compression,json=messageCompression,proto3,enum=BatchCompression" json:"message_compression,omitempty"`
+	FlowControl        *FlowControl     `protobuf:"bytes,13,opt,name=flow_control,json=flowControl,proto3" json:"flow_control,omitempty"`
+	MessageSpill       *MessageSpill    `protobuf:"bytes,14,opt,name=message_spill,json=messageSpill,proto3" json:"message_spill,omitempty"`
+	PartitionStore     *PartitionStore  `protobuf:"bytes,15,opt,name=partition_store,json=partitionStore,proto3" json:"partition_store,omitempty"`
+	// SuperStepBarrier is sent before every superstep, which costs another round trip per superstep. Otherwise Compute implies it
+	SuperstepBarrier bool `protobuf:"varint,16,opt,name=superstep_barrier,json=superstepBarrier,proto3" json:"superstep_barrier,omitempty"`
+}
+
+func (m *NewCluster) Reset()      { *m = NewCluster{} }
+func (*NewCluster) ProtoMessage() {}
+func (*NewCluster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{33}
+}
+func (m *NewCluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ShutdownAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NewCluster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ShutdownAck.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NewCluster.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1529,3386 +2251,18125 @@ func (m *ShutdownAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ShutdownAck) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShutdownAck.Merge(m, src)
+func (m *NewCluster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewCluster.Merge(m, src)
 }
-func (m *ShutdownAck) XXX_Size() int {
+func (m *NewCluster) XXX_Size() int {
 	return m.Size()
 }
-func (m *ShutdownAck) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShutdownAck.DiscardUnknown(m)
+func (m *NewCluster) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewCluster.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShutdownAck proto.InternalMessageInfo
+var xxx_messageInfo_NewCluster proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*LoadVertex)(nil), "LoadVertex")
-	proto.RegisterType((*LoadVertexAck)(nil), "LoadVertexAck")
-	proto.RegisterType((*LoadPartitionVertices)(nil), "LoadPartitionVertices")
-	proto.RegisterType((*LoadPartitionVerticesAck)(nil), "LoadPartitionVerticesAck")
-	proto.RegisterType((*LoadPartitionVerticesWorkerAck)(nil), "LoadPartitionVerticesWorkerAck")
-	proto.RegisterType((*GetVertexValue)(nil), "GetVertexValue")
-	proto.RegisterType((*GetVertexValueAck)(nil), "GetVertexValueAck")
-	proto.RegisterType((*SuperStepBarrier)(nil), "SuperStepBarrier")
-	proto.RegisterType((*SuperStepBarrierAck)(nil), "SuperStepBarrierAck")
-	proto.RegisterType((*SuperStepBarrierPartitionAck)(nil), "SuperStepBarrierPartitionAck")
-	proto.RegisterType((*SuperStepBarrierWorkerAck)(nil), "SuperStepBarrierWorkerAck")
-	proto.RegisterType((*Compute)(nil), "Compute")
-	proto.RegisterMapType((map[string]*types.Any)(nil), "Compute.AggregatedValuesEntry")
-	proto.RegisterType((*ComputeAck)(nil), "ComputeAck")
-	proto.RegisterMapType((map[string]*types.Any)(nil), "ComputeAck.AggregatedValuesEntry")
-	proto.RegisterType((*ComputePartitionAck)(nil), "ComputePartitionAck")
-	proto.RegisterMapType((map[string]*types.Any)(nil), "ComputePartitionAck.AggregatedValuesEntry")
-	proto.RegisterType((*ComputeWorkerAck)(nil), "ComputeWorkerAck")
-	proto.RegisterMapType((map[string]*types.Any)(nil), "ComputeWorkerAck.AggregatedValuesEntry")
-	proto.RegisterType((*SuperStepMessage)(nil), "SuperStepMessage")
-	proto.RegisterType((*SuperStepMessageAck)(nil), "SuperStepMessageAck")
-	proto.RegisterType((*InitPartition)(nil), "InitPartition")
-	proto.RegisterType((*InitPartitionAck)(nil), "InitPartitionAck")
-	proto.RegisterType((*ClusterInfo)(nil), "ClusterInfo")
-	proto.RegisterType((*ClusterInfo_WorkerInfo)(nil), "ClusterInfo.WorkerInfo")
-	proto.RegisterType((*InitWorker)(nil), "InitWorker")
-	proto.RegisterType((*InitWorkerAck)(nil), "InitWorkerAck")
-	proto.RegisterType((*NewCluster)(nil), "NewCluster")
-	proto.RegisterType((*NewCluster_WorkerReq)(nil), "NewCluster.WorkerReq")
-	proto.RegisterType((*NewClusterAck)(nil), "NewClusterAck")
-	proto.RegisterType((*CoordinatorStats)(nil), "CoordinatorStats")
-	proto.RegisterType((*CoordinatorStatsAck)(nil), "CoordinatorStatsAck")
-	proto.RegisterType((*StartSuperStep)(nil), "StartSuperStep")
-	proto.RegisterType((*ShowAggregatedValue)(nil), "ShowAggregatedValue")
-	proto.RegisterType((*ShowAggregatedValueAck)(nil), "ShowAggregatedValueAck")
-	proto.RegisterMapType((map[string]string)(nil), "ShowAggregatedValueAck.AggregatedValuesEntry")
-	proto.RegisterType((*Shutdown)(nil), "Shutdown")
-	proto.RegisterType((*ShutdownAck)(nil), "ShutdownAck")
+func (m *NewCluster) GetWorkers() []*NewCluster_WorkerReq {
+	if m != nil {
+		return m.Workers
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("command.proto", fileDescriptor_213c0bb044472049) }
-
-var fileDescriptor_213c0bb044472049 = []byte{
-	// 1000 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6e, 0xdb, 0xc6,
-	0x13, 0x16, 0x6d, 0x25, 0xb6, 0x86, 0x91, 0x23, 0xd3, 0x71, 0x7e, 0x8a, 0x7f, 0x2d, 0x91, 0x2c,
-	0x0a, 0xd4, 0x36, 0x6a, 0x0a, 0x50, 0xff, 0x20, 0xc8, 0x4d, 0x56, 0x9b, 0x40, 0x48, 0x9b, 0x1a,
-	0x54, 0x60, 0xa3, 0xb9, 0x10, 0x34, 0xb9, 0x92, 0x08, 0x59, 0xbb, 0xea, 0xee, 0xd2, 0xae, 0x6e,
-	0x7d, 0x84, 0x3e, 0x42, 0x8f, 0x39, 0xf6, 0xda, 0x53, 0xaf, 0x3d, 0xfa, 0x98, 0x63, 0x2d, 0x5f,
-	0x7a, 0x28, 0x8a, 0x3c, 0x42, 0xb1, 0xcb, 0x15, 0x25, 0x13, 0xb4, 0xad, 0xf8, 0xe4, 0xdb, 0xee,
-	0xcc, 0xec, 0xc7, 0xf9, 0xbe, 0x9d, 0x19, 0x2e, 0x94, 0x03, 0x3a, 0x18, 0xf8, 0x24, 0x74, 0x86,
-	0x8c, 0x0a, 0xba, 0xf1, 0xa8, 0x4b, 0x69, 0xf7, 0x08, 0xd7, 0xd4, 0xee, 0x30, 0xee, 0xd4, 0x7c,
-	0x32, 0xd2, 0xae, 0xaf, 0xba, 0x91, 0xe8, 0xc5, 0x87, 0x4e, 0x40, 0x07, 0xb5, 0x06, 0x1f, 0x91,
-	0x3e, 0xa3, 0xa4, 0xf5, 0x3a, 0x89, 0xf4, 0x03, 0x41, 0xd9, 0x4e, 0x97, 0xd6, 0xd4, 0x22, 0xb1,
-	0xf1, 0xe4, 0x1c, 0xda, 0x02, 0xf8, 0x96, 0xfa, 0xe1, 0x3e, 0x66, 0x02, 0xff, 0x64, 0xfd, 0x1f,
-	0x4a, 0xc7, 0x6a, 0xe5, 0x45, 0x61, 0xd5, 0x78, 0x6c, 0x6c, 0x96, 0xdc, 0xe5, 0xc4, 0xd0, 0x0a,
-	0xd1, 0x2e, 0x94, 0xa7, 0xa1, 0x8d, 0xa0, 0x7f, 0x65, 0xb4, 0xf5, 0x00, 0xee, 0x60, 0xc6, 0x28,
-	0xab, 0x2e, 0x28, 0x47, 0xb2, 0x41, 0x4d, 0x58, 0x97, 0x18, 0x7b, 0x3e, 0x13, 0x91, 0x88, 0x28,
-	0x91, 0x60, 0x51, 0x80, 0xb9, 0xb5, 0x0d, 0xab, 0x24, 0x1e, 0x78, 0xb4, 0xe3, 0x0d, 0x27, 0x3e,
-	0xae, 0x30, 0x8b, 0xee, 0x7d, 0x12, 0x0f, 0xbe, 0xef, 0xa4, 0x47, 0x38, 0x6a, 0x43, 0x35, 0x17,
-	0x44, 0xe6, 0xf4, 0x04, 0xee, 0xa5, 0x00, 0x93, 0xb4, 0x8a, 0xae, 0x99, 0xda, 0x2e, 0xcd, 0xec,
-	0x25, 0xd8, 0xb9, 0xa0, 0x07, 0x94, 0xf5, 0x31, 0x93, 0xd0, 0x5b, 0x00, 0x27, 0x6a, 0xe3, 0x0d,
-	0x35, 0xb0, 0x59, 0x07, 0x47, 0x69, 0xea, 0xec, 0xb5, 0xbe, 0x76, 0x4b, 0x89, 0x77, 0x2f, 0x0a,
-	0xd1, 0x0e, 0xac, 0xbc, 0xc0, 0x22, 0x51, 0x6a, 0xdf, 0x3f, 0x8a, 0xf1, 0xd5, 0xca, 0x3e, 0x87,
-	0xd5, 0x8b, 0xe1, 0xf3, 0xa8, 0x7b, 0x2c, 0x03, 0x27, 0x1c, 0xd4, 0x06, 0x59, 0x50, 0x69, 0xc7,
-	0x43, 0xcc, 0xda, 0x02, 0x0f, 0x77, 0x7d, 0xc6, 0x22, 0xcc, 0x50, 0x1d, 0xd6, 0xb2, 0xb6, 0xeb,
-	0xd0, 0x51, 0x03, 0x3e, 0xca, 0x9e, 0x49, 0x75, 0x99, 0x4f, 0x64, 0xf4, 0x1c, 0x1e, 0x65, 0x21,
-	0x6e, 0xa4, 0xe4, 0xa9, 0x01, 0x4b, 0x4d, 0x3a, 0x18, 0xc6, 0x02, 0x5b, 0x1f, 0x03, 0x70, 0x89,
-	0xe9, 0x71, 0x81, 0x87, 0xfa, 0xa3, 0x25, 0x3e, 0xf9, 0x8a, 0xf5, 0x12, 0x56, 0xfd, 0x6e, 0x97,
-	0xe1, 0xae, 0x2f, 0x70, 0xe8, 0x29, 0x45, 0x78, 0x75, 0xe1, 0xf1, 0xe2, 0xa6, 0x59, 0xb7, 0x1d,
-	0x8d, 0xe1, 0x34, 0xd2, 0x08, 0x25, 0x34, 0xff, 0x86, 0x08, 0x36, 0x72, 0x2b, 0x7e, 0xc6, 0xbc,
-	0xf1, 0x03, 0xac, 0xe7, 0x86, 0x5a, 0x15, 0x58, 0xec, 0xe3, 0x91, 0x96, 0x4c, 0x2e, 0xad, 0xed,
-	0xd9, 0xbb, 0x30, 0xeb, 0x0f, 0x9c, 0xa4, 0x4b, 0x9d, 0x49, 0x97, 0x3a, 0x0d, 0x32, 0xd2, 0x37,
-	0xf4, 0x6c, 0xe1, 0xa9, 0x81, 0xfe, 0x31, 0x00, 0x74, 0x3a, 0xd7, 0xde, 0xf3, 0x43, 0xb8, 0xdb,
-	0xf3, 0x8f, 0x04, 0x0e, 0x15, 0xf8, 0xb2, 0xab, 0x77, 0xd6, 0xab, 0x3c, 0xae, 0x8b, 0x8a, 0xeb,
-	0x13, 0x67, 0x0a, 0x7e, 0x4b, 0xe8, 0xae, 0xe9, 0x8c, 0x3e, 0xb0, 0x88, 0xac, 0x83, 0xcb, 0x6f,
-	0x74, 0xdb, 0xc9, 0xc1, 0xbc, 0x0d, 0x74, 0xff, 0x35, 0xa0, 0xa2, 0x53, 0xbb, 0x49, 0xc1, 0x5b,
-	0xaf, 0x2f, 0xe7, 0xfc, 0xa9, 0x93, 0x05, 0xbe, 0x0d, 0x84, 0xff, 0x30, 0x66, 0xa6, 0xce, 0x77,
-	0x98, 0x73, 0xbf, 0x8b, 0x2d, 0x0b, 0x8a, 0x71, 0x9c, 0xd6, 0xb3, 0x5a, 0x67, 0xda, 0x77, 0x21,
-	0xdb, 0xbe, 0x08, 0xca, 0x9c, 0x05, 0xde, 0xb4, 0x17, 0x16, 0xd5, 0x59, 0x93, 0xb3, 0x60, 0x7f,
-	0xd2, 0x0e, 0x9f, 0xc0, 0x4a, 0x88, 0xb9, 0x98, 0x09, 0x2a, 0xaa, 0xa0, 0x7b, 0xd2, 0x9a, 0x46,
-	0x39, 0xb0, 0x34, 0x48, 0xf2, 0xa8, 0xde, 0xb9, 0x82, 0xc3, 0x24, 0x08, 0x6d, 0xcd, 0x8c, 0x48,
-	0x4d, 0x40, 0x5e, 0x5a, 0x0e, 0x07, 0x54, 0x87, 0x72, 0x8b, 0x44, 0x22, 0x2d, 0xba, 0x79, 0x46,
-	0xe1, 0x97, 0x50, 0xb9, 0x70, 0x66, 0xce, 0x09, 0xfa, 0xab, 0x01, 0x66, 0xf3, 0x28, 0xe6, 0x02,
-	0xb3, 0x16, 0xe9, 0x50, 0xeb, 0x29, 0x98, 0xba, 0x86, 0x22, 0xd2, 0xa1, 0x55, 0x43, 0x95, 0xc4,
-	0xff, 0x9c, 0x99, 0x10, 0x27, 0xa9, 0x0b, 0xb9, 0x74, 0x75, 0xbd, 0xc9, 0xf5, 0xc6, 0x01, 0xc0,
-	0xd4, 0xf3, 0x21, 0xb5, 0x68, 0x03, 0xcc, 0xfc, 0x8d, 0x65, 0x11, 0x16, 0xdd, 0x19, 0x0b, 0x7a,
-	0x03, 0x20, 0x99, 0x25, 0xe0, 0xd6, 0x67, 0x60, 0x06, 0x94, 0xb2, 0x30, 0x22, 0xbe, 0xa0, 0x2c,
-	0x07, 0x79, 0xd6, 0x7d, 0x2d, 0xf6, 0xb3, 0x44, 0xe9, 0x1b, 0xfd, 0x34, 0x7e, 0x33, 0x00, 0x5e,
-	0xe1, 0x13, 0x2d, 0x8d, 0x55, 0x83, 0xa5, 0xc4, 0xc7, 0xb5, 0x6a, 0xeb, 0xce, 0xd4, 0xab, 0x45,
-	0x73, 0xf1, 0x8f, 0xee, 0x24, 0xca, 0xda, 0x84, 0x0a, 0x61, 0x99, 0xb7, 0x48, 0x52, 0xaf, 0x2b,
-	0x84, 0xcd, 0x3e, 0x45, 0x36, 0x5e, 0x40, 0x29, 0x3d, 0x2f, 0x87, 0x35, 0xc3, 0x03, 0x2a, 0xb0,
-	0xca, 0x6e, 0xd9, 0xd5, 0x3b, 0x59, 0xd9, 0x3d, 0xca, 0x85, 0xe7, 0x93, 0xd0, 0x1b, 0x52, 0x26,
-	0xf4, 0x4f, 0xdb, 0x94, 0xc6, 0x06, 0x09, 0xf7, 0x28, 0x13, 0xe8, 0x3e, 0x94, 0xa7, 0x39, 0x35,
-	0x82, 0xbe, 0xfc, 0x97, 0x37, 0xa7, 0x72, 0xb5, 0x85, 0x2f, 0x38, 0x7a, 0xab, 0x46, 0xe9, 0x45,
-	0xa3, 0x94, 0xe6, 0x9a, 0x1f, 0xe3, 0x0e, 0xac, 0x25, 0x74, 0xfc, 0x40, 0x44, 0xc7, 0x58, 0x77,
-	0x8f, 0x66, 0x54, 0x91, 0x8c, 0x1a, 0xca, 0xa1, 0x1f, 0x81, 0x69, 0x38, 0xc7, 0x44, 0x78, 0xba,
-	0x49, 0xb8, 0x6a, 0x47, 0x1d, 0xde, 0xc6, 0x44, 0xe8, 0x46, 0xe1, 0xf2, 0x29, 0xc2, 0x85, 0x2f,
-	0xb0, 0x6e, 0xc5, 0x64, 0x83, 0x2a, 0xb0, 0xd2, 0x16, 0x3e, 0x13, 0x69, 0x63, 0xa1, 0x75, 0x58,
-	0x6b, 0xf7, 0xe8, 0x49, 0x66, 0x0c, 0xa1, 0xdf, 0x0d, 0x78, 0x98, 0x63, 0x97, 0xb4, 0xde, 0xe4,
-	0x8d, 0xc2, 0xe4, 0x06, 0x77, 0x9c, 0xfc, 0x33, 0x73, 0x0f, 0xc4, 0xe6, 0xfc, 0x03, 0x31, 0xf7,
-	0xad, 0xa5, 0x46, 0x1f, 0xc0, 0x72, 0xbb, 0x17, 0x8b, 0x90, 0x9e, 0x10, 0x54, 0x06, 0x73, 0xb2,
-	0x6e, 0x04, 0xfd, 0xdd, 0x2f, 0x4e, 0xcf, 0xec, 0xc2, 0xbb, 0x33, 0xbb, 0xf0, 0xfe, 0xcc, 0x36,
-	0x7e, 0x1e, 0xdb, 0xc6, 0xdb, 0xb1, 0x6d, 0xfc, 0x39, 0xb6, 0x8d, 0xd3, 0xb1, 0x6d, 0xfc, 0x35,
-	0xb6, 0x8d, 0xbf, 0xc7, 0x76, 0xe1, 0xfd, 0xd8, 0x36, 0x7e, 0x39, 0xb7, 0x0b, 0xa7, 0xe7, 0x76,
-	0xe1, 0xdd, 0xb9, 0x5d, 0x38, 0xbc, 0xab, 0x06, 0xd4, 0xe7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x25, 0x52, 0x89, 0xab, 0xf8, 0x0b, 0x00, 0x00,
+func (m *NewCluster) GetNrOfPartitions() uint64 {
+	if m != nil {
+		return m.NrOfPartitions
+	}
+	return 0
 }
 
-func (this *LoadVertex) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *NewCluster) GetMinRegisteredWorkers() uint32 {
+	if m != nil {
+		return m.MinRegisteredWorkers
 	}
+	return 0
+}
 
-	that1, ok := that.(*LoadVertex)
-	if !ok {
-		that2, ok := that.(LoadVertex)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *NewCluster) GetRegistrationTimeoutMillis() int64 {
+	if m != nil {
+		return m.RegistrationTimeoutMillis
 	}
-	if this.VertexId != that1.VertexId {
-		return false
+	return 0
+}
+
+func (m *NewCluster) GetWorkerDns() string {
+	if m != nil {
+		return m.WorkerDns
 	}
-	return true
+	return ""
 }
-func (this *LoadVertexAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NewCluster) GetWorkerDnsSrv() bool {
+	if m != nil {
+		return m.WorkerDnsSrv
 	}
+	return false
+}
 
-	that1, ok := that.(*LoadVertexAck)
-	if !ok {
-		that2, ok := that.(LoadVertexAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VertexId != that1.VertexId {
-		return false
-	}
-	if this.Error != that1.Error {
-		return false
+func (m *NewCluster) GetRebalanceThreshold() float64 {
+	if m != nil {
+		return m.RebalanceThreshold
 	}
-	return true
+	return 0
 }
-func (this *LoadPartitionVertices) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*LoadPartitionVertices)
-	if !ok {
-		that2, ok := that.(LoadPartitionVertices)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.NumOfPartitions != that1.NumOfPartitions {
-		return false
+func (m *NewCluster) GetMaxVertexMoves() uint32 {
+	if m != nil {
+		return m.MaxVertexMoves
 	}
-	return true
+	return 0
 }
-func (this *LoadPartitionVerticesAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*LoadPartitionVerticesAck)
-	if !ok {
-		that2, ok := that.(LoadPartitionVerticesAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PartitionId != that1.PartitionId {
-		return false
-	}
-	if this.Error != that1.Error {
-		return false
+func (m *NewCluster) GetVertexCacheSize() uint32 {
+	if m != nil {
+		return m.VertexCacheSize
 	}
-	return true
+	return 0
 }
-func (this *LoadPartitionVerticesWorkerAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*LoadPartitionVerticesWorkerAck)
-	if !ok {
-		that2, ok := that.(LoadPartitionVerticesWorkerAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.WorkerPid.Equal(that1.WorkerPid) {
-		return false
+func (m *NewCluster) GetNumericVertexIds() bool {
+	if m != nil {
+		return m.NumericVertexIds
 	}
-	return true
+	return false
 }
-func (this *GetVertexValue) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetVertexValue)
-	if !ok {
-		that2, ok := that.(GetVertexValue)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VertexId != that1.VertexId {
-		return false
+func (m *NewCluster) GetMessageBatch() bool {
+	if m != nil {
+		return m.MessageBatch
 	}
-	return true
+	return false
 }
-func (this *GetVertexValueAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*GetVertexValueAck)
-	if !ok {
-		that2, ok := that.(GetVertexValueAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VertexId != that1.VertexId {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
+func (m *NewCluster) GetMessageCompression() BatchCompression {
+	if m != nil {
+		return m.MessageCompression
 	}
-	return true
+	return BATCH_COMPRESSION_NONE
 }
-func (this *SuperStepBarrier) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*SuperStepBarrier)
-	if !ok {
-		that2, ok := that.(SuperStepBarrier)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *NewCluster) GetFlowControl() *FlowControl {
+	if m != nil {
+		return m.FlowControl
 	}
-	return true
+	return nil
 }
-func (this *SuperStepBarrierAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*SuperStepBarrierAck)
-	if !ok {
-		that2, ok := that.(SuperStepBarrierAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *NewCluster) GetMessageSpill() *MessageSpill {
+	if m != nil {
+		return m.MessageSpill
 	}
-	if this.VertexId != that1.VertexId {
-		return false
+	return nil
+}
+
+func (m *NewCluster) GetPartitionStore() *PartitionStore {
+	if m != nil {
+		return m.PartitionStore
 	}
-	return true
+	return nil
 }
-func (this *SuperStepBarrierPartitionAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NewCluster) GetSuperstepBarrier() bool {
+	if m != nil {
+		return m.SuperstepBarrier
 	}
+	return false
+}
 
-	that1, ok := that.(*SuperStepBarrierPartitionAck)
-	if !ok {
-		that2, ok := that.(SuperStepBarrierPartitionAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type NewCluster_WorkerReq struct {
+	Remote      bool   `protobuf:"varint,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	HostAndPort string `protobuf:"bytes,2,opt,name=host_and_port,json=hostAndPort,proto3" json:"host_and_port,omitempty"`
+}
+
+func (m *NewCluster_WorkerReq) Reset()      { *m = NewCluster_WorkerReq{} }
+func (*NewCluster_WorkerReq) ProtoMessage() {}
+func (*NewCluster_WorkerReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{33, 0}
+}
+func (m *NewCluster_WorkerReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewCluster_WorkerReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewCluster_WorkerReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PartitionId != that1.PartitionId {
-		return false
+}
+func (m *NewCluster_WorkerReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewCluster_WorkerReq.Merge(m, src)
+}
+func (m *NewCluster_WorkerReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewCluster_WorkerReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewCluster_WorkerReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewCluster_WorkerReq proto.InternalMessageInfo
+
+func (m *NewCluster_WorkerReq) GetRemote() bool {
+	if m != nil {
+		return m.Remote
 	}
-	return true
+	return false
 }
-func (this *SuperStepBarrierWorkerAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *NewCluster_WorkerReq) GetHostAndPort() string {
+	if m != nil {
+		return m.HostAndPort
 	}
+	return ""
+}
 
-	that1, ok := that.(*SuperStepBarrierWorkerAck)
-	if !ok {
-		that2, ok := that.(SuperStepBarrierWorkerAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type NewClusterAck struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *NewClusterAck) Reset()      { *m = NewClusterAck{} }
+func (*NewClusterAck) ProtoMessage() {}
+func (*NewClusterAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{34}
+}
+func (m *NewClusterAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NewClusterAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NewClusterAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.WorkerPid.Equal(that1.WorkerPid) {
-		return false
-	}
-	return true
 }
-func (this *Compute) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *NewClusterAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NewClusterAck.Merge(m, src)
+}
+func (m *NewClusterAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *NewClusterAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_NewClusterAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NewClusterAck proto.InternalMessageInfo
+
+func (m *NewClusterAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*Compute)
-	if !ok {
-		that2, ok := that.(Compute)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type RegisterWorker struct {
+	HostAndPort string `protobuf:"bytes,1,opt,name=host_and_port,json=hostAndPort,proto3" json:"host_and_port,omitempty"`
+}
+
+func (m *RegisterWorker) Reset()      { *m = RegisterWorker{} }
+func (*RegisterWorker) ProtoMessage() {}
+func (*RegisterWorker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{35}
+}
+func (m *RegisterWorker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterWorker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SuperStep != that1.SuperStep {
-		return false
-	}
-	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
-		return false
+}
+func (m *RegisterWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterWorker.Merge(m, src)
+}
+func (m *RegisterWorker) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterWorker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterWorker proto.InternalMessageInfo
+
+func (m *RegisterWorker) GetHostAndPort() string {
+	if m != nil {
+		return m.HostAndPort
 	}
-	for i := range this.AggregatedValues {
-		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
-			return false
+	return ""
+}
+
+type RegisterWorkerAck struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RegisterWorkerAck) Reset()      { *m = RegisterWorkerAck{} }
+func (*RegisterWorkerAck) ProtoMessage() {}
+func (*RegisterWorkerAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{36}
+}
+func (m *RegisterWorkerAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisterWorkerAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisterWorkerAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *ComputeAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *RegisterWorkerAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterWorkerAck.Merge(m, src)
+}
+func (m *RegisterWorkerAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisterWorkerAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterWorkerAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterWorkerAck proto.InternalMessageInfo
+
+func (m *RegisterWorkerAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*ComputeAck)
-	if !ok {
-		that2, ok := that.(ComputeAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// VertexState is state of a vertex moved to another worker
+type VertexState struct {
+	VertexId string     `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
+	Vertex   *types.Any `protobuf:"bytes,2,opt,name=vertex,proto3" json:"vertex,omitempty"`
+	Halted   bool       `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
+	// messages to be handled in the next superstep
+	Messages []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+	// outgoing edges stored by the partition
+	OutEdges *OutEdges `protobuf:"bytes,5,opt,name=out_edges,json=outEdges,proto3" json:"out_edges,omitempty"`
+}
+
+func (m *VertexState) Reset()      { *m = VertexState{} }
+func (*VertexState) ProtoMessage() {}
+func (*VertexState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{37}
+}
+func (m *VertexState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VertexState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VertexState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.VertexId != that1.VertexId {
-		return false
+}
+func (m *VertexState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VertexState.Merge(m, src)
+}
+func (m *VertexState) XXX_Size() int {
+	return m.Size()
+}
+func (m *VertexState) XXX_DiscardUnknown() {
+	xxx_messageInfo_VertexState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VertexState proto.InternalMessageInfo
+
+func (m *VertexState) GetVertexId() string {
+	if m != nil {
+		return m.VertexId
 	}
-	if this.Halted != that1.Halted {
-		return false
+	return ""
+}
+
+func (m *VertexState) GetVertex() *types.Any {
+	if m != nil {
+		return m.Vertex
 	}
-	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
-		return false
+	return nil
+}
+
+func (m *VertexState) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	for i := range this.AggregatedValues {
-		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
-			return false
-		}
+	return false
+}
+
+func (m *VertexState) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
 	}
-	return true
+	return nil
 }
-func (this *ComputePartitionAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *VertexState) GetOutEdges() *OutEdges {
+	if m != nil {
+		return m.OutEdges
 	}
+	return nil
+}
 
-	that1, ok := that.(*ComputePartitionAck)
-	if !ok {
-		that2, ok := that.(ComputePartitionAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// OutEdges is outgoing edges of a vertex moved with it. Values are set by the edge value type of the plugin
+type OutEdges struct {
+	Dests         []string  `protobuf:"bytes,1,rep,name=dests,proto3" json:"dests,omitempty"`
+	Uint32Values  []uint32  `protobuf:"varint,2,rep,packed,name=uint32_values,json=uint32Values,proto3" json:"uint32_values,omitempty"`
+	Float64Values []float64 `protobuf:"fixed64,3,rep,packed,name=float64_values,json=float64Values,proto3" json:"float64_values,omitempty"`
+	BytesValues   [][]byte  `protobuf:"bytes,4,rep,name=bytes_values,json=bytesValues,proto3" json:"bytes_values,omitempty"`
+}
+
+func (m *OutEdges) Reset()      { *m = OutEdges{} }
+func (*OutEdges) ProtoMessage() {}
+func (*OutEdges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{38}
+}
+func (m *OutEdges) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutEdges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutEdges.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PartitionId != that1.PartitionId {
-		return false
-	}
-	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
-		return false
-	}
-	for i := range this.AggregatedValues {
-		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
-			return false
-		}
+}
+func (m *OutEdges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutEdges.Merge(m, src)
+}
+func (m *OutEdges) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutEdges) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutEdges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutEdges proto.InternalMessageInfo
+
+func (m *OutEdges) GetDests() []string {
+	if m != nil {
+		return m.Dests
 	}
-	return true
+	return nil
 }
-func (this *ComputeWorkerAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *OutEdges) GetUint32Values() []uint32 {
+	if m != nil {
+		return m.Uint32Values
 	}
+	return nil
+}
 
-	that1, ok := that.(*ComputeWorkerAck)
-	if !ok {
-		that2, ok := that.(ComputeWorkerAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
+func (m *OutEdges) GetFloat64Values() []float64 {
+	if m != nil {
+		return m.Float64Values
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+	return nil
+}
+
+func (m *OutEdges) GetBytesValues() [][]byte {
+	if m != nil {
+		return m.BytesValues
 	}
-	if !this.WorkerPid.Equal(that1.WorkerPid) {
-		return false
-	}
-	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
-		return false
-	}
-	for i := range this.AggregatedValues {
-		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
-			return false
-		}
-	}
-	return true
+	return nil
 }
-func (this *SuperStepMessage) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*SuperStepMessage)
-	if !ok {
-		that2, ok := that.(SuperStepMessage)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ExportVertex struct {
+}
+
+func (m *ExportVertex) Reset()      { *m = ExportVertex{} }
+func (*ExportVertex) ProtoMessage() {}
+func (*ExportVertex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{39}
+}
+func (m *ExportVertex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportVertex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportVertex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Uuid != that1.Uuid {
-		return false
-	}
-	if this.SuperStep != that1.SuperStep {
-		return false
-	}
-	if this.SrcVertexId != that1.SrcVertexId {
-		return false
-	}
-	if this.DestVertexId != that1.DestVertexId {
-		return false
-	}
-	if !this.Message.Equal(that1.Message) {
-		return false
-	}
-	return true
 }
-func (this *SuperStepMessageAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ExportVertex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportVertex.Merge(m, src)
+}
+func (m *ExportVertex) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportVertex) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportVertex.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*SuperStepMessageAck)
-	if !ok {
-		that2, ok := that.(SuperStepMessageAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ExportVertex proto.InternalMessageInfo
+
+type ExportVertexAck struct {
+	VertexId string       `protobuf:"bytes,1,opt,name=vertex_id,json=vertexId,proto3" json:"vertex_id,omitempty"`
+	State    *VertexState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Error    string       `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ExportVertexAck) Reset()      { *m = ExportVertexAck{} }
+func (*ExportVertexAck) ProtoMessage() {}
+func (*ExportVertexAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{40}
+}
+func (m *ExportVertexAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportVertexAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportVertexAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ExportVertexAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportVertexAck.Merge(m, src)
+}
+func (m *ExportVertexAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportVertexAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportVertexAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportVertexAck proto.InternalMessageInfo
+
+func (m *ExportVertexAck) GetVertexId() string {
+	if m != nil {
+		return m.VertexId
 	}
-	if this.Uuid != that1.Uuid {
-		return false
+	return ""
+}
+
+func (m *ExportVertexAck) GetState() *VertexState {
+	if m != nil {
+		return m.State
 	}
-	return true
+	return nil
 }
-func (this *InitPartition) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ExportVertexAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*InitPartition)
-	if !ok {
-		that2, ok := that.(InitPartition)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ExportPartition responds vertices of the partition. The partition is kept until ReleasePartition
+type ExportPartition struct {
+	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+}
+
+func (m *ExportPartition) Reset()      { *m = ExportPartition{} }
+func (*ExportPartition) ProtoMessage() {}
+func (*ExportPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{41}
+}
+func (m *ExportPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.PartitionId != that1.PartitionId {
-		return false
-	}
-	return true
 }
-func (this *InitPartitionAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ExportPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPartition.Merge(m, src)
+}
+func (m *ExportPartition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPartition proto.InternalMessageInfo
+
+func (m *ExportPartition) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*InitPartitionAck)
-	if !ok {
-		that2, ok := that.(InitPartitionAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ExportPartitionAck struct {
+	PartitionId uint64         `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Vertices    []*VertexState `protobuf:"bytes,2,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Error       string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ExportPartitionAck) Reset()      { *m = ExportPartitionAck{} }
+func (*ExportPartitionAck) ProtoMessage() {}
+func (*ExportPartitionAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{42}
+}
+func (m *ExportPartitionAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportPartitionAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportPartitionAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ExportPartitionAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPartitionAck.Merge(m, src)
+}
+func (m *ExportPartitionAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportPartitionAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPartitionAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportPartitionAck proto.InternalMessageInfo
+
+func (m *ExportPartitionAck) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
-	if this.PartitionId != that1.PartitionId {
-		return false
+	return 0
+}
+
+func (m *ExportPartitionAck) GetVertices() []*VertexState {
+	if m != nil {
+		return m.Vertices
 	}
-	return true
+	return nil
 }
-func (this *ClusterInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ExportPartitionAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*ClusterInfo)
-	if !ok {
-		that2, ok := that.(ClusterInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ImportPartition creates the partition in the worker with exported vertices
+type ImportPartition struct {
+	PartitionId      uint64          `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Vertices         []*VertexState  `protobuf:"bytes,2,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	NumOfPartitions  uint64          `protobuf:"varint,3,opt,name=num_of_partitions,json=numOfPartitions,proto3" json:"num_of_partitions,omitempty"`
+	NumericVertexIds bool            `protobuf:"varint,4,opt,name=numeric_vertex_ids,json=numericVertexIds,proto3" json:"numeric_vertex_ids,omitempty"`
+	Store            *PartitionStore `protobuf:"bytes,5,opt,name=store,proto3" json:"store,omitempty"`
+}
+
+func (m *ImportPartition) Reset()      { *m = ImportPartition{} }
+func (*ImportPartition) ProtoMessage() {}
+func (*ImportPartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{43}
+}
+func (m *ImportPartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportPartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportPartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.WorkerInfo) != len(that1.WorkerInfo) {
-		return false
+}
+func (m *ImportPartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPartition.Merge(m, src)
+}
+func (m *ImportPartition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportPartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportPartition proto.InternalMessageInfo
+
+func (m *ImportPartition) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
-	for i := range this.WorkerInfo {
-		if !this.WorkerInfo[i].Equal(that1.WorkerInfo[i]) {
-			return false
-		}
+	return 0
+}
+
+func (m *ImportPartition) GetVertices() []*VertexState {
+	if m != nil {
+		return m.Vertices
 	}
-	return true
+	return nil
 }
-func (this *ClusterInfo_WorkerInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ImportPartition) GetNumOfPartitions() uint64 {
+	if m != nil {
+		return m.NumOfPartitions
 	}
+	return 0
+}
 
-	that1, ok := that.(*ClusterInfo_WorkerInfo)
-	if !ok {
-		that2, ok := that.(ClusterInfo_WorkerInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.WorkerPid.Equal(that1.WorkerPid) {
-		return false
-	}
-	if len(this.Partitions) != len(that1.Partitions) {
-		return false
-	}
-	for i := range this.Partitions {
-		if this.Partitions[i] != that1.Partitions[i] {
-			return false
-		}
+func (m *ImportPartition) GetNumericVertexIds() bool {
+	if m != nil {
+		return m.NumericVertexIds
 	}
-	return true
+	return false
 }
-func (this *InitWorker) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ImportPartition) GetStore() *PartitionStore {
+	if m != nil {
+		return m.Store
 	}
+	return nil
+}
 
-	that1, ok := that.(*InitWorker)
-	if !ok {
-		that2, ok := that.(InitWorker)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ImportPartitionAck struct {
+	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ImportPartitionAck) Reset()      { *m = ImportPartitionAck{} }
+func (*ImportPartitionAck) ProtoMessage() {}
+func (*ImportPartitionAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{44}
+}
+func (m *ImportPartitionAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportPartitionAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportPartitionAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.Coordinator.Equal(that1.Coordinator) {
-		return false
+}
+func (m *ImportPartitionAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportPartitionAck.Merge(m, src)
+}
+func (m *ImportPartitionAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportPartitionAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportPartitionAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportPartitionAck proto.InternalMessageInfo
+
+func (m *ImportPartitionAck) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
-	if len(this.Partitions) != len(that1.Partitions) {
-		return false
+	return 0
+}
+
+func (m *ImportPartitionAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	for i := range this.Partitions {
-		if this.Partitions[i] != that1.Partitions[i] {
-			return false
+	return ""
+}
+
+// ReleasePartition removes the partition from the worker after it has been imported by another worker
+type ReleasePartition struct {
+	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+}
+
+func (m *ReleasePartition) Reset()      { *m = ReleasePartition{} }
+func (*ReleasePartition) ProtoMessage() {}
+func (*ReleasePartition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{45}
+}
+func (m *ReleasePartition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleasePartition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleasePartition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *InitWorkerAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ReleasePartition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartition.Merge(m, src)
+}
+func (m *ReleasePartition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleasePartition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleasePartition proto.InternalMessageInfo
+
+func (m *ReleasePartition) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*InitWorkerAck)
-	if !ok {
-		that2, ok := that.(InitWorkerAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ExportVertices removes the vertices from the partition then responds them
+type ExportVertices struct {
+	PartitionId uint64   `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	VertexIds   []string `protobuf:"bytes,2,rep,name=vertex_ids,json=vertexIds,proto3" json:"vertex_ids,omitempty"`
+}
+
+func (m *ExportVertices) Reset()      { *m = ExportVertices{} }
+func (*ExportVertices) ProtoMessage() {}
+func (*ExportVertices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{46}
+}
+func (m *ExportVertices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportVertices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportVertices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !this.WorkerPid.Equal(that1.WorkerPid) {
-		return false
+}
+func (m *ExportVertices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportVertices.Merge(m, src)
+}
+func (m *ExportVertices) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportVertices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportVertices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportVertices proto.InternalMessageInfo
+
+func (m *ExportVertices) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
-	return true
+	return 0
 }
-func (this *NewCluster) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ExportVertices) GetVertexIds() []string {
+	if m != nil {
+		return m.VertexIds
 	}
+	return nil
+}
 
-	that1, ok := that.(*NewCluster)
-	if !ok {
-		that2, ok := that.(NewCluster)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ExportVerticesAck struct {
+	PartitionId uint64         `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Vertices    []*VertexState `protobuf:"bytes,2,rep,name=vertices,proto3" json:"vertices,omitempty"`
+	Error       string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ExportVerticesAck) Reset()      { *m = ExportVerticesAck{} }
+func (*ExportVerticesAck) ProtoMessage() {}
+func (*ExportVerticesAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{47}
+}
+func (m *ExportVerticesAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExportVerticesAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExportVerticesAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.Workers) != len(that1.Workers) {
-		return false
-	}
-	for i := range this.Workers {
-		if !this.Workers[i].Equal(that1.Workers[i]) {
-			return false
-		}
+}
+func (m *ExportVerticesAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportVerticesAck.Merge(m, src)
+}
+func (m *ExportVerticesAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExportVerticesAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportVerticesAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportVerticesAck proto.InternalMessageInfo
+
+func (m *ExportVerticesAck) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
-	if this.NrOfPartitions != that1.NrOfPartitions {
-		return false
+	return 0
+}
+
+func (m *ExportVerticesAck) GetVertices() []*VertexState {
+	if m != nil {
+		return m.Vertices
 	}
-	return true
+	return nil
 }
-func (this *NewCluster_WorkerReq) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ExportVerticesAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*NewCluster_WorkerReq)
-	if !ok {
-		that2, ok := that.(NewCluster_WorkerReq)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// ImportVertices adds exported vertices to the partition
+type ImportVertices struct {
+	PartitionId uint64         `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Vertices    []*VertexState `protobuf:"bytes,2,rep,name=vertices,proto3" json:"vertices,omitempty"`
+}
+
+func (m *ImportVertices) Reset()      { *m = ImportVertices{} }
+func (*ImportVertices) ProtoMessage() {}
+func (*ImportVertices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{48}
+}
+func (m *ImportVertices) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportVertices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportVertices.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Remote != that1.Remote {
-		return false
-	}
-	if this.HostAndPort != that1.HostAndPort {
-		return false
-	}
-	return true
 }
-func (this *NewClusterAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *ImportVertices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportVertices.Merge(m, src)
+}
+func (m *ImportVertices) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportVertices) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportVertices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportVertices proto.InternalMessageInfo
+
+func (m *ImportVertices) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
+	return 0
+}
 
-	that1, ok := that.(*NewClusterAck)
-	if !ok {
-		that2, ok := that.(NewClusterAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+func (m *ImportVertices) GetVertices() []*VertexState {
+	if m != nil {
+		return m.Vertices
 	}
-	return true
+	return nil
 }
-func (this *CoordinatorStats) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*CoordinatorStats)
-	if !ok {
-		that2, ok := that.(CoordinatorStats)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type ImportVerticesAck struct {
+	PartitionId uint64 `protobuf:"varint,1,opt,name=partition_id,json=partitionId,proto3" json:"partition_id,omitempty"`
+	Error       string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ImportVerticesAck) Reset()      { *m = ImportVerticesAck{} }
+func (*ImportVerticesAck) ProtoMessage() {}
+func (*ImportVerticesAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{49}
+}
+func (m *ImportVerticesAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ImportVerticesAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ImportVerticesAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *ImportVerticesAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportVerticesAck.Merge(m, src)
+}
+func (m *ImportVerticesAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ImportVerticesAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportVerticesAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportVerticesAck proto.InternalMessageInfo
+
+func (m *ImportVerticesAck) GetPartitionId() uint64 {
+	if m != nil {
+		return m.PartitionId
 	}
-	return true
+	return 0
 }
-func (this *CoordinatorStatsAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *ImportVerticesAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*CoordinatorStatsAck)
-	if !ok {
-		that2, ok := that.(CoordinatorStatsAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AddWorker struct {
+	Remote      bool   `protobuf:"varint,1,opt,name=remote,proto3" json:"remote,omitempty"`
+	HostAndPort string `protobuf:"bytes,2,opt,name=host_and_port,json=hostAndPort,proto3" json:"host_and_port,omitempty"`
+}
+
+func (m *AddWorker) Reset()      { *m = AddWorker{} }
+func (*AddWorker) ProtoMessage() {}
+func (*AddWorker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{50}
+}
+func (m *AddWorker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddWorker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.SuperStep != that1.SuperStep {
-		return false
-	}
-	if this.NrOfActiveVertex != that1.NrOfActiveVertex {
-		return false
-	}
-	if this.NrOfSentMessages != that1.NrOfSentMessages {
-		return false
-	}
-	if this.State != that1.State {
-		return false
+}
+func (m *AddWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWorker.Merge(m, src)
+}
+func (m *AddWorker) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWorker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWorker proto.InternalMessageInfo
+
+func (m *AddWorker) GetRemote() bool {
+	if m != nil {
+		return m.Remote
 	}
-	return true
+	return false
 }
-func (this *StartSuperStep) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AddWorker) GetHostAndPort() string {
+	if m != nil {
+		return m.HostAndPort
 	}
+	return ""
+}
 
-	that1, ok := that.(*StartSuperStep)
-	if !ok {
-		that2, ok := that.(StartSuperStep)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type AddWorkerAck struct {
+	WorkerPid *actor.PID `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	Error     string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *AddWorkerAck) Reset()      { *m = AddWorkerAck{} }
+func (*AddWorkerAck) ProtoMessage() {}
+func (*AddWorkerAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{51}
+}
+func (m *AddWorkerAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddWorkerAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddWorkerAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *AddWorkerAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWorkerAck.Merge(m, src)
+}
+func (m *AddWorkerAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddWorkerAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWorkerAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWorkerAck proto.InternalMessageInfo
+
+func (m *AddWorkerAck) GetWorkerPid() *actor.PID {
+	if m != nil {
+		return m.WorkerPid
 	}
-	return true
+	return nil
 }
-func (this *ShowAggregatedValue) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *AddWorkerAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*ShowAggregatedValue)
-	if !ok {
-		that2, ok := that.(ShowAggregatedValue)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DrainWorker struct {
+	// address or id of the worker
+	Worker string `protobuf:"bytes,1,opt,name=worker,proto3" json:"worker,omitempty"`
+}
+
+func (m *DrainWorker) Reset()      { *m = DrainWorker{} }
+func (*DrainWorker) ProtoMessage() {}
+func (*DrainWorker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{52}
+}
+func (m *DrainWorker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainWorker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainWorker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *ShowAggregatedValueAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *DrainWorker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainWorker.Merge(m, src)
+}
+func (m *DrainWorker) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainWorker) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainWorker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainWorker proto.InternalMessageInfo
+
+func (m *DrainWorker) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
+	return ""
+}
 
-	that1, ok := that.(*ShowAggregatedValueAck)
-	if !ok {
-		that2, ok := that.(ShowAggregatedValueAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type DrainWorkerAck struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DrainWorkerAck) Reset()      { *m = DrainWorkerAck{} }
+func (*DrainWorkerAck) ProtoMessage() {}
+func (*DrainWorkerAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{53}
+}
+func (m *DrainWorkerAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainWorkerAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainWorkerAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
-		return false
+}
+func (m *DrainWorkerAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainWorkerAck.Merge(m, src)
+}
+func (m *DrainWorkerAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainWorkerAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainWorkerAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainWorkerAck proto.InternalMessageInfo
+
+func (m *DrainWorkerAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	for i := range this.AggregatedValues {
-		if this.AggregatedValues[i] != that1.AggregatedValues[i] {
-			return false
+	return ""
+}
+
+// RebalanceWorkers moves partitions so that loads of workers are balanced
+type RebalanceWorkers struct {
+}
+
+func (m *RebalanceWorkers) Reset()      { *m = RebalanceWorkers{} }
+func (*RebalanceWorkers) ProtoMessage() {}
+func (*RebalanceWorkers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{54}
+}
+func (m *RebalanceWorkers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceWorkers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceWorkers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *Shutdown) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RebalanceWorkers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceWorkers.Merge(m, src)
+}
+func (m *RebalanceWorkers) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceWorkers) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceWorkers.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Shutdown)
-	if !ok {
-		that2, ok := that.(Shutdown)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_RebalanceWorkers proto.InternalMessageInfo
+
+type RebalanceWorkersAck struct {
+	MovedPartitions uint32 `protobuf:"varint,1,opt,name=moved_partitions,json=movedPartitions,proto3" json:"moved_partitions,omitempty"`
+	Error           string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RebalanceWorkersAck) Reset()      { *m = RebalanceWorkersAck{} }
+func (*RebalanceWorkersAck) ProtoMessage() {}
+func (*RebalanceWorkersAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{55}
+}
+func (m *RebalanceWorkersAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RebalanceWorkersAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RebalanceWorkersAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
+}
+func (m *RebalanceWorkersAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceWorkersAck.Merge(m, src)
+}
+func (m *RebalanceWorkersAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *RebalanceWorkersAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceWorkersAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceWorkersAck proto.InternalMessageInfo
+
+func (m *RebalanceWorkersAck) GetMovedPartitions() uint32 {
+	if m != nil {
+		return m.MovedPartitions
 	}
-	return true
+	return 0
 }
-func (this *ShutdownAck) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+
+func (m *RebalanceWorkersAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
+	return ""
+}
 
-	that1, ok := that.(*ShutdownAck)
-	if !ok {
-		that2, ok := that.(ShutdownAck)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+type CoordinatorStats struct {
+}
+
+func (m *CoordinatorStats) Reset()      { *m = CoordinatorStats{} }
+func (*CoordinatorStats) ProtoMessage() {}
+func (*CoordinatorStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{56}
+}
+func (m *CoordinatorStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoordinatorStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoordinatorStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	return true
 }
-func (this *LoadVertex) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.LoadVertex{")
-	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *CoordinatorStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoordinatorStats.Merge(m, src)
 }
-func (this *LoadVertexAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.LoadVertexAck{")
-	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
-	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *CoordinatorStats) XXX_Size() int {
+	return m.Size()
 }
-func (this *LoadPartitionVertices) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.LoadPartitionVertices{")
-	s = append(s, "NumOfPartitions: "+fmt.Sprintf("%#v", this.NumOfPartitions)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *CoordinatorStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoordinatorStats.DiscardUnknown(m)
 }
-func (this *LoadPartitionVerticesAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.LoadPartitionVerticesAck{")
-	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
-	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+var xxx_messageInfo_CoordinatorStats proto.InternalMessageInfo
+
+type CoordinatorStatsAck struct {
+	SuperStep        uint64   `protobuf:"varint,1,opt,name=super_step,json=superStep,proto3" json:"super_step,omitempty"`
+	NrOfActiveVertex uint64   `protobuf:"varint,2,opt,name=nr_of_active_vertex,json=nrOfActiveVertex,proto3" json:"nr_of_active_vertex,omitempty"`
+	NrOfSentMessages uint64   `protobuf:"varint,3,opt,name=nr_of_sent_messages,json=nrOfSentMessages,proto3" json:"nr_of_sent_messages,omitempty"`
+	State            string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Stage            string   `protobuf:"bytes,5,opt,name=stage,proto3" json:"stage,omitempty"`
+	Algorithm        string   `protobuf:"bytes,6,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	Workers          []string `protobuf:"bytes,7,rep,name=workers,proto3" json:"workers,omitempty"`
+	// error which stopped the last supersteps, e.g. returned by Compute()
+	Error string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 }
-func (this *LoadPartitionVerticesWorkerAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.LoadPartitionVerticesWorkerAck{")
-	if this.WorkerPid != nil {
-		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+
+func (m *CoordinatorStatsAck) Reset()      { *m = CoordinatorStatsAck{} }
+func (*CoordinatorStatsAck) ProtoMessage() {}
+func (*CoordinatorStatsAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{57}
+}
+func (m *CoordinatorStatsAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoordinatorStatsAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoordinatorStatsAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *GetVertexValue) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *CoordinatorStatsAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoordinatorStatsAck.Merge(m, src)
+}
+func (m *CoordinatorStatsAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoordinatorStatsAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoordinatorStatsAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoordinatorStatsAck proto.InternalMessageInfo
+
+func (m *CoordinatorStatsAck) GetSuperStep() uint64 {
+	if m != nil {
+		return m.SuperStep
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.GetVertexValue{")
-	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *GetVertexValueAck) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *CoordinatorStatsAck) GetNrOfActiveVertex() uint64 {
+	if m != nil {
+		return m.NrOfActiveVertex
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.GetVertexValueAck{")
-	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
-	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *SuperStepBarrier) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *CoordinatorStatsAck) GetNrOfSentMessages() uint64 {
+	if m != nil {
+		return m.NrOfSentMessages
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.SuperStepBarrier{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *SuperStepBarrierAck) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *CoordinatorStatsAck) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.SuperStepBarrierAck{")
-	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *SuperStepBarrierPartitionAck) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *CoordinatorStatsAck) GetStage() string {
+	if m != nil {
+		return m.Stage
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.SuperStepBarrierPartitionAck{")
-	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *SuperStepBarrierWorkerAck) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *CoordinatorStatsAck) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.SuperStepBarrierWorkerAck{")
-	if this.WorkerPid != nil {
-		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	return ""
+}
+
+func (m *CoordinatorStatsAck) GetWorkers() []string {
+	if m != nil {
+		return m.Workers
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *Compute) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *CoordinatorStatsAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.Compute{")
-	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
-	}
-	mapStringForAggregatedValues += "}"
-	if this.AggregatedValues != nil {
-		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return ""
 }
-func (this *ComputeAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 7)
-	s = append(s, "&command.ComputeAck{")
-	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
-	s = append(s, "Halted: "+fmt.Sprintf("%#v", this.Halted)+",\n")
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
-	}
-	mapStringForAggregatedValues += "}"
-	if this.AggregatedValues != nil {
-		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+// ShowPartitions reports quality of partitioning. Traffic is accumulated over supersteps since vertices are loaded
+type ShowPartitions struct {
 }
-func (this *ComputePartitionAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.ComputePartitionAck{")
-	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
-	}
-	mapStringForAggregatedValues += "}"
-	if this.AggregatedValues != nil {
-		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *ShowPartitions) Reset()      { *m = ShowPartitions{} }
+func (*ShowPartitions) ProtoMessage() {}
+func (*ShowPartitions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{58}
 }
-func (this *ComputeWorkerAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.ComputeWorkerAck{")
-	if this.WorkerPid != nil {
-		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
-	}
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
-	}
-	mapStringForAggregatedValues += "}"
-	if this.AggregatedValues != nil {
-		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *SuperStepMessage) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 9)
-	s = append(s, "&command.SuperStepMessage{")
-	s = append(s, "Uuid: "+fmt.Sprintf("%#v", this.Uuid)+",\n")
-	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
-	s = append(s, "SrcVertexId: "+fmt.Sprintf("%#v", this.SrcVertexId)+",\n")
-	s = append(s, "DestVertexId: "+fmt.Sprintf("%#v", this.DestVertexId)+",\n")
-	if this.Message != nil {
-		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+func (m *ShowPartitions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowPartitions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *SuperStepMessageAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.SuperStepMessageAck{")
-	s = append(s, "Uuid: "+fmt.Sprintf("%#v", this.Uuid)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitions.Merge(m, src)
 }
-func (this *InitPartition) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.InitPartition{")
-	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitions) XXX_Size() int {
+	return m.Size()
 }
-func (this *InitPartitionAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.InitPartitionAck{")
-	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitions.DiscardUnknown(m)
 }
-func (this *ClusterInfo) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.ClusterInfo{")
-	if this.WorkerInfo != nil {
-		s = append(s, "WorkerInfo: "+fmt.Sprintf("%#v", this.WorkerInfo)+",\n")
+
+var xxx_messageInfo_ShowPartitions proto.InternalMessageInfo
+
+type ShowPartitionsAck struct {
+	Partitions     []*ShowPartitionsAck_Partition `protobuf:"bytes,1,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Traffic        []*PartitionTraffic            `protobuf:"bytes,2,rep,name=traffic,proto3" json:"traffic,omitempty"`
+	SuperSteps     uint64                         `protobuf:"varint,3,opt,name=super_steps,json=superSteps,proto3" json:"super_steps,omitempty"`
+	Edges          uint64                         `protobuf:"varint,4,opt,name=edges,proto3" json:"edges,omitempty"`
+	CutEdges       uint64                         `protobuf:"varint,5,opt,name=cut_edges,json=cutEdges,proto3" json:"cut_edges,omitempty"`
+	LocalMessages  uint64                         `protobuf:"varint,6,opt,name=local_messages,json=localMessages,proto3" json:"local_messages,omitempty"`
+	RemoteMessages uint64                         `protobuf:"varint,7,opt,name=remote_messages,json=remoteMessages,proto3" json:"remote_messages,omitempty"`
+	MovedVertices  uint64                         `protobuf:"varint,8,opt,name=moved_vertices,json=movedVertices,proto3" json:"moved_vertices,omitempty"`
+	MessageBatches *MessageBatchStats             `protobuf:"bytes,9,opt,name=message_batches,json=messageBatches,proto3" json:"message_batches,omitempty"`
+	FlowControl    *FlowControlStats              `protobuf:"bytes,10,opt,name=flow_control,json=flowControl,proto3" json:"flow_control,omitempty"`
+}
+
+func (m *ShowPartitionsAck) Reset()      { *m = ShowPartitionsAck{} }
+func (*ShowPartitionsAck) ProtoMessage() {}
+func (*ShowPartitionsAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{59}
+}
+func (m *ShowPartitionsAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShowPartitionsAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowPartitionsAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *ClusterInfo_WorkerInfo) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *ShowPartitionsAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsAck.Merge(m, src)
+}
+func (m *ShowPartitionsAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShowPartitionsAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShowPartitionsAck proto.InternalMessageInfo
+
+func (m *ShowPartitionsAck) GetPartitions() []*ShowPartitionsAck_Partition {
+	if m != nil {
+		return m.Partitions
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.ClusterInfo_WorkerInfo{")
-	if this.WorkerPid != nil {
-		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	return nil
+}
+
+func (m *ShowPartitionsAck) GetTraffic() []*PartitionTraffic {
+	if m != nil {
+		return m.Traffic
 	}
-	s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *InitWorker) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShowPartitionsAck) GetSuperSteps() uint64 {
+	if m != nil {
+		return m.SuperSteps
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.InitWorker{")
-	if this.Coordinator != nil {
-		s = append(s, "Coordinator: "+fmt.Sprintf("%#v", this.Coordinator)+",\n")
+	return 0
+}
+
+func (m *ShowPartitionsAck) GetEdges() uint64 {
+	if m != nil {
+		return m.Edges
 	}
-	s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *InitWorkerAck) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShowPartitionsAck) GetCutEdges() uint64 {
+	if m != nil {
+		return m.CutEdges
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.InitWorkerAck{")
-	if this.WorkerPid != nil {
-		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	return 0
+}
+
+func (m *ShowPartitionsAck) GetLocalMessages() uint64 {
+	if m != nil {
+		return m.LocalMessages
 	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *NewCluster) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShowPartitionsAck) GetRemoteMessages() uint64 {
+	if m != nil {
+		return m.RemoteMessages
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.NewCluster{")
-	if this.Workers != nil {
-		s = append(s, "Workers: "+fmt.Sprintf("%#v", this.Workers)+",\n")
+	return 0
+}
+
+func (m *ShowPartitionsAck) GetMovedVertices() uint64 {
+	if m != nil {
+		return m.MovedVertices
 	}
-	s = append(s, "NrOfPartitions: "+fmt.Sprintf("%#v", this.NrOfPartitions)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return 0
 }
-func (this *NewCluster_WorkerReq) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShowPartitionsAck) GetMessageBatches() *MessageBatchStats {
+	if m != nil {
+		return m.MessageBatches
 	}
-	s := make([]string, 0, 6)
-	s = append(s, "&command.NewCluster_WorkerReq{")
-	s = append(s, "Remote: "+fmt.Sprintf("%#v", this.Remote)+",\n")
-	s = append(s, "HostAndPort: "+fmt.Sprintf("%#v", this.HostAndPort)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *NewClusterAck) GoString() string {
-	if this == nil {
-		return "nil"
+
+func (m *ShowPartitionsAck) GetFlowControl() *FlowControlStats {
+	if m != nil {
+		return m.FlowControl
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.NewClusterAck{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+	return nil
 }
-func (this *CoordinatorStats) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.CoordinatorStats{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+type ShowPartitionsAck_Partition struct {
+	Stats  *PartitionStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Worker string          `protobuf:"bytes,2,opt,name=worker,proto3" json:"worker,omitempty"`
 }
-func (this *CoordinatorStatsAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 8)
-	s = append(s, "&command.CoordinatorStatsAck{")
-	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
-	s = append(s, "NrOfActiveVertex: "+fmt.Sprintf("%#v", this.NrOfActiveVertex)+",\n")
-	s = append(s, "NrOfSentMessages: "+fmt.Sprintf("%#v", this.NrOfSentMessages)+",\n")
-	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
-	s = append(s, "}")
-	return strings.Join(s, "")
+
+func (m *ShowPartitionsAck_Partition) Reset()      { *m = ShowPartitionsAck_Partition{} }
+func (*ShowPartitionsAck_Partition) ProtoMessage() {}
+func (*ShowPartitionsAck_Partition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{59, 0}
 }
-func (this *StartSuperStep) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.StartSuperStep{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitionsAck_Partition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (this *ShowAggregatedValue) GoString() string {
-	if this == nil {
-		return "nil"
+func (m *ShowPartitionsAck_Partition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowPartitionsAck_Partition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.ShowAggregatedValue{")
-	s = append(s, "}")
-	return strings.Join(s, "")
 }
-func (this *ShowAggregatedValueAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 5)
-	s = append(s, "&command.ShowAggregatedValueAck{")
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]string{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
-	}
-	mapStringForAggregatedValues += "}"
-	if this.AggregatedValues != nil {
-		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
-	}
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitionsAck_Partition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsAck_Partition.Merge(m, src)
 }
-func (this *Shutdown) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.Shutdown{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitionsAck_Partition) XXX_Size() int {
+	return m.Size()
 }
-func (this *ShutdownAck) GoString() string {
-	if this == nil {
-		return "nil"
-	}
-	s := make([]string, 0, 4)
-	s = append(s, "&command.ShutdownAck{")
-	s = append(s, "}")
-	return strings.Join(s, "")
+func (m *ShowPartitionsAck_Partition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsAck_Partition.DiscardUnknown(m)
 }
-func valueToGoStringCommand(v interface{}, typ string) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+var xxx_messageInfo_ShowPartitionsAck_Partition proto.InternalMessageInfo
+
+func (m *ShowPartitionsAck_Partition) GetStats() *PartitionStats {
+	if m != nil {
+		return m.Stats
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+	return nil
 }
-func (m *LoadVertex) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+
+func (m *ShowPartitionsAck_Partition) GetWorker() string {
+	if m != nil {
+		return m.Worker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LoadVertex) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.VertexId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
-		i += copy(dAtA[i:], m.VertexId)
-	}
-	return i, nil
+type StartSuperStep struct {
 }
 
-func (m *LoadVertexAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StartSuperStep) Reset()      { *m = StartSuperStep{} }
+func (*StartSuperStep) ProtoMessage() {}
+func (*StartSuperStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{60}
+}
+func (m *StartSuperStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartSuperStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartSuperStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StartSuperStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartSuperStep.Merge(m, src)
+}
+func (m *StartSuperStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartSuperStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartSuperStep.DiscardUnknown(m)
 }
 
-func (m *LoadVertexAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.VertexId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
-		i += copy(dAtA[i:], m.VertexId)
-	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
-	}
-	return i, nil
+var xxx_messageInfo_StartSuperStep proto.InternalMessageInfo
+
+type SelectAlgorithm struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *LoadPartitionVertices) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *SelectAlgorithm) Reset()      { *m = SelectAlgorithm{} }
+func (*SelectAlgorithm) ProtoMessage() {}
+func (*SelectAlgorithm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{61}
+}
+func (m *SelectAlgorithm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelectAlgorithm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelectAlgorithm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *SelectAlgorithm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectAlgorithm.Merge(m, src)
+}
+func (m *SelectAlgorithm) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelectAlgorithm) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectAlgorithm.DiscardUnknown(m)
 }
 
-func (m *LoadPartitionVertices) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.NumOfPartitions != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.NumOfPartitions))
+var xxx_messageInfo_SelectAlgorithm proto.InternalMessageInfo
+
+func (m *SelectAlgorithm) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *LoadPartitionVerticesAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type SelectAlgorithmAck struct {
+	WorkerPid *actor.PID `protobuf:"bytes,1,opt,name=worker_pid,json=workerPid,proto3" json:"worker_pid,omitempty"`
+	Error     string     `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *LoadPartitionVerticesAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
-	}
-	if len(m.Error) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
-		i += copy(dAtA[i:], m.Error)
+func (m *SelectAlgorithmAck) Reset()      { *m = SelectAlgorithmAck{} }
+func (*SelectAlgorithmAck) ProtoMessage() {}
+func (*SelectAlgorithmAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{62}
+}
+func (m *SelectAlgorithmAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SelectAlgorithmAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SelectAlgorithmAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *SelectAlgorithmAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectAlgorithmAck.Merge(m, src)
+}
+func (m *SelectAlgorithmAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *SelectAlgorithmAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectAlgorithmAck.DiscardUnknown(m)
 }
 
-func (m *LoadPartitionVerticesWorkerAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SelectAlgorithmAck proto.InternalMessageInfo
+
+func (m *SelectAlgorithmAck) GetWorkerPid() *actor.PID {
+	if m != nil {
+		return m.WorkerPid
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LoadPartitionVerticesWorkerAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
-		n1, err := m.WorkerPid.MarshalTo(dAtA[i:])
+func (m *SelectAlgorithmAck) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PipelineStage struct {
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Params map[string]string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *PipelineStage) Reset()      { *m = PipelineStage{} }
+func (*PipelineStage) ProtoMessage() {}
+func (*PipelineStage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{63}
+}
+func (m *PipelineStage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PipelineStage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PipelineStage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *PipelineStage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PipelineStage.Merge(m, src)
+}
+func (m *PipelineStage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PipelineStage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PipelineStage.DiscardUnknown(m)
 }
 
-func (m *GetVertexValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PipelineStage proto.InternalMessageInfo
+
+func (m *PipelineStage) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetVertexValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.VertexId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
-		i += copy(dAtA[i:], m.VertexId)
+func (m *PipelineStage) GetParams() map[string]string {
+	if m != nil {
+		return m.Params
 	}
-	return i, nil
+	return nil
 }
 
-func (m *GetVertexValueAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+type StartPipeline struct {
+	Stages []*PipelineStage `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+}
+
+func (m *StartPipeline) Reset()      { *m = StartPipeline{} }
+func (*StartPipeline) ProtoMessage() {}
+func (*StartPipeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{64}
+}
+func (m *StartPipeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartPipeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartPipeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StartPipeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartPipeline.Merge(m, src)
+}
+func (m *StartPipeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartPipeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartPipeline.DiscardUnknown(m)
 }
 
-func (m *GetVertexValueAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.VertexId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
-		i += copy(dAtA[i:], m.VertexId)
+var xxx_messageInfo_StartPipeline proto.InternalMessageInfo
+
+func (m *StartPipeline) GetStages() []*PipelineStage {
+	if m != nil {
+		return m.Stages
 	}
-	if len(m.Value) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
+	return nil
+}
+
+type StartPipelineAck struct {
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *StartPipelineAck) Reset()      { *m = StartPipelineAck{} }
+func (*StartPipelineAck) ProtoMessage() {}
+func (*StartPipelineAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{65}
+}
+func (m *StartPipelineAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartPipelineAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartPipelineAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *StartPipelineAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartPipelineAck.Merge(m, src)
+}
+func (m *StartPipelineAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartPipelineAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartPipelineAck.DiscardUnknown(m)
 }
 
-func (m *SuperStepBarrier) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StartPipelineAck proto.InternalMessageInfo
+
+func (m *StartPipelineAck) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SuperStepBarrier) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+type StageStats struct {
+	Name             string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SuperSteps       uint64 `protobuf:"varint,2,opt,name=super_steps,json=superSteps,proto3" json:"super_steps,omitempty"`
+	NrOfSentMessages uint64 `protobuf:"varint,3,opt,name=nr_of_sent_messages,json=nrOfSentMessages,proto3" json:"nr_of_sent_messages,omitempty"`
+	ElapsedMillis    int64  `protobuf:"varint,4,opt,name=elapsed_millis,json=elapsedMillis,proto3" json:"elapsed_millis,omitempty"`
+	Completed        bool   `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
 }
 
-func (m *SuperStepBarrierAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StageStats) Reset()      { *m = StageStats{} }
+func (*StageStats) ProtoMessage() {}
+func (*StageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{66}
+}
+func (m *StageStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StageStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StageStats.Merge(m, src)
+}
+func (m *StageStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *StageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StageStats.DiscardUnknown(m)
 }
 
-func (m *SuperStepBarrierAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.VertexId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
-		i += copy(dAtA[i:], m.VertexId)
+var xxx_messageInfo_StageStats proto.InternalMessageInfo
+
+func (m *StageStats) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return i, nil
+	return ""
 }
 
-func (m *SuperStepBarrierPartitionAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StageStats) GetSuperSteps() uint64 {
+	if m != nil {
+		return m.SuperSteps
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SuperStepBarrierPartitionAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+func (m *StageStats) GetNrOfSentMessages() uint64 {
+	if m != nil {
+		return m.NrOfSentMessages
 	}
-	return i, nil
+	return 0
 }
 
-func (m *SuperStepBarrierWorkerAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *StageStats) GetElapsedMillis() int64 {
+	if m != nil {
+		return m.ElapsedMillis
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SuperStepBarrierWorkerAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
-		n2, err := m.WorkerPid.MarshalTo(dAtA[i:])
+func (m *StageStats) GetCompleted() bool {
+	if m != nil {
+		return m.Completed
+	}
+	return false
+}
+
+type ShowPipeline struct {
+}
+
+func (m *ShowPipeline) Reset()      { *m = ShowPipeline{} }
+func (*ShowPipeline) ProtoMessage() {}
+func (*ShowPipeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{67}
+}
+func (m *ShowPipeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShowPipeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowPipeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ShowPipeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPipeline.Merge(m, src)
+}
+func (m *ShowPipeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShowPipeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPipeline.DiscardUnknown(m)
 }
 
-func (m *Compute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_ShowPipeline proto.InternalMessageInfo
+
+type ShowPipelineAck struct {
+	Stages []*StageStats `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
 }
 
-func (m *Compute) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SuperStep != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
-	}
-	if len(m.AggregatedValues) > 0 {
-		for k, _ := range m.AggregatedValues {
-			dAtA[i] = 0x12
-			i++
-			v := m.AggregatedValues[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovCommand(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
-			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
-				n3, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n3
-			}
+func (m *ShowPipelineAck) Reset()      { *m = ShowPipelineAck{} }
+func (*ShowPipelineAck) ProtoMessage() {}
+func (*ShowPipelineAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{68}
+}
+func (m *ShowPipelineAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShowPipelineAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowPipelineAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *ComputeAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ShowPipelineAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPipelineAck.Merge(m, src)
+}
+func (m *ShowPipelineAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShowPipelineAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPipelineAck.DiscardUnknown(m)
 }
 
-func (m *ComputeAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.VertexId) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
-		i += copy(dAtA[i:], m.VertexId)
-	}
-	if m.Halted {
-		dAtA[i] = 0x10
-		i++
-		if m.Halted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.AggregatedValues) > 0 {
-		for k, _ := range m.AggregatedValues {
-			dAtA[i] = 0x1a
-			i++
-			v := m.AggregatedValues[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovCommand(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
-			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
-				n4, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n4
-			}
-		}
+var xxx_messageInfo_ShowPipelineAck proto.InternalMessageInfo
+
+func (m *ShowPipelineAck) GetStages() []*StageStats {
+	if m != nil {
+		return m.Stages
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ComputePartitionAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type ShowAggregatedValue struct {
 }
 
-func (m *ComputePartitionAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
-	}
-	if len(m.AggregatedValues) > 0 {
-		for k, _ := range m.AggregatedValues {
-			dAtA[i] = 0x12
-			i++
-			v := m.AggregatedValues[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovCommand(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
-			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
-				n5, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n5
-			}
+func (m *ShowAggregatedValue) Reset()      { *m = ShowAggregatedValue{} }
+func (*ShowAggregatedValue) ProtoMessage() {}
+func (*ShowAggregatedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{69}
+}
+func (m *ShowAggregatedValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShowAggregatedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowAggregatedValue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ShowAggregatedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowAggregatedValue.Merge(m, src)
+}
+func (m *ShowAggregatedValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShowAggregatedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowAggregatedValue.DiscardUnknown(m)
 }
 
-func (m *ComputeWorkerAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_ShowAggregatedValue proto.InternalMessageInfo
+
+type ShowAggregatedValueAck struct {
+	AggregatedValues map[string]string `protobuf:"bytes,1,rep,name=aggregated_values,json=aggregatedValues,proto3" json:"aggregated_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *ComputeWorkerAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
-		n6, err := m.WorkerPid.MarshalTo(dAtA[i:])
+func (m *ShowAggregatedValueAck) Reset()      { *m = ShowAggregatedValueAck{} }
+func (*ShowAggregatedValueAck) ProtoMessage() {}
+func (*ShowAggregatedValueAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{70}
+}
+func (m *ShowAggregatedValueAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShowAggregatedValueAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShowAggregatedValueAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n6
-	}
-	if len(m.AggregatedValues) > 0 {
-		for k, _ := range m.AggregatedValues {
-			dAtA[i] = 0x12
-			i++
-			v := m.AggregatedValues[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovCommand(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
-			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
-				n7, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n7
-			}
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ShowAggregatedValueAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowAggregatedValueAck.Merge(m, src)
+}
+func (m *ShowAggregatedValueAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShowAggregatedValueAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowAggregatedValueAck.DiscardUnknown(m)
 }
 
-func (m *SuperStepMessage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ShowAggregatedValueAck proto.InternalMessageInfo
+
+func (m *ShowAggregatedValueAck) GetAggregatedValues() map[string]string {
+	if m != nil {
+		return m.AggregatedValues
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SuperStepMessage) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uuid) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.Uuid)))
-		i += copy(dAtA[i:], m.Uuid)
-	}
-	if m.SuperStep != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
-	}
-	if len(m.SrcVertexId) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.SrcVertexId)))
-		i += copy(dAtA[i:], m.SrcVertexId)
-	}
-	if len(m.DestVertexId) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.DestVertexId)))
-		i += copy(dAtA[i:], m.DestVertexId)
-	}
-	if m.Message != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.Message.Size()))
-		n8, err := m.Message.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n8
-	}
-	return i, nil
+type Shutdown struct {
 }
 
-func (m *SuperStepMessageAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Shutdown) Reset()      { *m = Shutdown{} }
+func (*Shutdown) ProtoMessage() {}
+func (*Shutdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{71}
 }
-
-func (m *SuperStepMessageAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Uuid) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.Uuid)))
-		i += copy(dAtA[i:], m.Uuid)
-	}
-	return i, nil
+func (m *Shutdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *InitPartition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Shutdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Shutdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *InitPartition) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
-	}
-	return i, nil
+func (m *Shutdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shutdown.Merge(m, src)
 }
-
-func (m *InitPartitionAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Shutdown) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *InitPartitionAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
-	}
-	return i, nil
+func (m *Shutdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shutdown.DiscardUnknown(m)
 }
 
-func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_Shutdown proto.InternalMessageInfo
 
-func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.WorkerInfo) > 0 {
-		for _, msg := range m.WorkerInfo {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	return i, nil
+type ShutdownAck struct {
 }
 
-func (m *ClusterInfo_WorkerInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ShutdownAck) Reset()      { *m = ShutdownAck{} }
+func (*ShutdownAck) ProtoMessage() {}
+func (*ShutdownAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_213c0bb044472049, []int{72}
 }
-
-func (m *ClusterInfo_WorkerInfo) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
-		n9, err := m.WorkerPid.MarshalTo(dAtA[i:])
+func (m *ShutdownAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShutdownAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ShutdownAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n9
-	}
-	if len(m.Partitions) > 0 {
-		dAtA11 := make([]byte, len(m.Partitions)*10)
-		var j10 int
-		for _, num := range m.Partitions {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
+			return nil, err
 		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(j10))
-		i += copy(dAtA[i:], dAtA11[:j10])
+		return b[:n], nil
 	}
-	return i, nil
 }
-
-func (m *InitWorker) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ShutdownAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShutdownAck.Merge(m, src)
 }
-
-func (m *InitWorker) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Coordinator != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.Coordinator.Size()))
-		n12, err := m.Coordinator.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n12
-	}
-	if len(m.Partitions) > 0 {
-		dAtA14 := make([]byte, len(m.Partitions)*10)
-		var j13 int
-		for _, num := range m.Partitions {
-			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j13++
-			}
-			dAtA14[j13] = uint8(num)
-			j13++
-		}
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(j13))
-		i += copy(dAtA[i:], dAtA14[:j13])
-	}
-	return i, nil
+func (m *ShutdownAck) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *InitWorkerAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ShutdownAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShutdownAck.DiscardUnknown(m)
 }
 
-func (m *InitWorkerAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
-		n15, err := m.WorkerPid.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n15
-	}
-	return i, nil
-}
+var xxx_messageInfo_ShutdownAck proto.InternalMessageInfo
 
-func (m *NewCluster) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("BatchCompression", BatchCompression_name, BatchCompression_value)
+	proto.RegisterType((*LoadVertex)(nil), "LoadVertex")
+	proto.RegisterType((*LoadVertexAck)(nil), "LoadVertexAck")
+	proto.RegisterType((*LoadPartitionVertices)(nil), "LoadPartitionVertices")
+	proto.RegisterType((*LoadPartitionVerticesAck)(nil), "LoadPartitionVerticesAck")
+	proto.RegisterType((*LoadPartitionVerticesWorkerAck)(nil), "LoadPartitionVerticesWorkerAck")
+	proto.RegisterType((*PartitionStats)(nil), "PartitionStats")
+	proto.RegisterType((*PartitionTraffic)(nil), "PartitionTraffic")
+	proto.RegisterType((*GetVertexValue)(nil), "GetVertexValue")
+	proto.RegisterType((*GetVertexValueAck)(nil), "GetVertexValueAck")
+	proto.RegisterType((*SuperStepBarrier)(nil), "SuperStepBarrier")
+	proto.RegisterType((*SuperStepBarrierAck)(nil), "SuperStepBarrierAck")
+	proto.RegisterType((*SuperStepBarrierPartitionAck)(nil), "SuperStepBarrierPartitionAck")
+	proto.RegisterType((*SuperStepBarrierWorkerAck)(nil), "SuperStepBarrierWorkerAck")
+	proto.RegisterType((*Compute)(nil), "Compute")
+	proto.RegisterMapType((map[string]*types.Any)(nil), "Compute.AggregatedValuesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "Compute.StageParamsEntry")
+	proto.RegisterType((*ComputeAck)(nil), "ComputeAck")
+	proto.RegisterMapType((map[string]*types.Any)(nil), "ComputeAck.AggregatedValuesEntry")
+	proto.RegisterType((*ComputePartitionAck)(nil), "ComputePartitionAck")
+	proto.RegisterMapType((map[string]*types.Any)(nil), "ComputePartitionAck.AggregatedValuesEntry")
+	proto.RegisterMapType((map[string]uint64)(nil), "ComputePartitionAck.InternalMessagesEntry")
+	proto.RegisterType((*ComputeWorkerAck)(nil), "ComputeWorkerAck")
+	proto.RegisterMapType((map[string]*types.Any)(nil), "ComputeWorkerAck.AggregatedValuesEntry")
+	proto.RegisterType((*VertexMove)(nil), "VertexMove")
+	proto.RegisterType((*SuperStepMessage)(nil), "SuperStepMessage")
+	proto.RegisterType((*SuperStepMessageAck)(nil), "SuperStepMessageAck")
+	proto.RegisterType((*SuperStepMessageBatch)(nil), "SuperStepMessageBatch")
+	proto.RegisterType((*SuperStepMessageBatchAck)(nil), "SuperStepMessageBatchAck")
+	proto.RegisterType((*MessageBatchStats)(nil), "MessageBatchStats")
+	proto.RegisterType((*FlowControl)(nil), "FlowControl")
+	proto.RegisterType((*FlowControlStats)(nil), "FlowControlStats")
+	proto.RegisterType((*MessageSpill)(nil), "MessageSpill")
+	proto.RegisterType((*PartitionStore)(nil), "PartitionStore")
+	proto.RegisterType((*InitPartition)(nil), "InitPartition")
+	proto.RegisterType((*InitPartitionAck)(nil), "InitPartitionAck")
+	proto.RegisterType((*ClusterInfo)(nil), "ClusterInfo")
+	proto.RegisterMapType((map[string]uint64)(nil), "ClusterInfo.MovedVerticesEntry")
+	proto.RegisterType((*ClusterInfo_WorkerInfo)(nil), "ClusterInfo.WorkerInfo")
+	proto.RegisterType((*PluginFingerprint)(nil), "PluginFingerprint")
+	proto.RegisterType((*InitWorker)(nil), "InitWorker")
+	proto.RegisterType((*InitWorkerAck)(nil), "InitWorkerAck")
+	proto.RegisterType((*NewCluster)(nil), "NewCluster")
+	proto.RegisterType((*NewCluster_WorkerReq)(nil), "NewCluster.WorkerReq")
+	proto.RegisterType((*NewClusterAck)(nil), "NewClusterAck")
+	proto.RegisterType((*RegisterWorker)(nil), "RegisterWorker")
+	proto.RegisterType((*RegisterWorkerAck)(nil), "RegisterWorkerAck")
+	proto.RegisterType((*VertexState)(nil), "VertexState")
+	proto.RegisterType((*OutEdges)(nil), "OutEdges")
+	proto.RegisterType((*ExportVertex)(nil), "ExportVertex")
+	proto.RegisterType((*ExportVertexAck)(nil), "ExportVertexAck")
+	proto.RegisterType((*ExportPartition)(nil), "ExportPartition")
+	proto.RegisterType((*ExportPartitionAck)(nil), "ExportPartitionAck")
+	proto.RegisterType((*ImportPartition)(nil), "ImportPartition")
+	proto.RegisterType((*ImportPartitionAck)(nil), "ImportPartitionAck")
+	proto.RegisterType((*ReleasePartition)(nil), "ReleasePartition")
+	proto.RegisterType((*ExportVertices)(nil), "ExportVertices")
+	proto.RegisterType((*ExportVerticesAck)(nil), "ExportVerticesAck")
+	proto.RegisterType((*ImportVertices)(nil), "ImportVertices")
+	proto.RegisterType((*ImportVerticesAck)(nil), "ImportVerticesAck")
+	proto.RegisterType((*AddWorker)(nil), "AddWorker")
+	proto.RegisterType((*AddWorkerAck)(nil), "AddWorkerAck")
+	proto.RegisterType((*DrainWorker)(nil), "DrainWorker")
+	proto.RegisterType((*DrainWorkerAck)(nil), "DrainWorkerAck")
+	proto.RegisterType((*RebalanceWorkers)(nil), "RebalanceWorkers")
+	proto.RegisterType((*RebalanceWorkersAck)(nil), "RebalanceWorkersAck")
+	proto.RegisterType((*CoordinatorStats)(nil), "CoordinatorStats")
+	proto.RegisterType((*CoordinatorStatsAck)(nil), "CoordinatorStatsAck")
+	proto.RegisterType((*ShowPartitions)(nil), "ShowPartitions")
+	proto.RegisterType((*ShowPartitionsAck)(nil), "ShowPartitionsAck")
+	proto.RegisterType((*ShowPartitionsAck_Partition)(nil), "ShowPartitionsAck.Partition")
+	proto.RegisterType((*StartSuperStep)(nil), "StartSuperStep")
+	proto.RegisterType((*SelectAlgorithm)(nil), "SelectAlgorithm")
+	proto.RegisterType((*SelectAlgorithmAck)(nil), "SelectAlgorithmAck")
+	proto.RegisterType((*PipelineStage)(nil), "PipelineStage")
+	proto.RegisterMapType((map[string]string)(nil), "PipelineStage.ParamsEntry")
+	proto.RegisterType((*StartPipeline)(nil), "StartPipeline")
+	proto.RegisterType((*StartPipelineAck)(nil), "StartPipelineAck")
+	proto.RegisterType((*StageStats)(nil), "StageStats")
+	proto.RegisterType((*ShowPipeline)(nil), "ShowPipeline")
+	proto.RegisterType((*ShowPipelineAck)(nil), "ShowPipelineAck")
+	proto.RegisterType((*ShowAggregatedValue)(nil), "ShowAggregatedValue")
+	proto.RegisterType((*ShowAggregatedValueAck)(nil), "ShowAggregatedValueAck")
+	proto.RegisterMapType((map[string]string)(nil), "ShowAggregatedValueAck.AggregatedValuesEntry")
+	proto.RegisterType((*Shutdown)(nil), "Shutdown")
+	proto.RegisterType((*ShutdownAck)(nil), "ShutdownAck")
 }
 
-func (m *NewCluster) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Workers) > 0 {
-		for _, msg := range m.Workers {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func init() { proto.RegisterFile("command.proto", fileDescriptor_213c0bb044472049) }
+
+var fileDescriptor_213c0bb044472049 = []byte{
+	// 3047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0x4d, 0x73, 0x1c, 0x47,
+	0x55, 0xb3, 0xbb, 0xfa, 0xd8, 0x37, 0xfb, 0xd9, 0xb2, 0x8c, 0xac, 0x38, 0x1b, 0x7b, 0x82, 0x1d,
+	0xdb, 0xb1, 0x47, 0x61, 0xe3, 0x84, 0x00, 0xa9, 0x14, 0x92, 0x62, 0x1b, 0x85, 0x58, 0x56, 0xcd,
+	0x0a, 0xbb, 0x48, 0x15, 0x35, 0x35, 0xda, 0x6d, 0xad, 0xa6, 0x3c, 0x33, 0xbd, 0x99, 0x99, 0x95,
+	0xac, 0x14, 0x07, 0x2e, 0x14, 0x1c, 0x28, 0x08, 0xfc, 0x01, 0x8e, 0x50, 0x1c, 0xb8, 0x71, 0xe0,
+	0xc8, 0x8d, 0xe2, 0x94, 0x0b, 0x55, 0xb9, 0x81, 0x95, 0x0b, 0x5c, 0x52, 0xb9, 0x70, 0xa7, 0xfa,
+	0x6b, 0xa6, 0x77, 0x76, 0x56, 0x5a, 0x09, 0x92, 0xdb, 0xf4, 0xeb, 0xd7, 0x1f, 0xef, 0xf5, 0xfb,
+	0x7e, 0x03, 0xd5, 0x2e, 0xf1, 0x7d, 0x27, 0xe8, 0x99, 0x83, 0x90, 0xc4, 0x64, 0xe5, 0x52, 0x9f,
+	0x90, 0xbe, 0x87, 0x57, 0xd9, 0x68, 0x77, 0xb8, 0xb7, 0xea, 0x04, 0x47, 0x62, 0xea, 0xcd, 0xbe,
+	0x1b, 0xef, 0x0f, 0x77, 0xcd, 0x2e, 0xf1, 0x57, 0xd7, 0xa2, 0xa3, 0xe0, 0x69, 0x48, 0x82, 0xcd,
+	0x1d, 0x8e, 0xe9, 0x74, 0x63, 0x12, 0xde, 0xe9, 0x93, 0x55, 0xf6, 0xc1, 0x61, 0x11, 0x5f, 0x67,
+	0xdc, 0x04, 0x78, 0x9f, 0x38, 0xbd, 0xc7, 0x38, 0x8c, 0xf1, 0x33, 0xf4, 0x02, 0x94, 0x0f, 0xd8,
+	0x97, 0xed, 0xf6, 0x96, 0xb5, 0x2b, 0xda, 0x8d, 0xb2, 0xb5, 0xc0, 0x01, 0x9b, 0x3d, 0x63, 0x1d,
+	0xaa, 0x29, 0xea, 0x5a, 0xf7, 0xe9, 0x89, 0xd8, 0xe8, 0x02, 0xcc, 0xe2, 0x30, 0x24, 0xe1, 0x72,
+	0x81, 0x4d, 0xf0, 0x81, 0xb1, 0x01, 0x4b, 0x74, 0x8f, 0x6d, 0x27, 0x8c, 0xdd, 0xd8, 0x25, 0x01,
+	0xdd, 0xcc, 0xed, 0xe2, 0x08, 0xdd, 0x82, 0x66, 0x30, 0xf4, 0x6d, 0xb2, 0x67, 0x0f, 0xe4, 0x5c,
+	0xc4, 0xf6, 0x2c, 0x59, 0xf5, 0x60, 0xe8, 0x3f, 0xda, 0x4b, 0x96, 0x44, 0xc6, 0x47, 0xb0, 0x9c,
+	0xbb, 0x09, 0xbd, 0xd3, 0x55, 0xa8, 0x24, 0x1b, 0xc8, 0x6b, 0x95, 0x2c, 0x3d, 0x81, 0x4d, 0xba,
+	0x19, 0xba, 0x06, 0xb3, 0x51, 0xec, 0xc4, 0xd1, 0x72, 0xf1, 0x8a, 0x76, 0x43, 0x6f, 0xd7, 0xcd,
+	0x64, 0xfb, 0x0e, 0x05, 0x5b, 0x7c, 0xd6, 0xf8, 0x31, 0xb4, 0x72, 0xcf, 0x7e, 0x42, 0xc2, 0xa7,
+	0x38, 0xa4, 0x37, 0xb8, 0x09, 0x70, 0xc8, 0x06, 0xf6, 0x40, 0x9c, 0xaf, 0xb7, 0xc1, 0x64, 0xac,
+	0x37, 0xb7, 0x37, 0xdf, 0xb5, 0xca, 0x7c, 0x76, 0xdb, 0xed, 0xa1, 0x55, 0x00, 0x85, 0xda, 0xc2,
+	0x95, 0x62, 0xde, 0xc1, 0x0a, 0x8a, 0xf1, 0x6f, 0x0d, 0x6a, 0xa3, 0xd3, 0xd3, 0x10, 0xbc, 0x02,
+	0x0b, 0x07, 0xe2, 0x9a, 0x8c, 0xe6, 0x92, 0x95, 0x8c, 0x19, 0x33, 0x7a, 0x7d, 0xcc, 0xc9, 0x2e,
+	0x59, 0x7c, 0x80, 0x5e, 0x86, 0xaa, 0x8f, 0xa3, 0xc8, 0xe9, 0xe3, 0xc8, 0x8e, 0x70, 0x10, 0x2f,
+	0x97, 0xd8, 0x6c, 0x45, 0x02, 0x3b, 0x38, 0x88, 0xe9, 0xf3, 0x77, 0x87, 0xb1, 0xcd, 0x97, 0xcf,
+	0xf2, 0x7d, 0xbb, 0xc3, 0xf8, 0x1e, 0xdb, 0xe1, 0x2a, 0x54, 0x84, 0x6c, 0xec, 0x1e, 0xc5, 0x38,
+	0x5a, 0x9e, 0xe3, 0xd7, 0xe2, 0xb0, 0x75, 0x0a, 0x42, 0x2f, 0x02, 0xd0, 0xb5, 0x02, 0x61, 0x9e,
+	0x21, 0x94, 0x29, 0x84, 0x4d, 0x1b, 0x7f, 0xd4, 0xa0, 0x91, 0xd0, 0xba, 0x13, 0x3a, 0x7b, 0x7b,
+	0x6e, 0x97, 0x5e, 0x2c, 0x0a, 0xbb, 0xa9, 0x8c, 0x08, 0x72, 0x2b, 0x51, 0xd8, 0x4d, 0x70, 0xd1,
+	0x35, 0xa8, 0xf5, 0x70, 0x14, 0x2b, 0x58, 0x9c, 0xea, 0x2a, 0x85, 0x8e, 0xa0, 0x79, 0xa4, 0xeb,
+	0x78, 0xb6, 0xa4, 0x4a, 0xf0, 0xa0, 0xca, 0xa0, 0x0f, 0x05, 0x10, 0xbd, 0x02, 0xf5, 0x10, 0xfb,
+	0x24, 0xc6, 0x29, 0x1e, 0xe7, 0x46, 0x8d, 0x83, 0x25, 0xa2, 0x71, 0x07, 0x6a, 0x0f, 0x70, 0xcc,
+	0xd5, 0xe3, 0xb1, 0xe3, 0x0d, 0xf1, 0xc9, 0xea, 0x74, 0x1f, 0x9a, 0xa3, 0xe8, 0xd3, 0xa8, 0xd4,
+	0x01, 0x45, 0x94, 0x82, 0xcb, 0x06, 0xc6, 0x37, 0xa0, 0xd1, 0x19, 0x0e, 0x70, 0xd8, 0x89, 0xf1,
+	0x60, 0xdd, 0x09, 0x43, 0x17, 0x87, 0x94, 0xb5, 0x11, 0x85, 0xd9, 0x51, 0x8c, 0x07, 0x82, 0x47,
+	0xe5, 0x48, 0x62, 0x19, 0x6d, 0x58, 0xcc, 0x2e, 0x39, 0xed, 0x70, 0x63, 0x0d, 0x2e, 0x67, 0xd7,
+	0x24, 0xac, 0x9c, 0x4e, 0xf1, 0x8c, 0xfb, 0x70, 0x29, 0xbb, 0xc5, 0x79, 0xd4, 0xc6, 0xf8, 0x4f,
+	0x11, 0xe6, 0x37, 0x88, 0x3f, 0x18, 0xc6, 0xf8, 0x14, 0x4a, 0xd1, 0xf7, 0xa1, 0xe9, 0xf4, 0xfb,
+	0x21, 0xee, 0x3b, 0x31, 0xee, 0xd9, 0x8c, 0x61, 0x52, 0xd1, 0x5a, 0xa6, 0xd8, 0xc3, 0x5c, 0x4b,
+	0x30, 0xd8, 0x3b, 0x44, 0xf7, 0x82, 0x38, 0x3c, 0xb2, 0x1a, 0x4e, 0x06, 0x4c, 0xf9, 0x1f, 0xc5,
+	0x4e, 0x1f, 0x33, 0x39, 0x29, 0x5b, 0x7c, 0x80, 0xde, 0x86, 0x0a, 0xfb, 0xa0, 0xe2, 0xe6, 0xf8,
+	0x54, 0x38, 0xe8, 0xee, 0x97, 0x92, 0xdd, 0x3b, 0x74, 0x72, 0x9b, 0xcd, 0xf1, 0x8d, 0xf5, 0x28,
+	0x85, 0xa0, 0xd7, 0xe0, 0x42, 0x1c, 0x3a, 0xdd, 0xa7, 0xb6, 0xe0, 0x7c, 0xcc, 0x05, 0x9d, 0xe9,
+	0xd3, 0x82, 0x85, 0xd8, 0x1c, 0x97, 0x11, 0xa9, 0x02, 0xb7, 0x01, 0x05, 0x43, 0x1f, 0x87, 0x6e,
+	0xd7, 0x4e, 0x5e, 0x8b, 0xeb, 0xd7, 0x82, 0xd5, 0x10, 0x33, 0x8f, 0xc5, 0xab, 0x45, 0x68, 0x15,
+	0x2a, 0x7b, 0x1e, 0x39, 0xb4, 0xbb, 0x24, 0x88, 0x43, 0xe2, 0x31, 0x35, 0xd3, 0xdb, 0x15, 0xf3,
+	0xbe, 0x47, 0x0e, 0x37, 0x38, 0xcc, 0xd2, 0xf7, 0xd2, 0xc1, 0xca, 0x0f, 0x61, 0x29, 0x97, 0x1f,
+	0xa8, 0x01, 0xc5, 0xa7, 0xf8, 0x48, 0xc8, 0x05, 0xfd, 0x44, 0xb7, 0x54, 0x79, 0xd4, 0xdb, 0x17,
+	0x4c, 0xee, 0x9e, 0x4c, 0xe9, 0x9e, 0xcc, 0xb5, 0xe0, 0x48, 0x48, 0xe9, 0xb7, 0x0b, 0x6f, 0x69,
+	0x2b, 0xef, 0x40, 0x23, 0xcb, 0x8c, 0x9c, 0x5d, 0x73, 0xa5, 0x9c, 0xae, 0x37, 0x7e, 0x57, 0x00,
+	0x10, 0x5c, 0x3d, 0x55, 0x57, 0x2e, 0xc2, 0xdc, 0xbe, 0xe3, 0xc5, 0xb8, 0xc7, 0xb6, 0x59, 0xb0,
+	0xc4, 0x08, 0x6d, 0xe5, 0x09, 0x44, 0x91, 0x3d, 0xd9, 0x55, 0x33, 0xdd, 0x7c, 0x6a, 0x99, 0x98,
+	0xca, 0x52, 0x26, 0x1e, 0x67, 0x56, 0xf1, 0x38, 0x5f, 0x22, 0xa7, 0x8d, 0xcf, 0x8b, 0xb0, 0x28,
+	0x88, 0x39, 0xa3, 0x92, 0xa2, 0x27, 0x93, 0x35, 0xe6, 0x96, 0x99, 0xb3, 0xe7, 0xd4, 0x9c, 0x9a,
+	0xce, 0xc1, 0xd2, 0xf3, 0xdd, 0x20, 0xc6, 0x61, 0xa0, 0x1a, 0xe6, 0xd2, 0x09, 0xe7, 0x6f, 0x0a,
+	0x6c, 0x69, 0x87, 0xc5, 0xf9, 0x6e, 0x06, 0x9c, 0xff, 0x08, 0x39, 0x4e, 0x60, 0x2e, 0xc7, 0x09,
+	0x7c, 0x99, 0x5a, 0xb1, 0x01, 0x4b, 0xb9, 0x24, 0x9c, 0xa6, 0x1a, 0x25, 0xf5, 0xc1, 0x7f, 0x5a,
+	0x82, 0x86, 0x60, 0xce, 0xb9, 0x22, 0x91, 0x9d, 0xc9, 0xaf, 0xfe, 0x8a, 0x99, 0xdd, 0x78, 0xea,
+	0x27, 0x1f, 0x8d, 0x6f, 0x8a, 0xa7, 0xc6, 0x37, 0xe8, 0x55, 0x98, 0x97, 0x06, 0x90, 0x3f, 0x79,
+	0xd3, 0xcc, 0x86, 0x00, 0x96, 0xc4, 0x40, 0x66, 0x12, 0x62, 0xf8, 0xe4, 0x80, 0x85, 0x20, 0x74,
+	0x85, 0x6e, 0x72, 0xe3, 0xf7, 0x90, 0x1c, 0x60, 0x19, 0x6f, 0xd0, 0xef, 0x08, 0x7d, 0x07, 0xea,
+	0xe2, 0x91, 0xed, 0x5d, 0x27, 0xee, 0xee, 0x8b, 0xb7, 0xd6, 0xdb, 0xc8, 0x14, 0x8c, 0x5f, 0xa7,
+	0x60, 0x7e, 0xab, 0x9a, 0xaf, 0x80, 0x70, 0x84, 0xee, 0xe6, 0xda, 0xd1, 0xa6, 0x6a, 0x47, 0xf9,
+	0x42, 0xd5, 0x98, 0xa6, 0x32, 0xb7, 0xf0, 0x15, 0x29, 0xbe, 0x03, 0x90, 0x92, 0x7f, 0xb2, 0x85,
+	0x44, 0x50, 0xda, 0x0b, 0x89, 0x2f, 0x64, 0x89, 0x7d, 0xa3, 0x1a, 0x14, 0x62, 0x22, 0xc2, 0xa0,
+	0x42, 0x4c, 0x28, 0x4e, 0xdf, 0x71, 0x03, 0x61, 0xd4, 0xd8, 0xb7, 0xf1, 0xeb, 0x82, 0x12, 0x70,
+	0x08, 0xc6, 0xd1, 0x9b, 0x47, 0xf8, 0x43, 0x46, 0x66, 0xc9, 0xa2, 0x9f, 0x19, 0xc7, 0x5c, 0xc8,
+	0x3a, 0x66, 0x83, 0x07, 0x72, 0xe9, 0xf5, 0xb8, 0x4f, 0xd5, 0xa3, 0x30, 0x71, 0x5e, 0xe8, 0xeb,
+	0x22, 0x8e, 0x4b, 0x91, 0x4a, 0x0c, 0xa9, 0x42, 0xa1, 0x09, 0x96, 0x09, 0xf3, 0xe2, 0xad, 0x96,
+	0x67, 0x4f, 0x60, 0x92, 0x44, 0xa2, 0xbb, 0xd2, 0x93, 0xa5, 0x0f, 0x75, 0x7b, 0x4c, 0x0a, 0xe6,
+	0x58, 0x0c, 0xb9, 0xc5, 0x81, 0x9b, 0x3d, 0x74, 0x1d, 0xea, 0xec, 0x6c, 0x05, 0x6d, 0x9e, 0xa1,
+	0xb1, 0x20, 0x32, 0xc1, 0x7b, 0xaf, 0xb4, 0xa0, 0x35, 0x0a, 0xc6, 0x1d, 0x58, 0xcc, 0xb2, 0x84,
+	0x2a, 0xa0, 0xe0, 0x4a, 0x21, 0xe1, 0x8a, 0x40, 0x7f, 0xae, 0xc1, 0x52, 0x16, 0x9f, 0x09, 0x9a,
+	0x5c, 0x31, 0x3f, 0x35, 0x1f, 0x2f, 0xc0, 0x6c, 0x97, 0x0c, 0x83, 0x98, 0xf1, 0xaf, 0x6a, 0xf1,
+	0xc1, 0x84, 0x18, 0xa1, 0x34, 0x21, 0x46, 0x78, 0x1d, 0xf4, 0x2e, 0xf1, 0x07, 0x21, 0x8e, 0x22,
+	0x1a, 0x2c, 0x53, 0x2e, 0xd6, 0xda, 0x4d, 0x93, 0xdd, 0x68, 0x23, 0x9d, 0xb0, 0x54, 0x2c, 0xb4,
+	0x0c, 0xf3, 0x03, 0xe7, 0xc8, 0x23, 0x0e, 0xe7, 0x5f, 0xc5, 0x92, 0x43, 0x41, 0x63, 0x1b, 0x96,
+	0x73, 0x49, 0x3c, 0x85, 0x2f, 0xcd, 0x31, 0x55, 0xa4, 0x27, 0x49, 0x7d, 0xe5, 0xfe, 0x4a, 0x0e,
+	0x69, 0x62, 0x93, 0x98, 0x6d, 0x91, 0xd8, 0xc8, 0x31, 0x95, 0xfd, 0xd0, 0x39, 0x14, 0xc9, 0x05,
+	0x97, 0xe8, 0x85, 0xd0, 0x39, 0xe4, 0xa9, 0xc7, 0xcb, 0x50, 0xc5, 0x41, 0x97, 0xf4, 0x70, 0x4f,
+	0x20, 0x08, 0xaf, 0x2d, 0x80, 0x1c, 0xe9, 0x36, 0x20, 0xdf, 0x79, 0xc6, 0x6d, 0x45, 0xea, 0x1e,
+	0x78, 0xa2, 0xd3, 0xf0, 0x9d, 0x67, 0xec, 0x8a, 0x89, 0x7b, 0xb9, 0x0e, 0xf5, 0x14, 0x5b, 0xcd,
+	0x79, 0xaa, 0x12, 0x95, 0xa7, 0x35, 0xbf, 0xd4, 0x40, 0x57, 0x8c, 0x06, 0x4d, 0x2f, 0xe4, 0x13,
+	0x05, 0x7b, 0x9e, 0xdb, 0xdf, 0x8f, 0x19, 0x95, 0x55, 0xab, 0x26, 0x34, 0x55, 0x40, 0xd1, 0x1d,
+	0x40, 0x8a, 0xef, 0x96, 0xb8, 0x05, 0x86, 0xdb, 0x4c, 0x3d, 0xb8, 0x44, 0x7f, 0x05, 0xea, 0xc2,
+	0xf8, 0x27, 0xb8, 0x5c, 0x44, 0x6a, 0x1c, 0x2c, 0x11, 0x8d, 0xcf, 0x35, 0x68, 0x64, 0xad, 0x18,
+	0xba, 0x09, 0x8d, 0x28, 0x76, 0x3c, 0x0f, 0xf7, 0x52, 0xca, 0x45, 0x36, 0x2e, 0xe0, 0x09, 0xe1,
+	0x2f, 0x81, 0xce, 0x40, 0x76, 0xe0, 0x04, 0x84, 0xbf, 0x43, 0xd1, 0x02, 0x06, 0xda, 0xa2, 0x10,
+	0xc9, 0x47, 0x69, 0xab, 0x1d, 0xd7, 0xdb, 0x25, 0xcf, 0x96, 0x8b, 0x09, 0x1f, 0x85, 0xc1, 0xe2,
+	0x70, 0xd4, 0x86, 0x25, 0x8a, 0x9d, 0x92, 0x2a, 0x17, 0xf0, 0x27, 0x5a, 0xf4, 0x9d, 0x67, 0x89,
+	0x4b, 0x90, 0x6b, 0xc4, 0x09, 0x82, 0x5e, 0xb9, 0x20, 0x7d, 0x29, 0xee, 0xb9, 0x04, 0xb6, 0xb1,
+	0x01, 0x15, 0x71, 0xf9, 0xce, 0xc0, 0xf5, 0x3c, 0x1a, 0x14, 0xf9, 0xd8, 0x27, 0xe1, 0x91, 0xed,
+	0xb9, 0xbe, 0x1b, 0xcb, 0xa0, 0x88, 0xc3, 0xde, 0xa7, 0x20, 0x2a, 0xb0, 0x3d, 0x57, 0x16, 0x0c,
+	0xe8, 0xa7, 0x61, 0x8f, 0x24, 0xe2, 0x24, 0xc4, 0x54, 0x4c, 0x71, 0xe0, 0xec, 0x7a, 0x98, 0x9b,
+	0xda, 0x05, 0x4b, 0x0e, 0xc7, 0x57, 0x8f, 0x1d, 0x59, 0x1c, 0x3b, 0xd2, 0xf8, 0x99, 0x06, 0xd5,
+	0xcd, 0xc0, 0x55, 0xf2, 0xd5, 0x29, 0x82, 0xb7, 0x7c, 0xbd, 0x2f, 0x4c, 0xd0, 0x7b, 0x16, 0x91,
+	0x91, 0x10, 0xe7, 0x45, 0x64, 0x24, 0xc4, 0x16, 0x9f, 0x35, 0xde, 0x80, 0xc6, 0xc8, 0x45, 0xa6,
+	0xcc, 0xf6, 0xfe, 0x50, 0x00, 0x7d, 0xc3, 0x1b, 0x46, 0x31, 0x93, 0x35, 0x82, 0xde, 0x02, 0x3d,
+	0x15, 0x48, 0xb2, 0xac, 0x31, 0x6f, 0xfd, 0x35, 0x53, 0x41, 0x31, 0x9f, 0x48, 0xc9, 0x24, 0x16,
+	0x24, 0x52, 0x4a, 0xd0, 0x7d, 0xa8, 0x51, 0x0f, 0xdf, 0xb3, 0x95, 0x2a, 0x06, 0x5d, 0xfc, 0xd2,
+	0xc8, 0x62, 0xea, 0xf1, 0x7a, 0xb2, 0x1c, 0xc3, 0x23, 0x92, 0xaa, 0xaf, 0xc2, 0x56, 0x9e, 0x00,
+	0xa4, 0x27, 0x9c, 0x25, 0x3a, 0x6a, 0x8d, 0xd5, 0x69, 0x4a, 0x6a, 0xd8, 0xb2, 0xf2, 0x5d, 0x40,
+	0xe3, 0xa7, 0x9f, 0x29, 0x7e, 0xfb, 0xad, 0x06, 0xcd, 0x6d, 0x6f, 0xd8, 0x77, 0x83, 0xfb, 0x6e,
+	0xd0, 0xc7, 0xe1, 0x20, 0x74, 0x83, 0x98, 0x6a, 0x21, 0xf3, 0x61, 0x5d, 0xe2, 0x51, 0xda, 0x23,
+	0x59, 0xf0, 0xa8, 0x5a, 0x75, 0x09, 0x7f, 0xcc, 0xc1, 0x54, 0xfa, 0x24, 0x06, 0x97, 0x33, 0x39,
+	0x44, 0x57, 0x40, 0x97, 0x81, 0x19, 0x09, 0x79, 0x14, 0x56, 0xb6, 0x54, 0x90, 0x92, 0xc3, 0xd8,
+	0xf1, 0xd1, 0x40, 0x84, 0xdb, 0xe5, 0x24, 0x87, 0xd9, 0xa1, 0x30, 0xe3, 0x2f, 0x45, 0x00, 0x2a,
+	0x06, 0x9c, 0x83, 0xe8, 0x36, 0xf5, 0x19, 0x24, 0xec, 0xb9, 0x01, 0xdd, 0x23, 0x87, 0x7d, 0xea,
+	0xf4, 0x69, 0x0c, 0x44, 0x77, 0x41, 0xdf, 0x4b, 0xe9, 0x16, 0xf2, 0x88, 0xcc, 0x31, 0x8e, 0x58,
+	0x2a, 0x1a, 0xad, 0x19, 0x0a, 0x29, 0xef, 0x3a, 0xdd, 0x7d, 0x6c, 0x47, 0xee, 0x47, 0x98, 0x99,
+	0x89, 0xaa, 0x25, 0x6c, 0xea, 0x06, 0x85, 0x77, 0xdc, 0x8f, 0xf0, 0x04, 0xcd, 0x98, 0x9d, 0xa0,
+	0x19, 0x0a, 0x47, 0x98, 0x41, 0x17, 0xe9, 0x75, 0x45, 0x0d, 0x0a, 0xd1, 0x3a, 0x2c, 0x4a, 0x24,
+	0xd5, 0x7d, 0xce, 0x4f, 0x72, 0x9f, 0x48, 0x60, 0x2b, 0x30, 0xd4, 0x4e, 0x0f, 0x8a, 0xa8, 0x31,
+	0x62, 0x11, 0x94, 0xde, 0xae, 0x9a, 0xaa, 0x85, 0x4a, 0xce, 0x65, 0x23, 0xf4, 0x16, 0xd4, 0x53,
+	0xdd, 0xe3, 0x0a, 0x5c, 0xce, 0x57, 0xe0, 0xda, 0x60, 0x64, 0x6c, 0x7c, 0x2c, 0x6c, 0xca, 0xb9,
+	0x52, 0x84, 0x16, 0x80, 0xe3, 0xf5, 0x49, 0xe8, 0xc6, 0xfb, 0x3e, 0x7f, 0xc3, 0xb2, 0xa5, 0x40,
+	0xce, 0xf7, 0x86, 0xc6, 0xdf, 0xe7, 0x00, 0xb6, 0xf0, 0xa1, 0x50, 0x64, 0xb4, 0x0a, 0xf3, 0xfc,
+	0xc4, 0x48, 0x18, 0x88, 0x25, 0x33, 0x9d, 0x15, 0xf6, 0xc1, 0xc2, 0x1f, 0x5a, 0x12, 0x0b, 0xdd,
+	0x80, 0x46, 0x10, 0x66, 0xca, 0xc6, 0x5c, 0xbb, 0x6a, 0x41, 0xa8, 0x56, 0x8d, 0xd1, 0x5d, 0xb8,
+	0xe8, 0xbb, 0x81, 0x1d, 0xe2, 0xbe, 0x4b, 0x37, 0xc3, 0x3d, 0x5b, 0x9e, 0xc4, 0xfd, 0xe2, 0x05,
+	0xdf, 0x0d, 0xac, 0x64, 0xf2, 0x89, 0xd8, 0xff, 0x1d, 0x78, 0x81, 0xaf, 0x08, 0x1d, 0xc6, 0xef,
+	0xd8, 0xf5, 0x31, 0x19, 0xc6, 0xb6, 0xef, 0x7a, 0x9e, 0xcb, 0xe3, 0x86, 0xa2, 0x75, 0x49, 0x45,
+	0xd9, 0xe1, 0x18, 0x0f, 0x19, 0x02, 0x0d, 0xdf, 0x04, 0x83, 0x7b, 0x41, 0x24, 0x52, 0x4f, 0xc1,
+	0xd4, 0x77, 0x83, 0x88, 0x06, 0xa3, 0xe9, 0xb4, 0x1d, 0x85, 0x07, 0x52, 0xd2, 0x12, 0x94, 0x4e,
+	0x78, 0x80, 0x56, 0x61, 0x31, 0xc4, 0xbb, 0x8e, 0xe7, 0x04, 0x5d, 0x6c, 0xc7, 0xfb, 0x21, 0x8e,
+	0xf6, 0x89, 0xc7, 0x03, 0x52, 0xcd, 0x42, 0xc9, 0xd4, 0x8e, 0x9c, 0xa1, 0x5c, 0x51, 0x5d, 0x2e,
+	0x4b, 0x8f, 0x16, 0xb8, 0xf7, 0x4f, 0x1d, 0x2e, 0x85, 0xe6, 0xeb, 0x50, 0xf9, 0x2c, 0x3a, 0x04,
+	0xd3, 0xea, 0x90, 0x3e, 0xbd, 0x0e, 0x55, 0xce, 0xa2, 0x43, 0xd9, 0x12, 0x57, 0xf5, 0x94, 0x12,
+	0xd7, 0xb8, 0xd2, 0xd5, 0xce, 0xa5, 0x74, 0xf5, 0xa9, 0x94, 0x0e, 0xbd, 0x0a, 0x4d, 0x16, 0xae,
+	0xd3, 0xf8, 0xdd, 0xde, 0xe5, 0x65, 0xcf, 0xe5, 0x06, 0x67, 0x5a, 0x32, 0x21, 0xca, 0xa1, 0x2b,
+	0x0f, 0xa0, 0x9c, 0x08, 0x39, 0xad, 0x61, 0xf1, 0x12, 0xb3, 0x08, 0x28, 0xc4, 0x88, 0xe6, 0x4e,
+	0xfb, 0x24, 0x8a, 0x6d, 0x27, 0xe8, 0xd9, 0x03, 0x12, 0xc6, 0xc2, 0xe2, 0xeb, 0x14, 0xb8, 0x16,
+	0xf4, 0xb6, 0x49, 0x18, 0x1b, 0xd7, 0xa0, 0x9a, 0x2a, 0x0e, 0xd5, 0xf4, 0x24, 0x15, 0xd5, 0xd4,
+	0x7e, 0xcc, 0x5d, 0xa8, 0x49, 0x99, 0x17, 0x86, 0x7d, 0x6c, 0x73, 0x6d, 0x7c, 0xf3, 0x9b, 0xd0,
+	0x1c, 0x5d, 0x35, 0xf9, 0x80, 0xbf, 0x69, 0xa0, 0x73, 0x99, 0xa0, 0x81, 0xe5, 0x29, 0x29, 0xe9,
+	0x6d, 0x98, 0xe3, 0xdf, 0x27, 0xa6, 0xbb, 0x02, 0x47, 0x29, 0xf1, 0x15, 0x47, 0x4a, 0x7c, 0xaf,
+	0x29, 0x59, 0x01, 0xaf, 0x22, 0xe4, 0xef, 0x93, 0x60, 0xa1, 0xeb, 0x50, 0x26, 0x23, 0x9d, 0x0c,
+	0xbd, 0x5d, 0x36, 0x1f, 0x89, 0x56, 0x86, 0xb5, 0x40, 0xc4, 0x97, 0xf1, 0x2b, 0x0d, 0x16, 0x24,
+	0x98, 0xd2, 0x4b, 0x53, 0x41, 0x6e, 0xa8, 0xca, 0x16, 0x1f, 0x50, 0xa9, 0x1f, 0xba, 0x41, 0xfc,
+	0x7a, 0x5b, 0x2d, 0xa2, 0x54, 0xad, 0x0a, 0x07, 0x26, 0xa5, 0xb0, 0xda, 0x9e, 0x47, 0x9c, 0xf8,
+	0xcd, 0xbb, 0x6a, 0x05, 0x52, 0xb3, 0xaa, 0x02, 0x2a, 0xd0, 0xae, 0x42, 0x85, 0x25, 0x12, 0x12,
+	0x89, 0x12, 0x53, 0xb1, 0x74, 0x06, 0xe3, 0x28, 0x46, 0x0d, 0x2a, 0xf7, 0x9e, 0xd1, 0x67, 0xe2,
+	0x3c, 0x36, 0xf6, 0xa1, 0xae, 0x8e, 0x4f, 0x2d, 0x93, 0x1a, 0xbc, 0x28, 0x27, 0xeb, 0x0b, 0x15,
+	0x53, 0x79, 0x2b, 0x5e, 0x91, 0xc3, 0xe9, 0xc3, 0x16, 0x47, 0x25, 0x47, 0x9c, 0x74, 0x96, 0x00,
+	0xd5, 0x38, 0x04, 0x94, 0x59, 0x35, 0x65, 0x59, 0xf2, 0xc6, 0x48, 0x0f, 0xab, 0x38, 0x76, 0xd7,
+	0xd1, 0x8e, 0xd6, 0xf8, 0x75, 0xff, 0xa1, 0x41, 0x7d, 0xd3, 0x3f, 0xeb, 0x7d, 0xcf, 0x70, 0x6c,
+	0x6e, 0x03, 0xb3, 0x98, 0xdb, 0xc0, 0x3c, 0x63, 0x7a, 0x9e, 0x84, 0xe9, 0xb3, 0x27, 0x86, 0xe9,
+	0x0f, 0x01, 0x6d, 0xfa, 0xe7, 0x61, 0x6d, 0x7e, 0xa7, 0xf6, 0x0d, 0x68, 0x58, 0xd8, 0xc3, 0x4e,
+	0x84, 0xcf, 0xf4, 0xc0, 0x16, 0xd4, 0x52, 0x01, 0x64, 0x8c, 0x99, 0xe2, 0x06, 0x2f, 0x02, 0x8c,
+	0xa4, 0x2b, 0x54, 0x9f, 0xca, 0x52, 0x46, 0x23, 0xe3, 0x00, 0x9a, 0xa3, 0x7b, 0x7e, 0x45, 0x32,
+	0xf3, 0x23, 0xa8, 0x71, 0x8e, 0x9e, 0x85, 0x96, 0xa9, 0x0f, 0x35, 0xde, 0x87, 0xe6, 0xa6, 0x7f,
+	0x0e, 0xb2, 0xf2, 0xdf, 0xeb, 0x01, 0x94, 0xd7, 0x7a, 0x22, 0x6c, 0xf9, 0x9f, 0x3c, 0xc7, 0x23,
+	0xa8, 0x24, 0x1b, 0x9d, 0x31, 0x44, 0xcc, 0xbf, 0xd9, 0x35, 0xd0, 0xdf, 0x0d, 0x1d, 0x37, 0x48,
+	0xef, 0xc6, 0x57, 0x08, 0x63, 0x24, 0x46, 0xc6, 0x75, 0xa8, 0x29, 0x68, 0x93, 0x3d, 0x0a, 0xa2,
+	0x82, 0x29, 0x22, 0x1e, 0x8e, 0x1b, 0x19, 0x8f, 0x61, 0x31, 0x0b, 0xe3, 0x57, 0x6f, 0xf0, 0xc4,
+	0x31, 0xf3, 0x4f, 0x41, 0xd5, 0xaa, 0x33, 0xb8, 0xa2, 0x92, 0xf9, 0x57, 0x47, 0xb4, 0xaa, 0x9e,
+	0xa4, 0x31, 0x1d, 0xf6, 0x07, 0xc0, 0x2f, 0x0a, 0xb0, 0x98, 0x05, 0xd2, 0xc3, 0x4e, 0xe9, 0x44,
+	0xde, 0x81, 0x45, 0x1e, 0xa8, 0x3a, 0xdd, 0xd8, 0x3d, 0xc0, 0xb6, 0xe2, 0xe8, 0x4a, 0x56, 0x83,
+	0xc6, 0xaa, 0x6b, 0x6c, 0x42, 0xfc, 0x89, 0x91, 0xa0, 0x47, 0x38, 0x88, 0xb3, 0x1d, 0x6a, 0x86,
+	0x4e, 0x3b, 0x4b, 0x6a, 0x73, 0x83, 0xdb, 0xf1, 0x52, 0xd2, 0x9a, 0xe4, 0x96, 0x9b, 0x37, 0x2c,
+	0x67, 0xd5, 0x86, 0xe5, 0x65, 0x28, 0x27, 0x61, 0x3b, 0x0b, 0x37, 0xcb, 0x56, 0x0a, 0xa0, 0x89,
+	0xa4, 0x8c, 0x8b, 0xe7, 0x99, 0x22, 0xca, 0x61, 0x7e, 0x31, 0xdb, 0x68, 0x40, 0xad, 0xb3, 0x4f,
+	0x0e, 0x95, 0xdf, 0x33, 0x7e, 0x5e, 0x82, 0xe6, 0x28, 0x88, 0xb2, 0xe7, 0xed, 0x91, 0x14, 0x90,
+	0x07, 0xf7, 0x97, 0xcd, 0x31, 0xbc, 0xd4, 0xb8, 0x4d, 0x6a, 0x0c, 0x14, 0x4e, 0x6d, 0x0c, 0xd0,
+	0x8a, 0x54, 0xf2, 0x12, 0x92, 0x67, 0x90, 0x3c, 0x85, 0xf2, 0xd3, 0x43, 0x49, 0xfd, 0xe9, 0xe1,
+	0xc4, 0xff, 0x19, 0xa6, 0xeb, 0x13, 0xe5, 0xfd, 0x2c, 0x30, 0x9f, 0xf7, 0xb3, 0x00, 0xdd, 0x2f,
+	0x53, 0xd3, 0xe0, 0xb5, 0xf3, 0xd1, 0x92, 0x45, 0x5e, 0xcf, 0xa2, 0x7c, 0xee, 0x9e, 0x05, 0x4c,
+	0xd3, 0xb3, 0x58, 0x79, 0x0f, 0xca, 0xea, 0x3f, 0x12, 0xa2, 0x69, 0xa7, 0x9d, 0xd8, 0xb4, 0x4b,
+	0x75, 0xba, 0x30, 0xa2, 0xd3, 0x54, 0x38, 0x62, 0x27, 0x8c, 0x93, 0x4a, 0xb0, 0x71, 0x0d, 0xea,
+	0x1d, 0xec, 0xe1, 0x6e, 0xbc, 0x96, 0x48, 0x1c, 0x82, 0x52, 0xe0, 0xf8, 0x58, 0x68, 0x39, 0xfb,
+	0x36, 0x7e, 0x00, 0x28, 0x83, 0xf6, 0x7f, 0x31, 0x45, 0xbf, 0xd1, 0xa0, 0xba, 0xed, 0x0e, 0xb0,
+	0xe7, 0x06, 0x98, 0xb5, 0xa2, 0xf3, 0x0e, 0x47, 0x6d, 0x98, 0x13, 0xbd, 0x7c, 0x2e, 0x6b, 0x2b,
+	0xe6, 0xc8, 0x1a, 0x53, 0x6d, 0xe6, 0x0b, 0xcc, 0x95, 0x6f, 0x81, 0x7e, 0xde, 0xb6, 0xf6, 0x37,
+	0xa1, 0xca, 0x98, 0x24, 0x0f, 0x41, 0xd7, 0x61, 0x8e, 0x69, 0xaa, 0x54, 0x93, 0xda, 0xe8, 0xf9,
+	0x96, 0x98, 0x35, 0x6e, 0x40, 0x63, 0x64, 0xe1, 0x64, 0x9b, 0xf9, 0x27, 0x0d, 0x80, 0xad, 0xe5,
+	0xd5, 0xdd, 0x3c, 0xa2, 0x33, 0x4a, 0x53, 0x18, 0x53, 0x9a, 0x33, 0x5a, 0xa4, 0x6b, 0x50, 0xc3,
+	0x9e, 0x33, 0x88, 0x68, 0x05, 0x59, 0xcd, 0x95, 0xab, 0x02, 0x2a, 0xf2, 0xe3, 0xcb, 0x50, 0xa6,
+	0x89, 0x9f, 0x87, 0x69, 0x1c, 0xcf, 0xcb, 0x31, 0x29, 0x80, 0x86, 0xb7, 0xcc, 0x42, 0x08, 0x02,
+	0x8d, 0x37, 0xa1, 0xae, 0x8e, 0x29, 0xc1, 0x2f, 0x67, 0x98, 0xa5, 0x9b, 0x29, 0xa1, 0x09, 0xa7,
+	0x96, 0x60, 0x91, 0xae, 0xcb, 0x74, 0xdd, 0x8c, 0x3f, 0x6b, 0x70, 0x31, 0x07, 0x4e, 0xb7, 0xfd,
+	0x20, 0xaf, 0x21, 0xca, 0x4f, 0xb8, 0x63, 0xe6, 0xaf, 0x99, 0xb6, 0x2d, 0x4a, 0x3b, 0xbe, 0xd3,
+	0xf6, 0xff, 0x26, 0x4b, 0x0d, 0xc0, 0x42, 0x67, 0x7f, 0x18, 0xf7, 0xc8, 0x61, 0x60, 0x54, 0x41,
+	0x97, 0xdf, 0x6b, 0xdd, 0xa7, 0xb7, 0xde, 0x83, 0x46, 0x36, 0x71, 0x46, 0x2b, 0x70, 0x71, 0x7d,
+	0x6d, 0x67, 0xe3, 0x7b, 0xf6, 0xc6, 0xa3, 0x87, 0xdb, 0xd6, 0xbd, 0x4e, 0x67, 0xf3, 0xd1, 0x96,
+	0xbd, 0xf5, 0x68, 0xeb, 0x5e, 0x63, 0x26, 0x7f, 0xee, 0xc1, 0x07, 0x9b, 0xdb, 0x0d, 0x6d, 0xfd,
+	0xee, 0x27, 0xcf, 0x5b, 0x33, 0x9f, 0x3e, 0x6f, 0xcd, 0x7c, 0xf1, 0xbc, 0xa5, 0xfd, 0xe4, 0xb8,
+	0xa5, 0xfd, 0xfe, 0xb8, 0xa5, 0xfd, 0xf5, 0xb8, 0xa5, 0x7d, 0x72, 0xdc, 0xd2, 0xfe, 0x79, 0xdc,
+	0xd2, 0xfe, 0x75, 0xdc, 0x9a, 0xf9, 0xe2, 0xb8, 0xa5, 0x7d, 0xfc, 0x59, 0x6b, 0xe6, 0x93, 0xcf,
+	0x5a, 0x33, 0x9f, 0x7e, 0xd6, 0x9a, 0xd9, 0x9d, 0x63, 0x89, 0xd6, 0xeb, 0xff, 0x1d, 0x00, 0x0f,
+	0x04, 0x16, 0xc3, 0xc0, 0x28, 0x00, 0x00,
+}
+
+func (x BatchCompression) String() string {
+	s, ok := BatchCompression_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *LoadVertex) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LoadVertex)
+	if !ok {
+		that2, ok := that.(LoadVertex)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.NrOfPartitions != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfPartitions))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *NewCluster_WorkerReq) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *LoadVertexAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *NewCluster_WorkerReq) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Remote {
-		dAtA[i] = 0x8
-		i++
-		if m.Remote {
-			dAtA[i] = 1
+	that1, ok := that.(*LoadVertexAck)
+	if !ok {
+		that2, ok := that.(LoadVertexAck)
+		if ok {
+			that1 = &that2
 		} else {
-			dAtA[i] = 0
+			return false
 		}
-		i++
 	}
-	if len(m.HostAndPort) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.HostAndPort)))
-		i += copy(dAtA[i:], m.HostAndPort)
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return i, nil
-}
-
-func (m *NewClusterAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *NewClusterAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func (m *CoordinatorStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if this.Error != that1.Error {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CoordinatorStats) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
+	return true
 }
+func (this *LoadPartitionVertices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CoordinatorStatsAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*LoadPartitionVertices)
+	if !ok {
+		that2, ok := that.(LoadPartitionVertices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumOfPartitions != that1.NumOfPartitions {
+		return false
+	}
+	return true
 }
+func (this *LoadPartitionVerticesAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *CoordinatorStatsAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.SuperStep != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
+	that1, ok := that.(*LoadPartitionVerticesAck)
+	if !ok {
+		that2, ok := that.(LoadPartitionVerticesAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.NrOfActiveVertex != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfActiveVertex))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.NrOfSentMessages != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfSentMessages))
+	if this.PartitionId != that1.PartitionId {
+		return false
 	}
-	if len(m.State) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCommand(dAtA, i, uint64(len(m.State)))
-		i += copy(dAtA[i:], m.State)
+	if this.Error != that1.Error {
+		return false
 	}
-	return i, nil
-}
-
-func (m *StartSuperStep) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if !this.Stats.Equal(that1.Stats) {
+		return false
 	}
-	return dAtA[:n], nil
+	return true
 }
+func (this *LoadPartitionVerticesWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *StartSuperStep) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func (m *ShowAggregatedValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*LoadPartitionVerticesWorkerAck)
+	if !ok {
+		that2, ok := that.(LoadPartitionVerticesWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ShowAggregatedValue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func (m *ShowAggregatedValueAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ShowAggregatedValueAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.AggregatedValues) > 0 {
-		for k, _ := range m.AggregatedValues {
-			dAtA[i] = 0xa
-			i++
-			v := m.AggregatedValues[k]
-			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
-			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCommand(dAtA, i, uint64(len(v)))
-			i += copy(dAtA[i:], v)
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
+	}
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
+	}
+	for i := range this.Partitions {
+		if !this.Partitions[i].Equal(that1.Partitions[i]) {
+			return false
 		}
 	}
-	return i, nil
+	return true
 }
-
-func (m *Shutdown) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (this *PartitionStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Shutdown) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
 
-func (m *ShutdownAck) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+	that1, ok := that.(*PartitionStats)
+	if !ok {
+		that2, ok := that.(PartitionStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ShutdownAck) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	return i, nil
-}
-
-func encodeVarintCommand(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *LoadVertex) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.PartitionId != that1.PartitionId {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.VertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if this.Vertices != that1.Vertices {
+		return false
 	}
-	return n
-}
-
-func (m *LoadVertexAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Edges != that1.Edges {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.VertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if this.MessagesSent != that1.MessagesSent {
+		return false
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if this.CutEdges != that1.CutEdges {
+		return false
 	}
-	return n
-}
-
-func (m *LoadPartitionVertices) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.VertexBytes != that1.VertexBytes {
+		return false
 	}
-	var l int
-	_ = l
-	if m.NumOfPartitions != 0 {
-		n += 1 + sovCommand(uint64(m.NumOfPartitions))
+	if this.EdgeBytes != that1.EdgeBytes {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *LoadPartitionVerticesAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *PartitionTraffic) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		n += 1 + sovCommand(uint64(m.PartitionId))
+
+	that1, ok := that.(*PartitionTraffic)
+	if !ok {
+		that2, ok := that.(PartitionTraffic)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *LoadPartitionVerticesWorkerAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.SrcPartition != that1.SrcPartition {
+		return false
 	}
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		l = m.WorkerPid.Size()
-		n += 1 + l + sovCommand(uint64(l))
+	if this.DestPartition != that1.DestPartition {
+		return false
 	}
-	return n
-}
-
-func (m *GetVertexValue) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.LocalMessages != that1.LocalMessages {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.VertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if this.RemoteMessages != that1.RemoteMessages {
+		return false
 	}
-	return n
+	return true
 }
+func (this *GetVertexValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *GetVertexValueAck) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*GetVertexValue)
+	if !ok {
+		that2, ok := that.(GetVertexValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.VertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *SuperStepBarrier) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *GetVertexValueAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *SuperStepBarrierAck) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*GetVertexValueAck)
+	if !ok {
+		that2, ok := that.(GetVertexValueAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.VertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *SuperStepBarrierPartitionAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		n += 1 + sovCommand(uint64(m.PartitionId))
+	if this.Value != that1.Value {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *SuperStepBarrierWorkerAck) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		l = m.WorkerPid.Size()
-		n += 1 + l + sovCommand(uint64(l))
+func (this *SuperStepBarrier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return n
-}
 
-func (m *Compute) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SuperStepBarrier)
+	if !ok {
+		that2, ok := that.(SuperStepBarrier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.SuperStep != 0 {
-		n += 1 + sovCommand(uint64(m.SuperStep))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.AggregatedValues) > 0 {
-		for k, v := range m.AggregatedValues {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovCommand(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
-		}
+	if this.SuperStep != that1.SuperStep {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *ComputeAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *SuperStepBarrierAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.VertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+
+	that1, ok := that.(*SuperStepBarrierAck)
+	if !ok {
+		that2, ok := that.(SuperStepBarrierAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	if m.Halted {
-		n += 2
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.AggregatedValues) > 0 {
-		for k, v := range m.AggregatedValues {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovCommand(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
-		}
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	return n
+	return true
 }
+func (this *SuperStepBarrierPartitionAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ComputePartitionAck) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SuperStepBarrierPartitionAck)
+	if !ok {
+		that2, ok := that.(SuperStepBarrierPartitionAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		n += 1 + sovCommand(uint64(m.PartitionId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.AggregatedValues) > 0 {
-		for k, v := range m.AggregatedValues {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovCommand(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
-		}
+	if this.PartitionId != that1.PartitionId {
+		return false
 	}
-	return n
+	return true
 }
+func (this *SuperStepBarrierWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ComputeWorkerAck) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*SuperStepBarrierWorkerAck)
+	if !ok {
+		that2, ok := that.(SuperStepBarrierWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		l = m.WorkerPid.Size()
-		n += 1 + l + sovCommand(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.AggregatedValues) > 0 {
-		for k, v := range m.AggregatedValues {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.Size()
-				l += 1 + sovCommand(uint64(l))
-			}
-			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
-		}
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *Compute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *SuperStepMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Compute)
+	if !ok {
+		that2, ok := that.(Compute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.SuperStep != 0 {
-		n += 1 + sovCommand(uint64(m.SuperStep))
+	if this.SuperStep != that1.SuperStep {
+		return false
 	}
-	l = len(m.SrcVertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
+		return false
 	}
-	l = len(m.DestVertexId)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	for i := range this.AggregatedValues {
+		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
+			return false
+		}
 	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovCommand(uint64(l))
+	if this.Stage != that1.Stage {
+		return false
 	}
-	return n
-}
-
-func (m *SuperStepMessageAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.StageParams) != len(that1.StageParams) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Uuid)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	for i := range this.StageParams {
+		if this.StageParams[i] != that1.StageParams[i] {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *InitPartition) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.TrackVertexTraffic != that1.TrackVertexTraffic {
+		return false
 	}
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		n += 1 + sovCommand(uint64(m.PartitionId))
+	if this.NumericVertexIds != that1.NumericVertexIds {
+		return false
 	}
-	return n
+	if !this.FlowControl.Equal(that1.FlowControl) {
+		return false
+	}
+	return true
 }
+func (this *ComputeAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *InitPartitionAck) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ComputeAck)
+	if !ok {
+		that2, ok := that.(ComputeAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.PartitionId != 0 {
-		n += 1 + sovCommand(uint64(m.PartitionId))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *ClusterInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	var l int
-	_ = l
-	if len(m.WorkerInfo) > 0 {
-		for _, e := range m.WorkerInfo {
-			l = e.Size()
-			n += 1 + l + sovCommand(uint64(l))
+	if this.Halted != that1.Halted {
+		return false
+	}
+	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
+		return false
+	}
+	for i := range this.AggregatedValues {
+		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
+			return false
 		}
 	}
-	return n
+	if this.MessagesSent != that1.MessagesSent {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
 }
+func (this *ComputePartitionAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *ClusterInfo_WorkerInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*ComputePartitionAck)
+	if !ok {
+		that2, ok := that.(ComputePartitionAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		l = m.WorkerPid.Size()
-		n += 1 + l + sovCommand(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.Partitions) > 0 {
-		l = 0
-		for _, e := range m.Partitions {
-			l += sovCommand(uint64(e))
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
+		return false
+	}
+	for i := range this.AggregatedValues {
+		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
+			return false
 		}
-		n += 1 + sovCommand(uint64(l)) + l
 	}
-	return n
-}
-
-func (m *InitWorker) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.Stats.Equal(that1.Stats) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Coordinator != nil {
-		l = m.Coordinator.Size()
-		n += 1 + l + sovCommand(uint64(l))
+	if len(this.InternalMessages) != len(that1.InternalMessages) {
+		return false
 	}
-	if len(m.Partitions) > 0 {
-		l = 0
-		for _, e := range m.Partitions {
-			l += sovCommand(uint64(e))
+	for i := range this.InternalMessages {
+		if this.InternalMessages[i] != that1.InternalMessages[i] {
+			return false
 		}
-		n += 1 + sovCommand(uint64(l)) + l
 	}
-	return n
-}
-
-func (m *InitWorkerAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Error != that1.Error {
+		return false
 	}
-	var l int
-	_ = l
-	if m.WorkerPid != nil {
-		l = m.WorkerPid.Size()
-		n += 1 + l + sovCommand(uint64(l))
+	if this.LocalMessages != that1.LocalMessages {
+		return false
 	}
-	return n
+	return true
 }
-
-func (m *NewCluster) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *ComputeWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.Workers) > 0 {
-		for _, e := range m.Workers {
-			l = e.Size()
-			n += 1 + l + sovCommand(uint64(l))
+
+	that1, ok := that.(*ComputeWorkerAck)
+	if !ok {
+		that2, ok := that.(ComputeWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.NrOfPartitions != 0 {
-		n += 1 + sovCommand(uint64(m.NrOfPartitions))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
-}
-
-func (m *NewCluster_WorkerReq) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.Remote {
-		n += 2
+	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
+		return false
 	}
-	l = len(m.HostAndPort)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	for i := range this.AggregatedValues {
+		if !this.AggregatedValues[i].Equal(that1.AggregatedValues[i]) {
+			return false
+		}
 	}
-	return n
-}
-
-func (m *NewClusterAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *CoordinatorStats) Size() (n int) {
-	if m == nil {
-		return 0
+	for i := range this.Partitions {
+		if !this.Partitions[i].Equal(that1.Partitions[i]) {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *CoordinatorStatsAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(this.Traffic) != len(that1.Traffic) {
+		return false
 	}
-	var l int
-	_ = l
-	if m.SuperStep != 0 {
-		n += 1 + sovCommand(uint64(m.SuperStep))
+	for i := range this.Traffic {
+		if !this.Traffic[i].Equal(that1.Traffic[i]) {
+			return false
+		}
 	}
-	if m.NrOfActiveVertex != 0 {
-		n += 1 + sovCommand(uint64(m.NrOfActiveVertex))
+	if len(this.VertexMoves) != len(that1.VertexMoves) {
+		return false
 	}
-	if m.NrOfSentMessages != 0 {
-		n += 1 + sovCommand(uint64(m.NrOfSentMessages))
+	for i := range this.VertexMoves {
+		if !this.VertexMoves[i].Equal(that1.VertexMoves[i]) {
+			return false
+		}
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovCommand(uint64(l))
+	if !this.MessageBatches.Equal(that1.MessageBatches) {
+		return false
 	}
-	return n
-}
-
-func (m *StartSuperStep) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.FlowControl.Equal(that1.FlowControl) {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ShowAggregatedValue) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.Error != that1.Error {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	return true
 }
-
-func (m *ShowAggregatedValueAck) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *VertexMove) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	if len(m.AggregatedValues) > 0 {
-		for k, v := range m.AggregatedValues {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
-			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+
+	that1, ok := that.(*VertexMove)
+	if !ok {
+		that2, ok := that.(VertexMove)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *Shutdown) Size() (n int) {
-	if m == nil {
-		return 0
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ShutdownAck) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.VertexId != that1.VertexId {
+		return false
 	}
-	var l int
-	_ = l
-	return n
+	if this.From != that1.From {
+		return false
+	}
+	if this.To != that1.To {
+		return false
+	}
+	if this.Gain != that1.Gain {
+		return false
+	}
+	return true
 }
+func (this *SuperStepMessage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovCommand(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	that1, ok := that.(*SuperStepMessage)
+	if !ok {
+		that2, ok := that.(SuperStepMessage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	return n
-}
-func sozCommand(x uint64) (n int) {
-	return sovCommand(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *LoadVertex) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&LoadVertex{`,
-		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoadVertexAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.Seq != that1.Seq {
+		return false
 	}
-	s := strings.Join([]string{`&LoadVertexAck{`,
-		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoadPartitionVertices) String() string {
-	if this == nil {
-		return "nil"
+	if this.SuperStep != that1.SuperStep {
+		return false
 	}
-	s := strings.Join([]string{`&LoadPartitionVertices{`,
-		`NumOfPartitions:` + fmt.Sprintf("%v", this.NumOfPartitions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoadPartitionVerticesAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.SrcVertexId != that1.SrcVertexId {
+		return false
 	}
-	s := strings.Join([]string{`&LoadPartitionVerticesAck{`,
-		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
-		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *LoadPartitionVerticesWorkerAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.DestVertexId != that1.DestVertexId {
+		return false
 	}
-	s := strings.Join([]string{`&LoadPartitionVerticesWorkerAck{`,
-		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetVertexValue) String() string {
-	if this == nil {
-		return "nil"
+	if !this.Message.Equal(that1.Message) {
+		return false
 	}
-	s := strings.Join([]string{`&GetVertexValue{`,
-		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *GetVertexValueAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.SrcNumericId != that1.SrcNumericId {
+		return false
 	}
-	s := strings.Join([]string{`&GetVertexValueAck{`,
-		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuperStepBarrier) String() string {
-	if this == nil {
-		return "nil"
+	if this.DestNumericId != that1.DestNumericId {
+		return false
 	}
-	s := strings.Join([]string{`&SuperStepBarrier{`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *SuperStepBarrierAck) String() string {
-	if this == nil {
-		return "nil"
+func (this *SuperStepMessageAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&SuperStepBarrierAck{`,
-		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuperStepBarrierPartitionAck) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*SuperStepMessageAck)
+	if !ok {
+		that2, ok := that.(SuperStepMessageAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&SuperStepBarrierPartitionAck{`,
-		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuperStepBarrierWorkerAck) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&SuperStepBarrierWorkerAck{`,
-		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.Seq != that1.Seq {
+		return false
+	}
+	return true
 }
-func (this *Compute) String() string {
-	if this == nil {
-		return "nil"
+func (this *SuperStepMessageBatch) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
+
+	that1, ok := that.(*SuperStepMessageBatch)
+	if !ok {
+		that2, ok := that.(SuperStepMessageBatch)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForAggregatedValues += "}"
-	s := strings.Join([]string{`&Compute{`,
-		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
-		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ComputeAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.Seq != that1.Seq {
+		return false
 	}
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	if this.SuperStep != that1.SuperStep {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	if this.Count != that1.Count {
+		return false
 	}
-	mapStringForAggregatedValues += "}"
-	s := strings.Join([]string{`&ComputeAck{`,
-		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
-		`Halted:` + fmt.Sprintf("%v", this.Halted) + `,`,
-		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ComputePartitionAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.NumericVertexIds != that1.NumericVertexIds {
+		return false
 	}
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	if this.Compression != that1.Compression {
+		return false
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
 	}
-	mapStringForAggregatedValues += "}"
-	s := strings.Join([]string{`&ComputePartitionAck{`,
-		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
-		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *ComputeWorkerAck) String() string {
-	if this == nil {
-		return "nil"
+func (this *SuperStepMessageBatchAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
+
+	that1, ok := that.(*SuperStepMessageBatchAck)
+	if !ok {
+		that2, ok := that.(SuperStepMessageBatchAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]*types.Any{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForAggregatedValues += "}"
-	s := strings.Join([]string{`&ComputeWorkerAck{`,
-		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
-		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *SuperStepMessage) String() string {
-	if this == nil {
-		return "nil"
+	if this.Seq != that1.Seq {
+		return false
 	}
-	s := strings.Join([]string{`&SuperStepMessage{`,
-		`Uuid:` + fmt.Sprintf("%v", this.Uuid) + `,`,
-		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
-		`SrcVertexId:` + fmt.Sprintf("%v", this.SrcVertexId) + `,`,
-		`DestVertexId:` + fmt.Sprintf("%v", this.DestVertexId) + `,`,
-		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "Any", "types.Any", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *SuperStepMessageAck) String() string {
-	if this == nil {
-		return "nil"
+func (this *MessageBatchStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&SuperStepMessageAck{`,
-		`Uuid:` + fmt.Sprintf("%v", this.Uuid) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InitPartition) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*MessageBatchStats)
+	if !ok {
+		that2, ok := that.(MessageBatchStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&InitPartition{`,
-		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InitPartitionAck) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&InitPartitionAck{`,
-		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ClusterInfo) String() string {
-	if this == nil {
-		return "nil"
+	if this.Batches != that1.Batches {
+		return false
 	}
-	s := strings.Join([]string{`&ClusterInfo{`,
-		`WorkerInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkerInfo), "ClusterInfo_WorkerInfo", "ClusterInfo_WorkerInfo", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ClusterInfo_WorkerInfo) String() string {
-	if this == nil {
-		return "nil"
+	if this.Messages != that1.Messages {
+		return false
 	}
-	s := strings.Join([]string{`&ClusterInfo_WorkerInfo{`,
-		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
-		`Partitions:` + fmt.Sprintf("%v", this.Partitions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InitWorker) String() string {
-	if this == nil {
-		return "nil"
+	if this.RawBytes != that1.RawBytes {
+		return false
 	}
-	s := strings.Join([]string{`&InitWorker{`,
-		`Coordinator:` + strings.Replace(fmt.Sprintf("%v", this.Coordinator), "PID", "actor.PID", 1) + `,`,
-		`Partitions:` + fmt.Sprintf("%v", this.Partitions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *InitWorkerAck) String() string {
-	if this == nil {
-		return "nil"
+	if this.EncodedBytes != that1.EncodedBytes {
+		return false
 	}
-	s := strings.Join([]string{`&InitWorkerAck{`,
-		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NewCluster) String() string {
-	if this == nil {
-		return "nil"
+	if this.MaxBatchMessages != that1.MaxBatchMessages {
+		return false
 	}
-	s := strings.Join([]string{`&NewCluster{`,
-		`Workers:` + strings.Replace(fmt.Sprintf("%v", this.Workers), "NewCluster_WorkerReq", "NewCluster_WorkerReq", 1) + `,`,
-		`NrOfPartitions:` + fmt.Sprintf("%v", this.NrOfPartitions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *NewCluster_WorkerReq) String() string {
-	if this == nil {
-		return "nil"
+	if this.MaxBatchBytes != that1.MaxBatchBytes {
+		return false
 	}
-	s := strings.Join([]string{`&NewCluster_WorkerReq{`,
-		`Remote:` + fmt.Sprintf("%v", this.Remote) + `,`,
-		`HostAndPort:` + fmt.Sprintf("%v", this.HostAndPort) + `,`,
-		`}`,
-	}, "")
-	return s
+	return true
 }
-func (this *NewClusterAck) String() string {
-	if this == nil {
-		return "nil"
+func (this *FlowControl) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&NewClusterAck{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CoordinatorStats) String() string {
-	if this == nil {
-		return "nil"
+
+	that1, ok := that.(*FlowControl)
+	if !ok {
+		that2, ok := that.(FlowControl)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	s := strings.Join([]string{`&CoordinatorStats{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CoordinatorStatsAck) String() string {
-	if this == nil {
-		return "nil"
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	s := strings.Join([]string{`&CoordinatorStatsAck{`,
-		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
-		`NrOfActiveVertex:` + fmt.Sprintf("%v", this.NrOfActiveVertex) + `,`,
-		`NrOfSentMessages:` + fmt.Sprintf("%v", this.NrOfSentMessages) + `,`,
-		`State:` + fmt.Sprintf("%v", this.State) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *StartSuperStep) String() string {
-	if this == nil {
-		return "nil"
+	if this.VertexInflight != that1.VertexInflight {
+		return false
 	}
-	s := strings.Join([]string{`&StartSuperStep{`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ShowAggregatedValue) String() string {
-	if this == nil {
-		return "nil"
+	if this.PartitionInflight != that1.PartitionInflight {
+		return false
 	}
-	s := strings.Join([]string{`&ShowAggregatedValue{`,
-		`}`,
-	}, "")
-	return s
+	if this.WorkerInflight != that1.WorkerInflight {
+		return false
+	}
+	return true
 }
-func (this *ShowAggregatedValueAck) String() string {
-	if this == nil {
-		return "nil"
+func (this *FlowControlStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
-	for k, _ := range this.AggregatedValues {
-		keysForAggregatedValues = append(keysForAggregatedValues, k)
+
+	that1, ok := that.(*FlowControlStats)
+	if !ok {
+		that2, ok := that.(FlowControlStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
-	mapStringForAggregatedValues := "map[string]string{"
-	for _, k := range keysForAggregatedValues {
-		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	mapStringForAggregatedValues += "}"
-	s := strings.Join([]string{`&ShowAggregatedValueAck{`,
-		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
-		`}`,
-	}, "")
-	return s
+	if this.StalledMessages != that1.StalledMessages {
+		return false
+	}
+	if this.StallNanos != that1.StallNanos {
+		return false
+	}
+	if this.MaxVertexMailbox != that1.MaxVertexMailbox {
+		return false
+	}
+	if this.MaxPartitionMailbox != that1.MaxPartitionMailbox {
+		return false
+	}
+	if this.MaxWorkerMailbox != that1.MaxWorkerMailbox {
+		return false
+	}
+	return true
 }
-func (this *Shutdown) String() string {
-	if this == nil {
-		return "nil"
+func (this *MessageSpill) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	s := strings.Join([]string{`&Shutdown{`,
-		`}`,
-	}, "")
-	return s
+
+	that1, ok := that.(*MessageSpill)
+	if !ok {
+		that2, ok := that.(MessageSpill)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MemoryLimit != that1.MemoryLimit {
+		return false
+	}
+	if this.Dir != that1.Dir {
+		return false
+	}
+	return true
 }
-func (this *ShutdownAck) String() string {
-	if this == nil {
-		return "nil"
+func (this *PartitionStore) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PartitionStore)
+	if !ok {
+		that2, ok := that.(PartitionStore)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if this.Dir != that1.Dir {
+		return false
+	}
+	if this.MemoryLimit != that1.MemoryLimit {
+		return false
+	}
+	return true
+}
+func (this *InitPartition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InitPartition)
+	if !ok {
+		that2, ok := that.(InitPartition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if this.NumericVertexIds != that1.NumericVertexIds {
+		return false
+	}
+	if !this.Store.Equal(that1.Store) {
+		return false
+	}
+	return true
+}
+func (this *InitPartitionAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InitPartitionAck)
+	if !ok {
+		that2, ok := that.(InitPartitionAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	return true
+}
+func (this *ClusterInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterInfo)
+	if !ok {
+		that2, ok := that.(ClusterInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.WorkerInfo) != len(that1.WorkerInfo) {
+		return false
+	}
+	for i := range this.WorkerInfo {
+		if !this.WorkerInfo[i].Equal(that1.WorkerInfo[i]) {
+			return false
+		}
+	}
+	if len(this.MovedVertices) != len(that1.MovedVertices) {
+		return false
+	}
+	for i := range this.MovedVertices {
+		if this.MovedVertices[i] != that1.MovedVertices[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ClusterInfo_WorkerInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClusterInfo_WorkerInfo)
+	if !ok {
+		that2, ok := that.(ClusterInfo_WorkerInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
+	}
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
+	}
+	for i := range this.Partitions {
+		if this.Partitions[i] != that1.Partitions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PluginFingerprint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PluginFingerprint)
+	if !ok {
+		that2, ok := that.(PluginFingerprint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if len(this.Aggregators) != len(that1.Aggregators) {
+		return false
+	}
+	for i := range this.Aggregators {
+		if this.Aggregators[i] != that1.Aggregators[i] {
+			return false
+		}
+	}
+	if len(this.MessageTypes) != len(that1.MessageTypes) {
+		return false
+	}
+	for i := range this.MessageTypes {
+		if this.MessageTypes[i] != that1.MessageTypes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *InitWorker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InitWorker)
+	if !ok {
+		that2, ok := that.(InitWorker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Coordinator.Equal(that1.Coordinator) {
+		return false
+	}
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
+	}
+	for i := range this.Partitions {
+		if this.Partitions[i] != that1.Partitions[i] {
+			return false
+		}
+	}
+	if !this.Fingerprint.Equal(that1.Fingerprint) {
+		return false
+	}
+	if this.VertexCacheSize != that1.VertexCacheSize {
+		return false
+	}
+	if this.NumericVertexIds != that1.NumericVertexIds {
+		return false
+	}
+	if this.MessageBatch != that1.MessageBatch {
+		return false
+	}
+	if this.MessageCompression != that1.MessageCompression {
+		return false
+	}
+	if !this.MessageSpill.Equal(that1.MessageSpill) {
+		return false
+	}
+	if !this.PartitionStore.Equal(that1.PartitionStore) {
+		return false
+	}
+	return true
+}
+func (this *InitWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*InitWorkerAck)
+	if !ok {
+		that2, ok := that.(InitWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
+	}
+	if len(this.Algorithms) != len(that1.Algorithms) {
+		return false
+	}
+	for i := range this.Algorithms {
+		if this.Algorithms[i] != that1.Algorithms[i] {
+			return false
+		}
+	}
+	if !this.Fingerprint.Equal(that1.Fingerprint) {
+		return false
+	}
+	return true
+}
+func (this *NewCluster) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NewCluster)
+	if !ok {
+		that2, ok := that.(NewCluster)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Workers) != len(that1.Workers) {
+		return false
+	}
+	for i := range this.Workers {
+		if !this.Workers[i].Equal(that1.Workers[i]) {
+			return false
+		}
+	}
+	if this.NrOfPartitions != that1.NrOfPartitions {
+		return false
+	}
+	if this.MinRegisteredWorkers != that1.MinRegisteredWorkers {
+		return false
+	}
+	if this.RegistrationTimeoutMillis != that1.RegistrationTimeoutMillis {
+		return false
+	}
+	if this.WorkerDns != that1.WorkerDns {
+		return false
+	}
+	if this.WorkerDnsSrv != that1.WorkerDnsSrv {
+		return false
+	}
+	if this.RebalanceThreshold != that1.RebalanceThreshold {
+		return false
+	}
+	if this.MaxVertexMoves != that1.MaxVertexMoves {
+		return false
+	}
+	if this.VertexCacheSize != that1.VertexCacheSize {
+		return false
+	}
+	if this.NumericVertexIds != that1.NumericVertexIds {
+		return false
+	}
+	if this.MessageBatch != that1.MessageBatch {
+		return false
+	}
+	if this.MessageCompression != that1.MessageCompression {
+		return false
+	}
+	if !this.FlowControl.Equal(that1.FlowControl) {
+		return false
+	}
+	if !this.MessageSpill.Equal(that1.MessageSpill) {
+		return false
+	}
+	if !this.PartitionStore.Equal(that1.PartitionStore) {
+		return false
+	}
+	if this.SuperstepBarrier != that1.SuperstepBarrier {
+		return false
+	}
+	return true
+}
+func (this *NewCluster_WorkerReq) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NewCluster_WorkerReq)
+	if !ok {
+		that2, ok := that.(NewCluster_WorkerReq)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Remote != that1.Remote {
+		return false
+	}
+	if this.HostAndPort != that1.HostAndPort {
+		return false
+	}
+	return true
+}
+func (this *NewClusterAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NewClusterAck)
+	if !ok {
+		that2, ok := that.(NewClusterAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *RegisterWorker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterWorker)
+	if !ok {
+		that2, ok := that.(RegisterWorker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HostAndPort != that1.HostAndPort {
+		return false
+	}
+	return true
+}
+func (this *RegisterWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisterWorkerAck)
+	if !ok {
+		that2, ok := that.(RegisterWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *VertexState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VertexState)
+	if !ok {
+		that2, ok := that.(VertexState)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VertexId != that1.VertexId {
+		return false
+	}
+	if !this.Vertex.Equal(that1.Vertex) {
+		return false
+	}
+	if this.Halted != that1.Halted {
+		return false
+	}
+	if len(this.Messages) != len(that1.Messages) {
+		return false
+	}
+	for i := range this.Messages {
+		if !this.Messages[i].Equal(that1.Messages[i]) {
+			return false
+		}
+	}
+	if !this.OutEdges.Equal(that1.OutEdges) {
+		return false
+	}
+	return true
+}
+func (this *OutEdges) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutEdges)
+	if !ok {
+		that2, ok := that.(OutEdges)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Dests) != len(that1.Dests) {
+		return false
+	}
+	for i := range this.Dests {
+		if this.Dests[i] != that1.Dests[i] {
+			return false
+		}
+	}
+	if len(this.Uint32Values) != len(that1.Uint32Values) {
+		return false
+	}
+	for i := range this.Uint32Values {
+		if this.Uint32Values[i] != that1.Uint32Values[i] {
+			return false
+		}
+	}
+	if len(this.Float64Values) != len(that1.Float64Values) {
+		return false
+	}
+	for i := range this.Float64Values {
+		if this.Float64Values[i] != that1.Float64Values[i] {
+			return false
+		}
+	}
+	if len(this.BytesValues) != len(that1.BytesValues) {
+		return false
+	}
+	for i := range this.BytesValues {
+		if !bytes.Equal(this.BytesValues[i], that1.BytesValues[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExportVertex) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportVertex)
+	if !ok {
+		that2, ok := that.(ExportVertex)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ExportVertexAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportVertexAck)
+	if !ok {
+		that2, ok := that.(ExportVertexAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.VertexId != that1.VertexId {
+		return false
+	}
+	if !this.State.Equal(that1.State) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ExportPartition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportPartition)
+	if !ok {
+		that2, ok := that.(ExportPartition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	return true
+}
+func (this *ExportPartitionAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportPartitionAck)
+	if !ok {
+		that2, ok := that.(ExportPartitionAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if len(this.Vertices) != len(that1.Vertices) {
+		return false
+	}
+	for i := range this.Vertices {
+		if !this.Vertices[i].Equal(that1.Vertices[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ImportPartition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportPartition)
+	if !ok {
+		that2, ok := that.(ImportPartition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if len(this.Vertices) != len(that1.Vertices) {
+		return false
+	}
+	for i := range this.Vertices {
+		if !this.Vertices[i].Equal(that1.Vertices[i]) {
+			return false
+		}
+	}
+	if this.NumOfPartitions != that1.NumOfPartitions {
+		return false
+	}
+	if this.NumericVertexIds != that1.NumericVertexIds {
+		return false
+	}
+	if !this.Store.Equal(that1.Store) {
+		return false
+	}
+	return true
+}
+func (this *ImportPartitionAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportPartitionAck)
+	if !ok {
+		that2, ok := that.(ImportPartitionAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ReleasePartition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReleasePartition)
+	if !ok {
+		that2, ok := that.(ReleasePartition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	return true
+}
+func (this *ExportVertices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportVertices)
+	if !ok {
+		that2, ok := that.(ExportVertices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if len(this.VertexIds) != len(that1.VertexIds) {
+		return false
+	}
+	for i := range this.VertexIds {
+		if this.VertexIds[i] != that1.VertexIds[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ExportVerticesAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExportVerticesAck)
+	if !ok {
+		that2, ok := that.(ExportVerticesAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if len(this.Vertices) != len(that1.Vertices) {
+		return false
+	}
+	for i := range this.Vertices {
+		if !this.Vertices[i].Equal(that1.Vertices[i]) {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ImportVertices) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportVertices)
+	if !ok {
+		that2, ok := that.(ImportVertices)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if len(this.Vertices) != len(that1.Vertices) {
+		return false
+	}
+	for i := range this.Vertices {
+		if !this.Vertices[i].Equal(that1.Vertices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ImportVerticesAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ImportVerticesAck)
+	if !ok {
+		that2, ok := that.(ImportVerticesAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PartitionId != that1.PartitionId {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *AddWorker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddWorker)
+	if !ok {
+		that2, ok := that.(AddWorker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Remote != that1.Remote {
+		return false
+	}
+	if this.HostAndPort != that1.HostAndPort {
+		return false
+	}
+	return true
+}
+func (this *AddWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddWorkerAck)
+	if !ok {
+		that2, ok := that.(AddWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *DrainWorker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainWorker)
+	if !ok {
+		that2, ok := that.(DrainWorker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Worker != that1.Worker {
+		return false
+	}
+	return true
+}
+func (this *DrainWorkerAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainWorkerAck)
+	if !ok {
+		that2, ok := that.(DrainWorkerAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *RebalanceWorkers) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RebalanceWorkers)
+	if !ok {
+		that2, ok := that.(RebalanceWorkers)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *RebalanceWorkersAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RebalanceWorkersAck)
+	if !ok {
+		that2, ok := that.(RebalanceWorkersAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MovedPartitions != that1.MovedPartitions {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *CoordinatorStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CoordinatorStats)
+	if !ok {
+		that2, ok := that.(CoordinatorStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *CoordinatorStatsAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CoordinatorStatsAck)
+	if !ok {
+		that2, ok := that.(CoordinatorStatsAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SuperStep != that1.SuperStep {
+		return false
+	}
+	if this.NrOfActiveVertex != that1.NrOfActiveVertex {
+		return false
+	}
+	if this.NrOfSentMessages != that1.NrOfSentMessages {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Stage != that1.Stage {
+		return false
+	}
+	if this.Algorithm != that1.Algorithm {
+		return false
+	}
+	if len(this.Workers) != len(that1.Workers) {
+		return false
+	}
+	for i := range this.Workers {
+		if this.Workers[i] != that1.Workers[i] {
+			return false
+		}
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *ShowPartitions) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowPartitions)
+	if !ok {
+		that2, ok := that.(ShowPartitions)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ShowPartitionsAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowPartitionsAck)
+	if !ok {
+		that2, ok := that.(ShowPartitionsAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Partitions) != len(that1.Partitions) {
+		return false
+	}
+	for i := range this.Partitions {
+		if !this.Partitions[i].Equal(that1.Partitions[i]) {
+			return false
+		}
+	}
+	if len(this.Traffic) != len(that1.Traffic) {
+		return false
+	}
+	for i := range this.Traffic {
+		if !this.Traffic[i].Equal(that1.Traffic[i]) {
+			return false
+		}
+	}
+	if this.SuperSteps != that1.SuperSteps {
+		return false
+	}
+	if this.Edges != that1.Edges {
+		return false
+	}
+	if this.CutEdges != that1.CutEdges {
+		return false
+	}
+	if this.LocalMessages != that1.LocalMessages {
+		return false
+	}
+	if this.RemoteMessages != that1.RemoteMessages {
+		return false
+	}
+	if this.MovedVertices != that1.MovedVertices {
+		return false
+	}
+	if !this.MessageBatches.Equal(that1.MessageBatches) {
+		return false
+	}
+	if !this.FlowControl.Equal(that1.FlowControl) {
+		return false
+	}
+	return true
+}
+func (this *ShowPartitionsAck_Partition) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowPartitionsAck_Partition)
+	if !ok {
+		that2, ok := that.(ShowPartitionsAck_Partition)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Stats.Equal(that1.Stats) {
+		return false
+	}
+	if this.Worker != that1.Worker {
+		return false
+	}
+	return true
+}
+func (this *StartSuperStep) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartSuperStep)
+	if !ok {
+		that2, ok := that.(StartSuperStep)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *SelectAlgorithm) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SelectAlgorithm)
+	if !ok {
+		that2, ok := that.(SelectAlgorithm)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	return true
+}
+func (this *SelectAlgorithmAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SelectAlgorithmAck)
+	if !ok {
+		that2, ok := that.(SelectAlgorithmAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.WorkerPid.Equal(that1.WorkerPid) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PipelineStage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PipelineStage)
+	if !ok {
+		that2, ok := that.(PipelineStage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.Params) != len(that1.Params) {
+		return false
+	}
+	for i := range this.Params {
+		if this.Params[i] != that1.Params[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *StartPipeline) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartPipeline)
+	if !ok {
+		that2, ok := that.(StartPipeline)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Stages) != len(that1.Stages) {
+		return false
+	}
+	for i := range this.Stages {
+		if !this.Stages[i].Equal(that1.Stages[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StartPipelineAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartPipelineAck)
+	if !ok {
+		that2, ok := that.(StartPipelineAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *StageStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StageStats)
+	if !ok {
+		that2, ok := that.(StageStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.SuperSteps != that1.SuperSteps {
+		return false
+	}
+	if this.NrOfSentMessages != that1.NrOfSentMessages {
+		return false
+	}
+	if this.ElapsedMillis != that1.ElapsedMillis {
+		return false
+	}
+	if this.Completed != that1.Completed {
+		return false
+	}
+	return true
+}
+func (this *ShowPipeline) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowPipeline)
+	if !ok {
+		that2, ok := that.(ShowPipeline)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ShowPipelineAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowPipelineAck)
+	if !ok {
+		that2, ok := that.(ShowPipelineAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Stages) != len(that1.Stages) {
+		return false
+	}
+	for i := range this.Stages {
+		if !this.Stages[i].Equal(that1.Stages[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ShowAggregatedValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowAggregatedValue)
+	if !ok {
+		that2, ok := that.(ShowAggregatedValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ShowAggregatedValueAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShowAggregatedValueAck)
+	if !ok {
+		that2, ok := that.(ShowAggregatedValueAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AggregatedValues) != len(that1.AggregatedValues) {
+		return false
+	}
+	for i := range this.AggregatedValues {
+		if this.AggregatedValues[i] != that1.AggregatedValues[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *Shutdown) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Shutdown)
+	if !ok {
+		that2, ok := that.(Shutdown)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *ShutdownAck) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShutdownAck)
+	if !ok {
+		that2, ok := that.(ShutdownAck)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *LoadVertex) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.LoadVertex{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoadVertexAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.LoadVertexAck{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoadPartitionVertices) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.LoadPartitionVertices{")
+	s = append(s, "NumOfPartitions: "+fmt.Sprintf("%#v", this.NumOfPartitions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoadPartitionVerticesAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.LoadPartitionVerticesAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	if this.Stats != nil {
+		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LoadPartitionVerticesWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.LoadPartitionVerticesWorkerAck{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	if this.Partitions != nil {
+		s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PartitionStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&command.PartitionStats{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "Vertices: "+fmt.Sprintf("%#v", this.Vertices)+",\n")
+	s = append(s, "Edges: "+fmt.Sprintf("%#v", this.Edges)+",\n")
+	s = append(s, "MessagesSent: "+fmt.Sprintf("%#v", this.MessagesSent)+",\n")
+	s = append(s, "CutEdges: "+fmt.Sprintf("%#v", this.CutEdges)+",\n")
+	s = append(s, "VertexBytes: "+fmt.Sprintf("%#v", this.VertexBytes)+",\n")
+	s = append(s, "EdgeBytes: "+fmt.Sprintf("%#v", this.EdgeBytes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PartitionTraffic) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&command.PartitionTraffic{")
+	s = append(s, "SrcPartition: "+fmt.Sprintf("%#v", this.SrcPartition)+",\n")
+	s = append(s, "DestPartition: "+fmt.Sprintf("%#v", this.DestPartition)+",\n")
+	s = append(s, "LocalMessages: "+fmt.Sprintf("%#v", this.LocalMessages)+",\n")
+	s = append(s, "RemoteMessages: "+fmt.Sprintf("%#v", this.RemoteMessages)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetVertexValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.GetVertexValue{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetVertexValueAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.GetVertexValueAck{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepBarrier) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SuperStepBarrier{")
+	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepBarrierAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SuperStepBarrierAck{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepBarrierPartitionAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SuperStepBarrierPartitionAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepBarrierWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SuperStepBarrierWorkerAck{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Compute) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&command.Compute{")
+	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	if this.AggregatedValues != nil {
+		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
+	}
+	s = append(s, "Stage: "+fmt.Sprintf("%#v", this.Stage)+",\n")
+	keysForStageParams := make([]string, 0, len(this.StageParams))
+	for k, _ := range this.StageParams {
+		keysForStageParams = append(keysForStageParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStageParams)
+	mapStringForStageParams := "map[string]string{"
+	for _, k := range keysForStageParams {
+		mapStringForStageParams += fmt.Sprintf("%#v: %#v,", k, this.StageParams[k])
+	}
+	mapStringForStageParams += "}"
+	if this.StageParams != nil {
+		s = append(s, "StageParams: "+mapStringForStageParams+",\n")
+	}
+	s = append(s, "TrackVertexTraffic: "+fmt.Sprintf("%#v", this.TrackVertexTraffic)+",\n")
+	s = append(s, "NumericVertexIds: "+fmt.Sprintf("%#v", this.NumericVertexIds)+",\n")
+	if this.FlowControl != nil {
+		s = append(s, "FlowControl: "+fmt.Sprintf("%#v", this.FlowControl)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ComputeAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&command.ComputeAck{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "Halted: "+fmt.Sprintf("%#v", this.Halted)+",\n")
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	if this.AggregatedValues != nil {
+		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
+	}
+	s = append(s, "MessagesSent: "+fmt.Sprintf("%#v", this.MessagesSent)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ComputePartitionAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&command.ComputePartitionAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	if this.AggregatedValues != nil {
+		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
+	}
+	if this.Stats != nil {
+		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	}
+	keysForInternalMessages := make([]string, 0, len(this.InternalMessages))
+	for k, _ := range this.InternalMessages {
+		keysForInternalMessages = append(keysForInternalMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInternalMessages)
+	mapStringForInternalMessages := "map[string]uint64{"
+	for _, k := range keysForInternalMessages {
+		mapStringForInternalMessages += fmt.Sprintf("%#v: %#v,", k, this.InternalMessages[k])
+	}
+	mapStringForInternalMessages += "}"
+	if this.InternalMessages != nil {
+		s = append(s, "InternalMessages: "+mapStringForInternalMessages+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "LocalMessages: "+fmt.Sprintf("%#v", this.LocalMessages)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ComputeWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&command.ComputeWorkerAck{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	if this.AggregatedValues != nil {
+		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
+	}
+	if this.Partitions != nil {
+		s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
+	}
+	if this.Traffic != nil {
+		s = append(s, "Traffic: "+fmt.Sprintf("%#v", this.Traffic)+",\n")
+	}
+	if this.VertexMoves != nil {
+		s = append(s, "VertexMoves: "+fmt.Sprintf("%#v", this.VertexMoves)+",\n")
+	}
+	if this.MessageBatches != nil {
+		s = append(s, "MessageBatches: "+fmt.Sprintf("%#v", this.MessageBatches)+",\n")
+	}
+	if this.FlowControl != nil {
+		s = append(s, "FlowControl: "+fmt.Sprintf("%#v", this.FlowControl)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VertexMove) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&command.VertexMove{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	s = append(s, "From: "+fmt.Sprintf("%#v", this.From)+",\n")
+	s = append(s, "To: "+fmt.Sprintf("%#v", this.To)+",\n")
+	s = append(s, "Gain: "+fmt.Sprintf("%#v", this.Gain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepMessage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&command.SuperStepMessage{")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
+	s = append(s, "SrcVertexId: "+fmt.Sprintf("%#v", this.SrcVertexId)+",\n")
+	s = append(s, "DestVertexId: "+fmt.Sprintf("%#v", this.DestVertexId)+",\n")
+	if this.Message != nil {
+		s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	}
+	s = append(s, "SrcNumericId: "+fmt.Sprintf("%#v", this.SrcNumericId)+",\n")
+	s = append(s, "DestNumericId: "+fmt.Sprintf("%#v", this.DestNumericId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepMessageAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SuperStepMessageAck{")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepMessageBatch) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&command.SuperStepMessageBatch{")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
+	s = append(s, "Count: "+fmt.Sprintf("%#v", this.Count)+",\n")
+	s = append(s, "NumericVertexIds: "+fmt.Sprintf("%#v", this.NumericVertexIds)+",\n")
+	s = append(s, "Compression: "+fmt.Sprintf("%#v", this.Compression)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuperStepMessageBatchAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SuperStepMessageBatchAck{")
+	s = append(s, "Seq: "+fmt.Sprintf("%#v", this.Seq)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MessageBatchStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&command.MessageBatchStats{")
+	s = append(s, "Batches: "+fmt.Sprintf("%#v", this.Batches)+",\n")
+	s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	s = append(s, "RawBytes: "+fmt.Sprintf("%#v", this.RawBytes)+",\n")
+	s = append(s, "EncodedBytes: "+fmt.Sprintf("%#v", this.EncodedBytes)+",\n")
+	s = append(s, "MaxBatchMessages: "+fmt.Sprintf("%#v", this.MaxBatchMessages)+",\n")
+	s = append(s, "MaxBatchBytes: "+fmt.Sprintf("%#v", this.MaxBatchBytes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FlowControl) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.FlowControl{")
+	s = append(s, "VertexInflight: "+fmt.Sprintf("%#v", this.VertexInflight)+",\n")
+	s = append(s, "PartitionInflight: "+fmt.Sprintf("%#v", this.PartitionInflight)+",\n")
+	s = append(s, "WorkerInflight: "+fmt.Sprintf("%#v", this.WorkerInflight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FlowControlStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&command.FlowControlStats{")
+	s = append(s, "StalledMessages: "+fmt.Sprintf("%#v", this.StalledMessages)+",\n")
+	s = append(s, "StallNanos: "+fmt.Sprintf("%#v", this.StallNanos)+",\n")
+	s = append(s, "MaxVertexMailbox: "+fmt.Sprintf("%#v", this.MaxVertexMailbox)+",\n")
+	s = append(s, "MaxPartitionMailbox: "+fmt.Sprintf("%#v", this.MaxPartitionMailbox)+",\n")
+	s = append(s, "MaxWorkerMailbox: "+fmt.Sprintf("%#v", this.MaxWorkerMailbox)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MessageSpill) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.MessageSpill{")
+	s = append(s, "MemoryLimit: "+fmt.Sprintf("%#v", this.MemoryLimit)+",\n")
+	s = append(s, "Dir: "+fmt.Sprintf("%#v", this.Dir)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PartitionStore) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.PartitionStore{")
+	s = append(s, "Enabled: "+fmt.Sprintf("%#v", this.Enabled)+",\n")
+	s = append(s, "Dir: "+fmt.Sprintf("%#v", this.Dir)+",\n")
+	s = append(s, "MemoryLimit: "+fmt.Sprintf("%#v", this.MemoryLimit)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InitPartition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.InitPartition{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "NumericVertexIds: "+fmt.Sprintf("%#v", this.NumericVertexIds)+",\n")
+	if this.Store != nil {
+		s = append(s, "Store: "+fmt.Sprintf("%#v", this.Store)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InitPartitionAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.InitPartitionAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ClusterInfo{")
+	if this.WorkerInfo != nil {
+		s = append(s, "WorkerInfo: "+fmt.Sprintf("%#v", this.WorkerInfo)+",\n")
+	}
+	keysForMovedVertices := make([]string, 0, len(this.MovedVertices))
+	for k, _ := range this.MovedVertices {
+		keysForMovedVertices = append(keysForMovedVertices, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMovedVertices)
+	mapStringForMovedVertices := "map[string]uint64{"
+	for _, k := range keysForMovedVertices {
+		mapStringForMovedVertices += fmt.Sprintf("%#v: %#v,", k, this.MovedVertices[k])
+	}
+	mapStringForMovedVertices += "}"
+	if this.MovedVertices != nil {
+		s = append(s, "MovedVertices: "+mapStringForMovedVertices+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ClusterInfo_WorkerInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ClusterInfo_WorkerInfo{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PluginFingerprint) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&command.PluginFingerprint{")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Aggregators: "+fmt.Sprintf("%#v", this.Aggregators)+",\n")
+	s = append(s, "MessageTypes: "+fmt.Sprintf("%#v", this.MessageTypes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InitWorker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&command.InitWorker{")
+	if this.Coordinator != nil {
+		s = append(s, "Coordinator: "+fmt.Sprintf("%#v", this.Coordinator)+",\n")
+	}
+	s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
+	if this.Fingerprint != nil {
+		s = append(s, "Fingerprint: "+fmt.Sprintf("%#v", this.Fingerprint)+",\n")
+	}
+	s = append(s, "VertexCacheSize: "+fmt.Sprintf("%#v", this.VertexCacheSize)+",\n")
+	s = append(s, "NumericVertexIds: "+fmt.Sprintf("%#v", this.NumericVertexIds)+",\n")
+	s = append(s, "MessageBatch: "+fmt.Sprintf("%#v", this.MessageBatch)+",\n")
+	s = append(s, "MessageCompression: "+fmt.Sprintf("%#v", this.MessageCompression)+",\n")
+	if this.MessageSpill != nil {
+		s = append(s, "MessageSpill: "+fmt.Sprintf("%#v", this.MessageSpill)+",\n")
+	}
+	if this.PartitionStore != nil {
+		s = append(s, "PartitionStore: "+fmt.Sprintf("%#v", this.PartitionStore)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *InitWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.InitWorkerAck{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	s = append(s, "Algorithms: "+fmt.Sprintf("%#v", this.Algorithms)+",\n")
+	if this.Fingerprint != nil {
+		s = append(s, "Fingerprint: "+fmt.Sprintf("%#v", this.Fingerprint)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NewCluster) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&command.NewCluster{")
+	if this.Workers != nil {
+		s = append(s, "Workers: "+fmt.Sprintf("%#v", this.Workers)+",\n")
+	}
+	s = append(s, "NrOfPartitions: "+fmt.Sprintf("%#v", this.NrOfPartitions)+",\n")
+	s = append(s, "MinRegisteredWorkers: "+fmt.Sprintf("%#v", this.MinRegisteredWorkers)+",\n")
+	s = append(s, "RegistrationTimeoutMillis: "+fmt.Sprintf("%#v", this.RegistrationTimeoutMillis)+",\n")
+	s = append(s, "WorkerDns: "+fmt.Sprintf("%#v", this.WorkerDns)+",\n")
+	s = append(s, "WorkerDnsSrv: "+fmt.Sprintf("%#v", this.WorkerDnsSrv)+",\n")
+	s = append(s, "RebalanceThreshold: "+fmt.Sprintf("%#v", this.RebalanceThreshold)+",\n")
+	s = append(s, "MaxVertexMoves: "+fmt.Sprintf("%#v", this.MaxVertexMoves)+",\n")
+	s = append(s, "VertexCacheSize: "+fmt.Sprintf("%#v", this.VertexCacheSize)+",\n")
+	s = append(s, "NumericVertexIds: "+fmt.Sprintf("%#v", this.NumericVertexIds)+",\n")
+	s = append(s, "MessageBatch: "+fmt.Sprintf("%#v", this.MessageBatch)+",\n")
+	s = append(s, "MessageCompression: "+fmt.Sprintf("%#v", this.MessageCompression)+",\n")
+	if this.FlowControl != nil {
+		s = append(s, "FlowControl: "+fmt.Sprintf("%#v", this.FlowControl)+",\n")
+	}
+	if this.MessageSpill != nil {
+		s = append(s, "MessageSpill: "+fmt.Sprintf("%#v", this.MessageSpill)+",\n")
+	}
+	if this.PartitionStore != nil {
+		s = append(s, "PartitionStore: "+fmt.Sprintf("%#v", this.PartitionStore)+",\n")
+	}
+	s = append(s, "SuperstepBarrier: "+fmt.Sprintf("%#v", this.SuperstepBarrier)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NewCluster_WorkerReq) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.NewCluster_WorkerReq{")
+	s = append(s, "Remote: "+fmt.Sprintf("%#v", this.Remote)+",\n")
+	s = append(s, "HostAndPort: "+fmt.Sprintf("%#v", this.HostAndPort)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NewClusterAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.NewClusterAck{")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegisterWorker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.RegisterWorker{")
+	s = append(s, "HostAndPort: "+fmt.Sprintf("%#v", this.HostAndPort)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RegisterWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.RegisterWorkerAck{")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VertexState) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&command.VertexState{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	if this.Vertex != nil {
+		s = append(s, "Vertex: "+fmt.Sprintf("%#v", this.Vertex)+",\n")
+	}
+	s = append(s, "Halted: "+fmt.Sprintf("%#v", this.Halted)+",\n")
+	if this.Messages != nil {
+		s = append(s, "Messages: "+fmt.Sprintf("%#v", this.Messages)+",\n")
+	}
+	if this.OutEdges != nil {
+		s = append(s, "OutEdges: "+fmt.Sprintf("%#v", this.OutEdges)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OutEdges) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&command.OutEdges{")
+	s = append(s, "Dests: "+fmt.Sprintf("%#v", this.Dests)+",\n")
+	s = append(s, "Uint32Values: "+fmt.Sprintf("%#v", this.Uint32Values)+",\n")
+	s = append(s, "Float64Values: "+fmt.Sprintf("%#v", this.Float64Values)+",\n")
+	s = append(s, "BytesValues: "+fmt.Sprintf("%#v", this.BytesValues)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportVertex) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.ExportVertex{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportVertexAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.ExportVertexAck{")
+	s = append(s, "VertexId: "+fmt.Sprintf("%#v", this.VertexId)+",\n")
+	if this.State != nil {
+		s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportPartition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.ExportPartition{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportPartitionAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.ExportPartitionAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	if this.Vertices != nil {
+		s = append(s, "Vertices: "+fmt.Sprintf("%#v", this.Vertices)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportPartition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&command.ImportPartition{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	if this.Vertices != nil {
+		s = append(s, "Vertices: "+fmt.Sprintf("%#v", this.Vertices)+",\n")
+	}
+	s = append(s, "NumOfPartitions: "+fmt.Sprintf("%#v", this.NumOfPartitions)+",\n")
+	s = append(s, "NumericVertexIds: "+fmt.Sprintf("%#v", this.NumericVertexIds)+",\n")
+	if this.Store != nil {
+		s = append(s, "Store: "+fmt.Sprintf("%#v", this.Store)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportPartitionAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ImportPartitionAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReleasePartition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.ReleasePartition{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportVertices) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ExportVertices{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "VertexIds: "+fmt.Sprintf("%#v", this.VertexIds)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ExportVerticesAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&command.ExportVerticesAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	if this.Vertices != nil {
+		s = append(s, "Vertices: "+fmt.Sprintf("%#v", this.Vertices)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportVertices) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ImportVertices{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	if this.Vertices != nil {
+		s = append(s, "Vertices: "+fmt.Sprintf("%#v", this.Vertices)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ImportVerticesAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ImportVerticesAck{")
+	s = append(s, "PartitionId: "+fmt.Sprintf("%#v", this.PartitionId)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddWorker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.AddWorker{")
+	s = append(s, "Remote: "+fmt.Sprintf("%#v", this.Remote)+",\n")
+	s = append(s, "HostAndPort: "+fmt.Sprintf("%#v", this.HostAndPort)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.AddWorkerAck{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DrainWorker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.DrainWorker{")
+	s = append(s, "Worker: "+fmt.Sprintf("%#v", this.Worker)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DrainWorkerAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.DrainWorkerAck{")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RebalanceWorkers) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.RebalanceWorkers{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RebalanceWorkersAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.RebalanceWorkersAck{")
+	s = append(s, "MovedPartitions: "+fmt.Sprintf("%#v", this.MovedPartitions)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CoordinatorStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.CoordinatorStats{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CoordinatorStatsAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&command.CoordinatorStatsAck{")
+	s = append(s, "SuperStep: "+fmt.Sprintf("%#v", this.SuperStep)+",\n")
+	s = append(s, "NrOfActiveVertex: "+fmt.Sprintf("%#v", this.NrOfActiveVertex)+",\n")
+	s = append(s, "NrOfSentMessages: "+fmt.Sprintf("%#v", this.NrOfSentMessages)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Stage: "+fmt.Sprintf("%#v", this.Stage)+",\n")
+	s = append(s, "Algorithm: "+fmt.Sprintf("%#v", this.Algorithm)+",\n")
+	s = append(s, "Workers: "+fmt.Sprintf("%#v", this.Workers)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowPartitions) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.ShowPartitions{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowPartitionsAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&command.ShowPartitionsAck{")
+	if this.Partitions != nil {
+		s = append(s, "Partitions: "+fmt.Sprintf("%#v", this.Partitions)+",\n")
+	}
+	if this.Traffic != nil {
+		s = append(s, "Traffic: "+fmt.Sprintf("%#v", this.Traffic)+",\n")
+	}
+	s = append(s, "SuperSteps: "+fmt.Sprintf("%#v", this.SuperSteps)+",\n")
+	s = append(s, "Edges: "+fmt.Sprintf("%#v", this.Edges)+",\n")
+	s = append(s, "CutEdges: "+fmt.Sprintf("%#v", this.CutEdges)+",\n")
+	s = append(s, "LocalMessages: "+fmt.Sprintf("%#v", this.LocalMessages)+",\n")
+	s = append(s, "RemoteMessages: "+fmt.Sprintf("%#v", this.RemoteMessages)+",\n")
+	s = append(s, "MovedVertices: "+fmt.Sprintf("%#v", this.MovedVertices)+",\n")
+	if this.MessageBatches != nil {
+		s = append(s, "MessageBatches: "+fmt.Sprintf("%#v", this.MessageBatches)+",\n")
+	}
+	if this.FlowControl != nil {
+		s = append(s, "FlowControl: "+fmt.Sprintf("%#v", this.FlowControl)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowPartitionsAck_Partition) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.ShowPartitionsAck_Partition{")
+	if this.Stats != nil {
+		s = append(s, "Stats: "+fmt.Sprintf("%#v", this.Stats)+",\n")
+	}
+	s = append(s, "Worker: "+fmt.Sprintf("%#v", this.Worker)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartSuperStep) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.StartSuperStep{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SelectAlgorithm) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.SelectAlgorithm{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SelectAlgorithmAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.SelectAlgorithmAck{")
+	if this.WorkerPid != nil {
+		s = append(s, "WorkerPid: "+fmt.Sprintf("%#v", this.WorkerPid)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PipelineStage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&command.PipelineStage{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	keysForParams := make([]string, 0, len(this.Params))
+	for k, _ := range this.Params {
+		keysForParams = append(keysForParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
+	mapStringForParams := "map[string]string{"
+	for _, k := range keysForParams {
+		mapStringForParams += fmt.Sprintf("%#v: %#v,", k, this.Params[k])
+	}
+	mapStringForParams += "}"
+	if this.Params != nil {
+		s = append(s, "Params: "+mapStringForParams+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartPipeline) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.StartPipeline{")
+	if this.Stages != nil {
+		s = append(s, "Stages: "+fmt.Sprintf("%#v", this.Stages)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartPipelineAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.StartPipelineAck{")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StageStats) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&command.StageStats{")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "SuperSteps: "+fmt.Sprintf("%#v", this.SuperSteps)+",\n")
+	s = append(s, "NrOfSentMessages: "+fmt.Sprintf("%#v", this.NrOfSentMessages)+",\n")
+	s = append(s, "ElapsedMillis: "+fmt.Sprintf("%#v", this.ElapsedMillis)+",\n")
+	s = append(s, "Completed: "+fmt.Sprintf("%#v", this.Completed)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowPipeline) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.ShowPipeline{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowPipelineAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.ShowPipelineAck{")
+	if this.Stages != nil {
+		s = append(s, "Stages: "+fmt.Sprintf("%#v", this.Stages)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowAggregatedValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.ShowAggregatedValue{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShowAggregatedValueAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&command.ShowAggregatedValueAck{")
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]string{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%#v: %#v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	if this.AggregatedValues != nil {
+		s = append(s, "AggregatedValues: "+mapStringForAggregatedValues+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Shutdown) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.Shutdown{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShutdownAck) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&command.ShutdownAck{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCommand(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LoadVertex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadVertex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	return i, nil
+}
+
+func (m *LoadVertexAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadVertexAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *LoadPartitionVertices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadPartitionVertices) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.NumOfPartitions != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.NumOfPartitions))
+	}
+	return i, nil
+}
+
+func (m *LoadPartitionVerticesAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadPartitionVerticesAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Stats.Size()))
+		n1, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	return i, nil
+}
+
+func (m *LoadPartitionVerticesWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LoadPartitionVerticesWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n2, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Partitions) > 0 {
+		for _, msg := range m.Partitions {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PartitionStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if m.Vertices != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Vertices))
+	}
+	if m.Edges != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Edges))
+	}
+	if m.MessagesSent != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessagesSent))
+	}
+	if m.CutEdges != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.CutEdges))
+	}
+	if m.VertexBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.VertexBytes))
+	}
+	if m.EdgeBytes != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.EdgeBytes))
+	}
+	return i, nil
+}
+
+func (m *PartitionTraffic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionTraffic) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SrcPartition != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SrcPartition))
+	}
+	if m.DestPartition != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.DestPartition))
+	}
+	if m.LocalMessages != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.LocalMessages))
+	}
+	if m.RemoteMessages != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.RemoteMessages))
+	}
+	return i, nil
+}
+
+func (m *GetVertexValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	return i, nil
+}
+
+func (m *GetVertexValueAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVertexValueAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	if len(m.Value) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	return i, nil
+}
+
+func (m *SuperStepBarrier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepBarrier) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
+	}
+	return i, nil
+}
+
+func (m *SuperStepBarrierAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepBarrierAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	return i, nil
+}
+
+func (m *SuperStepBarrierPartitionAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepBarrierPartitionAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	return i, nil
+}
+
+func (m *SuperStepBarrierWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepBarrierWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n3, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *Compute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Compute) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, _ := range m.AggregatedValues {
+			dAtA[i] = 0x12
+			i++
+			v := m.AggregatedValues[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCommand(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
+				n4, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n4
+			}
+		}
+	}
+	if len(m.Stage) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Stage)))
+		i += copy(dAtA[i:], m.Stage)
+	}
+	if len(m.StageParams) > 0 {
+		for k, _ := range m.StageParams {
+			dAtA[i] = 0x22
+			i++
+			v := m.StageParams[k]
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.TrackVertexTraffic {
+		dAtA[i] = 0x28
+		i++
+		if m.TrackVertexTraffic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.NumericVertexIds {
+		dAtA[i] = 0x30
+		i++
+		if m.NumericVertexIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.FlowControl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.FlowControl.Size()))
+		n5, err := m.FlowControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *ComputeAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComputeAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	if m.Halted {
+		dAtA[i] = 0x10
+		i++
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, _ := range m.AggregatedValues {
+			dAtA[i] = 0x1a
+			i++
+			v := m.AggregatedValues[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCommand(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
+				n6, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n6
+			}
+		}
+	}
+	if m.MessagesSent != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessagesSent))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ComputePartitionAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComputePartitionAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, _ := range m.AggregatedValues {
+			dAtA[i] = 0x12
+			i++
+			v := m.AggregatedValues[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCommand(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
+				n7, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n7
+			}
+		}
+	}
+	if m.Stats != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Stats.Size()))
+		n8, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.InternalMessages) > 0 {
+		for k, _ := range m.InternalMessages {
+			dAtA[i] = 0x22
+			i++
+			v := m.InternalMessages[k]
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + sovCommand(uint64(v))
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(v))
+		}
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.LocalMessages != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.LocalMessages))
+	}
+	return i, nil
+}
+
+func (m *ComputeWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ComputeWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n9, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, _ := range m.AggregatedValues {
+			dAtA[i] = 0x12
+			i++
+			v := m.AggregatedValues[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovCommand(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + msgSize
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintCommand(dAtA, i, uint64(v.Size()))
+				n10, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n10
+			}
+		}
+	}
+	if len(m.Partitions) > 0 {
+		for _, msg := range m.Partitions {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Traffic) > 0 {
+		for _, msg := range m.Traffic {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.VertexMoves) > 0 {
+		for _, msg := range m.VertexMoves {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.MessageBatches != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessageBatches.Size()))
+		n11, err := m.MessageBatches.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if m.FlowControl != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.FlowControl.Size()))
+		n12, err := m.FlowControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *VertexMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexMove) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	if m.From != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.From))
+	}
+	if m.To != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.To))
+	}
+	if m.Gain != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Gain))
+	}
+	return i, nil
+}
+
+func (m *SuperStepMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepMessage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
+	}
+	if len(m.SrcVertexId) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.SrcVertexId)))
+		i += copy(dAtA[i:], m.SrcVertexId)
+	}
+	if len(m.DestVertexId) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.DestVertexId)))
+		i += copy(dAtA[i:], m.DestVertexId)
+	}
+	if m.Message != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Message.Size()))
+		n13, err := m.Message.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if m.SrcNumericId != 0 {
+		dAtA[i] = 0x31
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.SrcNumericId))
+		i += 8
+	}
+	if m.DestNumericId != 0 {
+		dAtA[i] = 0x39
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.DestNumericId))
+		i += 8
+	}
+	if m.Seq != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Seq))
+	}
+	return i, nil
+}
+
+func (m *SuperStepMessageAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepMessageAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Seq))
+	}
+	return i, nil
+}
+
+func (m *SuperStepMessageBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepMessageBatch) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
+	}
+	if m.Count != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Count))
+	}
+	if m.NumericVertexIds {
+		dAtA[i] = 0x20
+		i++
+		if m.NumericVertexIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Compression != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Compression))
+	}
+	if len(m.Payload) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Payload)))
+		i += copy(dAtA[i:], m.Payload)
+	}
+	if m.Seq != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Seq))
+	}
+	return i, nil
+}
+
+func (m *SuperStepMessageBatchAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuperStepMessageBatchAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Seq))
+	}
+	return i, nil
+}
+
+func (m *MessageBatchStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageBatchStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Batches != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Batches))
+	}
+	if m.Messages != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Messages))
+	}
+	if m.RawBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.RawBytes))
+	}
+	if m.EncodedBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.EncodedBytes))
+	}
+	if m.MaxBatchMessages != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MaxBatchMessages))
+	}
+	if m.MaxBatchBytes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MaxBatchBytes))
+	}
+	return i, nil
+}
+
+func (m *FlowControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowControl) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.VertexInflight != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.VertexInflight))
+	}
+	if m.PartitionInflight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionInflight))
+	}
+	if m.WorkerInflight != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerInflight))
+	}
+	return i, nil
+}
+
+func (m *FlowControlStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowControlStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.StalledMessages != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.StalledMessages))
+	}
+	if m.StallNanos != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.StallNanos))
+	}
+	if m.MaxVertexMailbox != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MaxVertexMailbox))
+	}
+	if m.MaxPartitionMailbox != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MaxPartitionMailbox))
+	}
+	if m.MaxWorkerMailbox != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MaxWorkerMailbox))
+	}
+	return i, nil
+}
+
+func (m *MessageSpill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageSpill) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MemoryLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MemoryLimit))
+	}
+	if len(m.Dir) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Dir)))
+		i += copy(dAtA[i:], m.Dir)
+	}
+	return i, nil
+}
+
+func (m *PartitionStore) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionStore) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		dAtA[i] = 0x8
+		i++
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Dir) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Dir)))
+		i += copy(dAtA[i:], m.Dir)
+	}
+	if m.MemoryLimit != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MemoryLimit))
+	}
+	return i, nil
+}
+
+func (m *InitPartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitPartition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if m.NumericVertexIds {
+		dAtA[i] = 0x10
+		i++
+		if m.NumericVertexIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Store != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Store.Size()))
+		n14, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	return i, nil
+}
+
+func (m *InitPartitionAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitPartitionAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	return i, nil
+}
+
+func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkerInfo) > 0 {
+		for _, msg := range m.WorkerInfo {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.MovedVertices) > 0 {
+		for k, _ := range m.MovedVertices {
+			dAtA[i] = 0x12
+			i++
+			v := m.MovedVertices[k]
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + sovCommand(uint64(v))
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x10
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(v))
+		}
+	}
+	return i, nil
+}
+
+func (m *ClusterInfo_WorkerInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo_WorkerInfo) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n15, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if len(m.Partitions) > 0 {
+		dAtA17 := make([]byte, len(m.Partitions)*10)
+		var j16 int
+		for _, num := range m.Partitions {
+			for num >= 1<<7 {
+				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j16++
+			}
+			dAtA17[j16] = uint8(num)
+			j16++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(j16))
+		i += copy(dAtA[i:], dAtA17[:j16])
+	}
+	return i, nil
+}
+
+func (m *PluginFingerprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PluginFingerprint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.ProtocolVersion))
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.Aggregators) > 0 {
+		for _, s := range m.Aggregators {
+			dAtA[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.MessageTypes) > 0 {
+		for _, s := range m.MessageTypes {
+			dAtA[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *InitWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitWorker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Coordinator != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Coordinator.Size()))
+		n18, err := m.Coordinator.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
+	}
+	if len(m.Partitions) > 0 {
+		dAtA20 := make([]byte, len(m.Partitions)*10)
+		var j19 int
+		for _, num := range m.Partitions {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(j19))
+		i += copy(dAtA[i:], dAtA20[:j19])
+	}
+	if m.Fingerprint != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Fingerprint.Size()))
+		n21, err := m.Fingerprint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if m.VertexCacheSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.VertexCacheSize))
+	}
+	if m.NumericVertexIds {
+		dAtA[i] = 0x28
+		i++
+		if m.NumericVertexIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MessageBatch {
+		dAtA[i] = 0x30
+		i++
+		if m.MessageBatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MessageCompression != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessageCompression))
+	}
+	if m.MessageSpill != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessageSpill.Size()))
+		n22, err := m.MessageSpill.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
+	}
+	if m.PartitionStore != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionStore.Size()))
+		n23, err := m.PartitionStore.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
+	}
+	return i, nil
+}
+
+func (m *InitWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InitWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n24, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
+	}
+	if len(m.Algorithms) > 0 {
+		for _, s := range m.Algorithms {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Fingerprint != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Fingerprint.Size()))
+		n25, err := m.Fingerprint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
+	}
+	return i, nil
+}
+
+func (m *NewCluster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewCluster) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for _, msg := range m.Workers {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NrOfPartitions != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfPartitions))
+	}
+	if m.MinRegisteredWorkers != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MinRegisteredWorkers))
+	}
+	if m.RegistrationTimeoutMillis != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.RegistrationTimeoutMillis))
+	}
+	if len(m.WorkerDns) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.WorkerDns)))
+		i += copy(dAtA[i:], m.WorkerDns)
+	}
+	if m.WorkerDnsSrv {
+		dAtA[i] = 0x30
+		i++
+		if m.WorkerDnsSrv {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.RebalanceThreshold != 0 {
+		dAtA[i] = 0x39
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.RebalanceThreshold))))
+		i += 8
+	}
+	if m.MaxVertexMoves != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MaxVertexMoves))
+	}
+	if m.VertexCacheSize != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.VertexCacheSize))
+	}
+	if m.NumericVertexIds {
+		dAtA[i] = 0x50
+		i++
+		if m.NumericVertexIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MessageBatch {
+		dAtA[i] = 0x58
+		i++
+		if m.MessageBatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.MessageCompression != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessageCompression))
+	}
+	if m.FlowControl != nil {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.FlowControl.Size()))
+		n26, err := m.FlowControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
+	}
+	if m.MessageSpill != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessageSpill.Size()))
+		n27, err := m.MessageSpill.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
+	}
+	if m.PartitionStore != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionStore.Size()))
+		n28, err := m.PartitionStore.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
+	}
+	if m.SuperstepBarrier {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.SuperstepBarrier {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *NewCluster_WorkerReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewCluster_WorkerReq) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Remote {
+		dAtA[i] = 0x8
+		i++
+		if m.Remote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.HostAndPort) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.HostAndPort)))
+		i += copy(dAtA[i:], m.HostAndPort)
+	}
+	return i, nil
+}
+
+func (m *NewClusterAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewClusterAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *RegisterWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterWorker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostAndPort) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.HostAndPort)))
+		i += copy(dAtA[i:], m.HostAndPort)
+	}
+	return i, nil
+}
+
+func (m *RegisterWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisterWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *VertexState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VertexState) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	if m.Vertex != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Vertex.Size()))
+		n29, err := m.Vertex.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
+	}
+	if m.Halted {
+		dAtA[i] = 0x18
+		i++
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OutEdges != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.OutEdges.Size()))
+		n30, err := m.OutEdges.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	return i, nil
+}
+
+func (m *OutEdges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutEdges) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dests) > 0 {
+		for _, s := range m.Dests {
+			dAtA[i] = 0xa
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Uint32Values) > 0 {
+		dAtA32 := make([]byte, len(m.Uint32Values)*10)
+		var j31 int
+		for _, num := range m.Uint32Values {
+			for num >= 1<<7 {
+				dAtA32[j31] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j31++
+			}
+			dAtA32[j31] = uint8(num)
+			j31++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(j31))
+		i += copy(dAtA[i:], dAtA32[:j31])
+	}
+	if len(m.Float64Values) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Float64Values)*8))
+		for _, num := range m.Float64Values {
+			f33 := math.Float64bits(float64(num))
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(f33))
+			i += 8
+		}
+	}
+	if len(m.BytesValues) > 0 {
+		for _, b := range m.BytesValues {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *ExportVertex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportVertex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ExportVertexAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportVertexAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.VertexId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.VertexId)))
+		i += copy(dAtA[i:], m.VertexId)
+	}
+	if m.State != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.State.Size()))
+		n34, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ExportPartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportPartition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	return i, nil
+}
+
+func (m *ExportPartitionAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportPartitionAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, msg := range m.Vertices {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ImportPartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportPartition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, msg := range m.Vertices {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.NumOfPartitions != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.NumOfPartitions))
+	}
+	if m.NumericVertexIds {
+		dAtA[i] = 0x20
+		i++
+		if m.NumericVertexIds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Store != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Store.Size()))
+		n35, err := m.Store.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	return i, nil
+}
+
+func (m *ImportPartitionAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportPartitionAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ReleasePartition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleasePartition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	return i, nil
+}
+
+func (m *ExportVertices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportVertices) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.VertexIds) > 0 {
+		for _, s := range m.VertexIds {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	return i, nil
+}
+
+func (m *ExportVerticesAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExportVerticesAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, msg := range m.Vertices {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ImportVertices) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportVertices) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, msg := range m.Vertices {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ImportVerticesAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ImportVerticesAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.PartitionId))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *AddWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddWorker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Remote {
+		dAtA[i] = 0x8
+		i++
+		if m.Remote {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.HostAndPort) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.HostAndPort)))
+		i += copy(dAtA[i:], m.HostAndPort)
+	}
+	return i, nil
+}
+
+func (m *AddWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n36, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *DrainWorker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainWorker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Worker) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Worker)))
+		i += copy(dAtA[i:], m.Worker)
+	}
+	return i, nil
+}
+
+func (m *DrainWorkerAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainWorkerAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *RebalanceWorkers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceWorkers) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *RebalanceWorkersAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RebalanceWorkersAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MovedPartitions != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MovedPartitions))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *CoordinatorStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoordinatorStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CoordinatorStatsAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoordinatorStatsAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperStep))
+	}
+	if m.NrOfActiveVertex != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfActiveVertex))
+	}
+	if m.NrOfSentMessages != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfSentMessages))
+	}
+	if len(m.State) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.State)))
+		i += copy(dAtA[i:], m.State)
+	}
+	if len(m.Stage) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Stage)))
+		i += copy(dAtA[i:], m.Stage)
+	}
+	if len(m.Algorithm) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Algorithm)))
+		i += copy(dAtA[i:], m.Algorithm)
+	}
+	if len(m.Workers) > 0 {
+		for _, s := range m.Workers {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *ShowPartitions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowPartitions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ShowPartitionsAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowPartitionsAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for _, msg := range m.Partitions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Traffic) > 0 {
+		for _, msg := range m.Traffic {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.SuperSteps != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperSteps))
+	}
+	if m.Edges != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Edges))
+	}
+	if m.CutEdges != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.CutEdges))
+	}
+	if m.LocalMessages != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.LocalMessages))
+	}
+	if m.RemoteMessages != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.RemoteMessages))
+	}
+	if m.MovedVertices != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MovedVertices))
+	}
+	if m.MessageBatches != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.MessageBatches.Size()))
+		n37, err := m.MessageBatches.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.FlowControl != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.FlowControl.Size()))
+		n38, err := m.FlowControl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	return i, nil
+}
+
+func (m *ShowPartitionsAck_Partition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowPartitionsAck_Partition) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Stats != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.Stats.Size()))
+		n39, err := m.Stats.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if len(m.Worker) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Worker)))
+		i += copy(dAtA[i:], m.Worker)
+	}
+	return i, nil
+}
+
+func (m *StartSuperStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartSuperStep) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *SelectAlgorithm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectAlgorithm) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	return i, nil
+}
+
+func (m *SelectAlgorithmAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SelectAlgorithmAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.WorkerPid.Size()))
+		n40, err := m.WorkerPid.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *PipelineStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineStage) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Params) > 0 {
+		for k, _ := range m.Params {
+			dAtA[i] = 0x12
+			i++
+			v := m.Params[k]
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *StartPipeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartPipeline) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for _, msg := range m.Stages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *StartPipelineAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartPipelineAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *StageStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StageStats) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if m.SuperSteps != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.SuperSteps))
+	}
+	if m.NrOfSentMessages != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.NrOfSentMessages))
+	}
+	if m.ElapsedMillis != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCommand(dAtA, i, uint64(m.ElapsedMillis))
+	}
+	if m.Completed {
+		dAtA[i] = 0x28
+		i++
+		if m.Completed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *ShowPipeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowPipeline) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ShowPipelineAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowPipelineAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for _, msg := range m.Stages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ShowAggregatedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowAggregatedValue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ShowAggregatedValueAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShowAggregatedValueAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatedValues) > 0 {
+		for k, _ := range m.AggregatedValues {
+			dAtA[i] = 0xa
+			i++
+			v := m.AggregatedValues[k]
+			mapSize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			i = encodeVarintCommand(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCommand(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	return i, nil
+}
+
+func (m *Shutdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Shutdown) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *ShutdownAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShutdownAck) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func encodeVarintCommand(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *LoadVertex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *LoadVertexAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *LoadPartitionVertices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfPartitions != 0 {
+		n += 1 + sovCommand(uint64(m.NumOfPartitions))
+	}
+	return n
+}
+
+func (m *LoadPartitionVerticesAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *LoadPartitionVerticesWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PartitionStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if m.Vertices != 0 {
+		n += 1 + sovCommand(uint64(m.Vertices))
+	}
+	if m.Edges != 0 {
+		n += 1 + sovCommand(uint64(m.Edges))
+	}
+	if m.MessagesSent != 0 {
+		n += 1 + sovCommand(uint64(m.MessagesSent))
+	}
+	if m.CutEdges != 0 {
+		n += 1 + sovCommand(uint64(m.CutEdges))
+	}
+	if m.VertexBytes != 0 {
+		n += 1 + sovCommand(uint64(m.VertexBytes))
+	}
+	if m.EdgeBytes != 0 {
+		n += 1 + sovCommand(uint64(m.EdgeBytes))
+	}
+	return n
+}
+
+func (m *PartitionTraffic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SrcPartition != 0 {
+		n += 1 + sovCommand(uint64(m.SrcPartition))
+	}
+	if m.DestPartition != 0 {
+		n += 1 + sovCommand(uint64(m.DestPartition))
+	}
+	if m.LocalMessages != 0 {
+		n += 1 + sovCommand(uint64(m.LocalMessages))
+	}
+	if m.RemoteMessages != 0 {
+		n += 1 + sovCommand(uint64(m.RemoteMessages))
+	}
+	return n
+}
+
+func (m *GetVertexValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *GetVertexValueAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperStepBarrier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		n += 1 + sovCommand(uint64(m.SuperStep))
+	}
+	return n
+}
+
+func (m *SuperStepBarrierAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *SuperStepBarrierPartitionAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	return n
+}
+
+func (m *SuperStepBarrierWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *Compute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		n += 1 + sovCommand(uint64(m.SuperStep))
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, v := range m.AggregatedValues {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCommand(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.StageParams) > 0 {
+		for k, v := range m.StageParams {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	if m.TrackVertexTraffic {
+		n += 2
+	}
+	if m.NumericVertexIds {
+		n += 2
+	}
+	if m.FlowControl != nil {
+		l = m.FlowControl.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ComputeAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, v := range m.AggregatedValues {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCommand(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	if m.MessagesSent != 0 {
+		n += 1 + sovCommand(uint64(m.MessagesSent))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ComputePartitionAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, v := range m.AggregatedValues {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCommand(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.InternalMessages) > 0 {
+		for k, v := range m.InternalMessages {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + sovCommand(uint64(v))
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.LocalMessages != 0 {
+		n += 1 + sovCommand(uint64(m.LocalMessages))
+	}
+	return n
+}
+
+func (m *ComputeWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.AggregatedValues) > 0 {
+		for k, v := range m.AggregatedValues {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovCommand(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.Traffic) > 0 {
+		for _, e := range m.Traffic {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.VertexMoves) > 0 {
+		for _, e := range m.VertexMoves {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if m.MessageBatches != nil {
+		l = m.MessageBatches.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.FlowControl != nil {
+		l = m.FlowControl.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *VertexMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.From != 0 {
+		n += 1 + sovCommand(uint64(m.From))
+	}
+	if m.To != 0 {
+		n += 1 + sovCommand(uint64(m.To))
+	}
+	if m.Gain != 0 {
+		n += 1 + sovCommand(uint64(m.Gain))
+	}
+	return n
+}
+
+func (m *SuperStepMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		n += 1 + sovCommand(uint64(m.SuperStep))
+	}
+	l = len(m.SrcVertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.DestVertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.SrcNumericId != 0 {
+		n += 9
+	}
+	if m.DestNumericId != 0 {
+		n += 9
+	}
+	if m.Seq != 0 {
+		n += 1 + sovCommand(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *SuperStepMessageAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovCommand(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *SuperStepMessageBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		n += 1 + sovCommand(uint64(m.SuperStep))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCommand(uint64(m.Count))
+	}
+	if m.NumericVertexIds {
+		n += 2
+	}
+	if m.Compression != 0 {
+		n += 1 + sovCommand(uint64(m.Compression))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovCommand(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *SuperStepMessageBatchAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovCommand(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *MessageBatchStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batches != 0 {
+		n += 1 + sovCommand(uint64(m.Batches))
+	}
+	if m.Messages != 0 {
+		n += 1 + sovCommand(uint64(m.Messages))
+	}
+	if m.RawBytes != 0 {
+		n += 1 + sovCommand(uint64(m.RawBytes))
+	}
+	if m.EncodedBytes != 0 {
+		n += 1 + sovCommand(uint64(m.EncodedBytes))
+	}
+	if m.MaxBatchMessages != 0 {
+		n += 1 + sovCommand(uint64(m.MaxBatchMessages))
+	}
+	if m.MaxBatchBytes != 0 {
+		n += 1 + sovCommand(uint64(m.MaxBatchBytes))
+	}
+	return n
+}
+
+func (m *FlowControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VertexInflight != 0 {
+		n += 1 + sovCommand(uint64(m.VertexInflight))
+	}
+	if m.PartitionInflight != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionInflight))
+	}
+	if m.WorkerInflight != 0 {
+		n += 1 + sovCommand(uint64(m.WorkerInflight))
+	}
+	return n
+}
+
+func (m *FlowControlStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StalledMessages != 0 {
+		n += 1 + sovCommand(uint64(m.StalledMessages))
+	}
+	if m.StallNanos != 0 {
+		n += 1 + sovCommand(uint64(m.StallNanos))
+	}
+	if m.MaxVertexMailbox != 0 {
+		n += 1 + sovCommand(uint64(m.MaxVertexMailbox))
+	}
+	if m.MaxPartitionMailbox != 0 {
+		n += 1 + sovCommand(uint64(m.MaxPartitionMailbox))
+	}
+	if m.MaxWorkerMailbox != 0 {
+		n += 1 + sovCommand(uint64(m.MaxWorkerMailbox))
+	}
+	return n
+}
+
+func (m *MessageSpill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemoryLimit != 0 {
+		n += 1 + sovCommand(uint64(m.MemoryLimit))
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *PartitionStore) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Dir)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.MemoryLimit != 0 {
+		n += 1 + sovCommand(uint64(m.MemoryLimit))
+	}
+	return n
+}
+
+func (m *InitPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if m.NumericVertexIds {
+		n += 2
+	}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *InitPartitionAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	return n
+}
+
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WorkerInfo) > 0 {
+		for _, e := range m.WorkerInfo {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.MovedVertices) > 0 {
+		for k, v := range m.MovedVertices {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + sovCommand(uint64(v))
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ClusterInfo_WorkerInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovCommand(uint64(e))
+		}
+		n += 1 + sovCommand(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *PluginFingerprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovCommand(uint64(m.ProtocolVersion))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Aggregators) > 0 {
+		for _, s := range m.Aggregators {
+			l = len(s)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.MessageTypes) > 0 {
+		for _, s := range m.MessageTypes {
+			l = len(s)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InitWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Coordinator != nil {
+		l = m.Coordinator.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Partitions) > 0 {
+		l = 0
+		for _, e := range m.Partitions {
+			l += sovCommand(uint64(e))
+		}
+		n += 1 + sovCommand(uint64(l)) + l
+	}
+	if m.Fingerprint != nil {
+		l = m.Fingerprint.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.VertexCacheSize != 0 {
+		n += 1 + sovCommand(uint64(m.VertexCacheSize))
+	}
+	if m.NumericVertexIds {
+		n += 2
+	}
+	if m.MessageBatch {
+		n += 2
+	}
+	if m.MessageCompression != 0 {
+		n += 1 + sovCommand(uint64(m.MessageCompression))
+	}
+	if m.MessageSpill != nil {
+		l = m.MessageSpill.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.PartitionStore != nil {
+		l = m.PartitionStore.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *InitWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Algorithms) > 0 {
+		for _, s := range m.Algorithms {
+			l = len(s)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if m.Fingerprint != nil {
+		l = m.Fingerprint.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *NewCluster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Workers) > 0 {
+		for _, e := range m.Workers {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if m.NrOfPartitions != 0 {
+		n += 1 + sovCommand(uint64(m.NrOfPartitions))
+	}
+	if m.MinRegisteredWorkers != 0 {
+		n += 1 + sovCommand(uint64(m.MinRegisteredWorkers))
+	}
+	if m.RegistrationTimeoutMillis != 0 {
+		n += 1 + sovCommand(uint64(m.RegistrationTimeoutMillis))
+	}
+	l = len(m.WorkerDns)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.WorkerDnsSrv {
+		n += 2
+	}
+	if m.RebalanceThreshold != 0 {
+		n += 9
+	}
+	if m.MaxVertexMoves != 0 {
+		n += 1 + sovCommand(uint64(m.MaxVertexMoves))
+	}
+	if m.VertexCacheSize != 0 {
+		n += 1 + sovCommand(uint64(m.VertexCacheSize))
+	}
+	if m.NumericVertexIds {
+		n += 2
+	}
+	if m.MessageBatch {
+		n += 2
+	}
+	if m.MessageCompression != 0 {
+		n += 1 + sovCommand(uint64(m.MessageCompression))
+	}
+	if m.FlowControl != nil {
+		l = m.FlowControl.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.MessageSpill != nil {
+		l = m.MessageSpill.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.PartitionStore != nil {
+		l = m.PartitionStore.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.SuperstepBarrier {
+		n += 3
+	}
+	return n
+}
+
+func (m *NewCluster_WorkerReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remote {
+		n += 2
+	}
+	l = len(m.HostAndPort)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *NewClusterAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAndPort)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisterWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *VertexState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Vertex != nil {
+		l = m.Vertex.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.Halted {
+		n += 2
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if m.OutEdges != nil {
+		l = m.OutEdges.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *OutEdges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dests) > 0 {
+		for _, s := range m.Dests {
+			l = len(s)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.Uint32Values) > 0 {
+		l = 0
+		for _, e := range m.Uint32Values {
+			l += sovCommand(uint64(e))
+		}
+		n += 1 + sovCommand(uint64(l)) + l
+	}
+	if len(m.Float64Values) > 0 {
+		n += 1 + sovCommand(uint64(len(m.Float64Values)*8)) + len(m.Float64Values)*8
+	}
+	if len(m.BytesValues) > 0 {
+		for _, b := range m.BytesValues {
+			l = len(b)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportVertex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExportVertexAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VertexId)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ExportPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	return n
+}
+
+func (m *ExportPartitionAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, e := range m.Vertices {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportPartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, e := range m.Vertices {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if m.NumOfPartitions != 0 {
+		n += 1 + sovCommand(uint64(m.NumOfPartitions))
+	}
+	if m.NumericVertexIds {
+		n += 2
+	}
+	if m.Store != nil {
+		l = m.Store.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportPartitionAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ReleasePartition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	return n
+}
+
+func (m *ExportVertices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if len(m.VertexIds) > 0 {
+		for _, s := range m.VertexIds {
+			l = len(s)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExportVerticesAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, e := range m.Vertices {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ImportVertices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	if len(m.Vertices) > 0 {
+		for _, e := range m.Vertices {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ImportVerticesAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionId != 0 {
+		n += 1 + sovCommand(uint64(m.PartitionId))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *AddWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Remote {
+		n += 2
+	}
+	l = len(m.HostAndPort)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *AddWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainWorker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainWorkerAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *RebalanceWorkers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RebalanceWorkersAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MovedPartitions != 0 {
+		n += 1 + sovCommand(uint64(m.MovedPartitions))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *CoordinatorStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CoordinatorStatsAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SuperStep != 0 {
+		n += 1 + sovCommand(uint64(m.SuperStep))
+	}
+	if m.NrOfActiveVertex != 0 {
+		n += 1 + sovCommand(uint64(m.NrOfActiveVertex))
+	}
+	if m.NrOfSentMessages != 0 {
+		n += 1 + sovCommand(uint64(m.NrOfSentMessages))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Stage)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Workers) > 0 {
+		for _, s := range m.Workers {
+			l = len(s)
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ShowPartitions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ShowPartitionsAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Partitions) > 0 {
+		for _, e := range m.Partitions {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if len(m.Traffic) > 0 {
+		for _, e := range m.Traffic {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	if m.SuperSteps != 0 {
+		n += 1 + sovCommand(uint64(m.SuperSteps))
+	}
+	if m.Edges != 0 {
+		n += 1 + sovCommand(uint64(m.Edges))
+	}
+	if m.CutEdges != 0 {
+		n += 1 + sovCommand(uint64(m.CutEdges))
+	}
+	if m.LocalMessages != 0 {
+		n += 1 + sovCommand(uint64(m.LocalMessages))
+	}
+	if m.RemoteMessages != 0 {
+		n += 1 + sovCommand(uint64(m.RemoteMessages))
+	}
+	if m.MovedVertices != 0 {
+		n += 1 + sovCommand(uint64(m.MovedVertices))
+	}
+	if m.MessageBatches != nil {
+		l = m.MessageBatches.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.FlowControl != nil {
+		l = m.FlowControl.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *ShowPartitionsAck_Partition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stats != nil {
+		l = m.Stats.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Worker)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *StartSuperStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SelectAlgorithm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *SelectAlgorithmAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WorkerPid != nil {
+		l = m.WorkerPid.Size()
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if len(m.Params) > 0 {
+		for k, v := range m.Params {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *StartPipeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StartPipelineAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	return n
+}
+
+func (m *StageStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCommand(uint64(l))
+	}
+	if m.SuperSteps != 0 {
+		n += 1 + sovCommand(uint64(m.SuperSteps))
+	}
+	if m.NrOfSentMessages != 0 {
+		n += 1 + sovCommand(uint64(m.NrOfSentMessages))
+	}
+	if m.ElapsedMillis != 0 {
+		n += 1 + sovCommand(uint64(m.ElapsedMillis))
+	}
+	if m.Completed {
+		n += 2
+	}
+	return n
+}
+
+func (m *ShowPipeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ShowPipelineAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stages) > 0 {
+		for _, e := range m.Stages {
+			l = e.Size()
+			n += 1 + l + sovCommand(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ShowAggregatedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ShowAggregatedValueAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AggregatedValues) > 0 {
+		for k, v := range m.AggregatedValues {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCommand(uint64(len(k))) + 1 + len(v) + sovCommand(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCommand(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Shutdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ShutdownAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovCommand(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCommand(x uint64) (n int) {
+	return sovCommand(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LoadVertex) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoadVertex{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoadVertexAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoadVertexAck{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoadPartitionVertices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoadPartitionVertices{`,
+		`NumOfPartitions:` + fmt.Sprintf("%v", this.NumOfPartitions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoadPartitionVerticesAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoadPartitionVerticesAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PartitionStats", "PartitionStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LoadPartitionVerticesWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LoadPartitionVerticesWorkerAck{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`Partitions:` + strings.Replace(fmt.Sprintf("%v", this.Partitions), "PartitionStats", "PartitionStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionStats{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Vertices:` + fmt.Sprintf("%v", this.Vertices) + `,`,
+		`Edges:` + fmt.Sprintf("%v", this.Edges) + `,`,
+		`MessagesSent:` + fmt.Sprintf("%v", this.MessagesSent) + `,`,
+		`CutEdges:` + fmt.Sprintf("%v", this.CutEdges) + `,`,
+		`VertexBytes:` + fmt.Sprintf("%v", this.VertexBytes) + `,`,
+		`EdgeBytes:` + fmt.Sprintf("%v", this.EdgeBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionTraffic) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionTraffic{`,
+		`SrcPartition:` + fmt.Sprintf("%v", this.SrcPartition) + `,`,
+		`DestPartition:` + fmt.Sprintf("%v", this.DestPartition) + `,`,
+		`LocalMessages:` + fmt.Sprintf("%v", this.LocalMessages) + `,`,
+		`RemoteMessages:` + fmt.Sprintf("%v", this.RemoteMessages) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetVertexValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetVertexValue{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetVertexValueAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetVertexValueAck{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepBarrier) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepBarrier{`,
+		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepBarrierAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepBarrierAck{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepBarrierPartitionAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepBarrierPartitionAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepBarrierWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepBarrierWorkerAck{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Compute) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	keysForStageParams := make([]string, 0, len(this.StageParams))
+	for k, _ := range this.StageParams {
+		keysForStageParams = append(keysForStageParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStageParams)
+	mapStringForStageParams := "map[string]string{"
+	for _, k := range keysForStageParams {
+		mapStringForStageParams += fmt.Sprintf("%v: %v,", k, this.StageParams[k])
+	}
+	mapStringForStageParams += "}"
+	s := strings.Join([]string{`&Compute{`,
+		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
+		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`StageParams:` + mapStringForStageParams + `,`,
+		`TrackVertexTraffic:` + fmt.Sprintf("%v", this.TrackVertexTraffic) + `,`,
+		`NumericVertexIds:` + fmt.Sprintf("%v", this.NumericVertexIds) + `,`,
+		`FlowControl:` + strings.Replace(fmt.Sprintf("%v", this.FlowControl), "FlowControl", "FlowControl", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComputeAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	s := strings.Join([]string{`&ComputeAck{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`Halted:` + fmt.Sprintf("%v", this.Halted) + `,`,
+		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
+		`MessagesSent:` + fmt.Sprintf("%v", this.MessagesSent) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComputePartitionAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	keysForInternalMessages := make([]string, 0, len(this.InternalMessages))
+	for k, _ := range this.InternalMessages {
+		keysForInternalMessages = append(keysForInternalMessages, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForInternalMessages)
+	mapStringForInternalMessages := "map[string]uint64{"
+	for _, k := range keysForInternalMessages {
+		mapStringForInternalMessages += fmt.Sprintf("%v: %v,", k, this.InternalMessages[k])
+	}
+	mapStringForInternalMessages += "}"
+	s := strings.Join([]string{`&ComputePartitionAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PartitionStats", "PartitionStats", 1) + `,`,
+		`InternalMessages:` + mapStringForInternalMessages + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`LocalMessages:` + fmt.Sprintf("%v", this.LocalMessages) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ComputeWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]*types.Any{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	s := strings.Join([]string{`&ComputeWorkerAck{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
+		`Partitions:` + strings.Replace(fmt.Sprintf("%v", this.Partitions), "PartitionStats", "PartitionStats", 1) + `,`,
+		`Traffic:` + strings.Replace(fmt.Sprintf("%v", this.Traffic), "PartitionTraffic", "PartitionTraffic", 1) + `,`,
+		`VertexMoves:` + strings.Replace(fmt.Sprintf("%v", this.VertexMoves), "VertexMove", "VertexMove", 1) + `,`,
+		`MessageBatches:` + strings.Replace(fmt.Sprintf("%v", this.MessageBatches), "MessageBatchStats", "MessageBatchStats", 1) + `,`,
+		`FlowControl:` + strings.Replace(fmt.Sprintf("%v", this.FlowControl), "FlowControlStats", "FlowControlStats", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexMove) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VertexMove{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`From:` + fmt.Sprintf("%v", this.From) + `,`,
+		`To:` + fmt.Sprintf("%v", this.To) + `,`,
+		`Gain:` + fmt.Sprintf("%v", this.Gain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepMessage{`,
+		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
+		`SrcVertexId:` + fmt.Sprintf("%v", this.SrcVertexId) + `,`,
+		`DestVertexId:` + fmt.Sprintf("%v", this.DestVertexId) + `,`,
+		`Message:` + strings.Replace(fmt.Sprintf("%v", this.Message), "Any", "types.Any", 1) + `,`,
+		`SrcNumericId:` + fmt.Sprintf("%v", this.SrcNumericId) + `,`,
+		`DestNumericId:` + fmt.Sprintf("%v", this.DestNumericId) + `,`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepMessageAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepMessageAck{`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepMessageBatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepMessageBatch{`,
+		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
+		`Count:` + fmt.Sprintf("%v", this.Count) + `,`,
+		`NumericVertexIds:` + fmt.Sprintf("%v", this.NumericVertexIds) + `,`,
+		`Compression:` + fmt.Sprintf("%v", this.Compression) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuperStepMessageBatchAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuperStepMessageBatchAck{`,
+		`Seq:` + fmt.Sprintf("%v", this.Seq) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageBatchStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageBatchStats{`,
+		`Batches:` + fmt.Sprintf("%v", this.Batches) + `,`,
+		`Messages:` + fmt.Sprintf("%v", this.Messages) + `,`,
+		`RawBytes:` + fmt.Sprintf("%v", this.RawBytes) + `,`,
+		`EncodedBytes:` + fmt.Sprintf("%v", this.EncodedBytes) + `,`,
+		`MaxBatchMessages:` + fmt.Sprintf("%v", this.MaxBatchMessages) + `,`,
+		`MaxBatchBytes:` + fmt.Sprintf("%v", this.MaxBatchBytes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FlowControl) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FlowControl{`,
+		`VertexInflight:` + fmt.Sprintf("%v", this.VertexInflight) + `,`,
+		`PartitionInflight:` + fmt.Sprintf("%v", this.PartitionInflight) + `,`,
+		`WorkerInflight:` + fmt.Sprintf("%v", this.WorkerInflight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FlowControlStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FlowControlStats{`,
+		`StalledMessages:` + fmt.Sprintf("%v", this.StalledMessages) + `,`,
+		`StallNanos:` + fmt.Sprintf("%v", this.StallNanos) + `,`,
+		`MaxVertexMailbox:` + fmt.Sprintf("%v", this.MaxVertexMailbox) + `,`,
+		`MaxPartitionMailbox:` + fmt.Sprintf("%v", this.MaxPartitionMailbox) + `,`,
+		`MaxWorkerMailbox:` + fmt.Sprintf("%v", this.MaxWorkerMailbox) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MessageSpill) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MessageSpill{`,
+		`MemoryLimit:` + fmt.Sprintf("%v", this.MemoryLimit) + `,`,
+		`Dir:` + fmt.Sprintf("%v", this.Dir) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PartitionStore) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PartitionStore{`,
+		`Enabled:` + fmt.Sprintf("%v", this.Enabled) + `,`,
+		`Dir:` + fmt.Sprintf("%v", this.Dir) + `,`,
+		`MemoryLimit:` + fmt.Sprintf("%v", this.MemoryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InitPartition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InitPartition{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`NumericVertexIds:` + fmt.Sprintf("%v", this.NumericVertexIds) + `,`,
+		`Store:` + strings.Replace(fmt.Sprintf("%v", this.Store), "PartitionStore", "PartitionStore", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InitPartitionAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InitPartitionAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMovedVertices := make([]string, 0, len(this.MovedVertices))
+	for k, _ := range this.MovedVertices {
+		keysForMovedVertices = append(keysForMovedVertices, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMovedVertices)
+	mapStringForMovedVertices := "map[string]uint64{"
+	for _, k := range keysForMovedVertices {
+		mapStringForMovedVertices += fmt.Sprintf("%v: %v,", k, this.MovedVertices[k])
+	}
+	mapStringForMovedVertices += "}"
+	s := strings.Join([]string{`&ClusterInfo{`,
+		`WorkerInfo:` + strings.Replace(fmt.Sprintf("%v", this.WorkerInfo), "ClusterInfo_WorkerInfo", "ClusterInfo_WorkerInfo", 1) + `,`,
+		`MovedVertices:` + mapStringForMovedVertices + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterInfo_WorkerInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterInfo_WorkerInfo{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`Partitions:` + fmt.Sprintf("%v", this.Partitions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PluginFingerprint) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PluginFingerprint{`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Aggregators:` + fmt.Sprintf("%v", this.Aggregators) + `,`,
+		`MessageTypes:` + fmt.Sprintf("%v", this.MessageTypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InitWorker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InitWorker{`,
+		`Coordinator:` + strings.Replace(fmt.Sprintf("%v", this.Coordinator), "PID", "actor.PID", 1) + `,`,
+		`Partitions:` + fmt.Sprintf("%v", this.Partitions) + `,`,
+		`Fingerprint:` + strings.Replace(fmt.Sprintf("%v", this.Fingerprint), "PluginFingerprint", "PluginFingerprint", 1) + `,`,
+		`VertexCacheSize:` + fmt.Sprintf("%v", this.VertexCacheSize) + `,`,
+		`NumericVertexIds:` + fmt.Sprintf("%v", this.NumericVertexIds) + `,`,
+		`MessageBatch:` + fmt.Sprintf("%v", this.MessageBatch) + `,`,
+		`MessageCompression:` + fmt.Sprintf("%v", this.MessageCompression) + `,`,
+		`MessageSpill:` + strings.Replace(fmt.Sprintf("%v", this.MessageSpill), "MessageSpill", "MessageSpill", 1) + `,`,
+		`PartitionStore:` + strings.Replace(fmt.Sprintf("%v", this.PartitionStore), "PartitionStore", "PartitionStore", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InitWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InitWorkerAck{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`Algorithms:` + fmt.Sprintf("%v", this.Algorithms) + `,`,
+		`Fingerprint:` + strings.Replace(fmt.Sprintf("%v", this.Fingerprint), "PluginFingerprint", "PluginFingerprint", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NewCluster) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewCluster{`,
+		`Workers:` + strings.Replace(fmt.Sprintf("%v", this.Workers), "NewCluster_WorkerReq", "NewCluster_WorkerReq", 1) + `,`,
+		`NrOfPartitions:` + fmt.Sprintf("%v", this.NrOfPartitions) + `,`,
+		`MinRegisteredWorkers:` + fmt.Sprintf("%v", this.MinRegisteredWorkers) + `,`,
+		`RegistrationTimeoutMillis:` + fmt.Sprintf("%v", this.RegistrationTimeoutMillis) + `,`,
+		`WorkerDns:` + fmt.Sprintf("%v", this.WorkerDns) + `,`,
+		`WorkerDnsSrv:` + fmt.Sprintf("%v", this.WorkerDnsSrv) + `,`,
+		`RebalanceThreshold:` + fmt.Sprintf("%v", this.RebalanceThreshold) + `,`,
+		`MaxVertexMoves:` + fmt.Sprintf("%v", this.MaxVertexMoves) + `,`,
+		`VertexCacheSize:` + fmt.Sprintf("%v", this.VertexCacheSize) + `,`,
+		`NumericVertexIds:` + fmt.Sprintf("%v", this.NumericVertexIds) + `,`,
+		`MessageBatch:` + fmt.Sprintf("%v", this.MessageBatch) + `,`,
+		`MessageCompression:` + fmt.Sprintf("%v", this.MessageCompression) + `,`,
+		`FlowControl:` + strings.Replace(fmt.Sprintf("%v", this.FlowControl), "FlowControl", "FlowControl", 1) + `,`,
+		`MessageSpill:` + strings.Replace(fmt.Sprintf("%v", this.MessageSpill), "MessageSpill", "MessageSpill", 1) + `,`,
+		`PartitionStore:` + strings.Replace(fmt.Sprintf("%v", this.PartitionStore), "PartitionStore", "PartitionStore", 1) + `,`,
+		`SuperstepBarrier:` + fmt.Sprintf("%v", this.SuperstepBarrier) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NewCluster_WorkerReq) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewCluster_WorkerReq{`,
+		`Remote:` + fmt.Sprintf("%v", this.Remote) + `,`,
+		`HostAndPort:` + fmt.Sprintf("%v", this.HostAndPort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NewClusterAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NewClusterAck{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterWorker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterWorker{`,
+		`HostAndPort:` + fmt.Sprintf("%v", this.HostAndPort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegisterWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegisterWorkerAck{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VertexState) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VertexState{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`Vertex:` + strings.Replace(fmt.Sprintf("%v", this.Vertex), "Any", "types.Any", 1) + `,`,
+		`Halted:` + fmt.Sprintf("%v", this.Halted) + `,`,
+		`Messages:` + strings.Replace(fmt.Sprintf("%v", this.Messages), "Any", "types.Any", 1) + `,`,
+		`OutEdges:` + strings.Replace(fmt.Sprintf("%v", this.OutEdges), "OutEdges", "OutEdges", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutEdges) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutEdges{`,
+		`Dests:` + fmt.Sprintf("%v", this.Dests) + `,`,
+		`Uint32Values:` + fmt.Sprintf("%v", this.Uint32Values) + `,`,
+		`Float64Values:` + fmt.Sprintf("%v", this.Float64Values) + `,`,
+		`BytesValues:` + fmt.Sprintf("%v", this.BytesValues) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportVertex) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportVertex{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportVertexAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportVertexAck{`,
+		`VertexId:` + fmt.Sprintf("%v", this.VertexId) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "VertexState", "VertexState", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportPartition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportPartition{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportPartitionAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportPartitionAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Vertices:` + strings.Replace(fmt.Sprintf("%v", this.Vertices), "VertexState", "VertexState", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportPartition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportPartition{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Vertices:` + strings.Replace(fmt.Sprintf("%v", this.Vertices), "VertexState", "VertexState", 1) + `,`,
+		`NumOfPartitions:` + fmt.Sprintf("%v", this.NumOfPartitions) + `,`,
+		`NumericVertexIds:` + fmt.Sprintf("%v", this.NumericVertexIds) + `,`,
+		`Store:` + strings.Replace(fmt.Sprintf("%v", this.Store), "PartitionStore", "PartitionStore", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportPartitionAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportPartitionAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReleasePartition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReleasePartition{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportVertices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportVertices{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`VertexIds:` + fmt.Sprintf("%v", this.VertexIds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ExportVerticesAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ExportVerticesAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Vertices:` + strings.Replace(fmt.Sprintf("%v", this.Vertices), "VertexState", "VertexState", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportVertices) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportVertices{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Vertices:` + strings.Replace(fmt.Sprintf("%v", this.Vertices), "VertexState", "VertexState", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImportVerticesAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImportVerticesAck{`,
+		`PartitionId:` + fmt.Sprintf("%v", this.PartitionId) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorker{`,
+		`Remote:` + fmt.Sprintf("%v", this.Remote) + `,`,
+		`HostAndPort:` + fmt.Sprintf("%v", this.HostAndPort) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddWorkerAck{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainWorker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainWorker{`,
+		`Worker:` + fmt.Sprintf("%v", this.Worker) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainWorkerAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainWorkerAck{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RebalanceWorkers) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RebalanceWorkers{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RebalanceWorkersAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RebalanceWorkersAck{`,
+		`MovedPartitions:` + fmt.Sprintf("%v", this.MovedPartitions) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CoordinatorStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CoordinatorStats{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CoordinatorStatsAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CoordinatorStatsAck{`,
+		`SuperStep:` + fmt.Sprintf("%v", this.SuperStep) + `,`,
+		`NrOfActiveVertex:` + fmt.Sprintf("%v", this.NrOfActiveVertex) + `,`,
+		`NrOfSentMessages:` + fmt.Sprintf("%v", this.NrOfSentMessages) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`Algorithm:` + fmt.Sprintf("%v", this.Algorithm) + `,`,
+		`Workers:` + fmt.Sprintf("%v", this.Workers) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowPartitions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShowPartitions{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowPartitionsAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShowPartitionsAck{`,
+		`Partitions:` + strings.Replace(fmt.Sprintf("%v", this.Partitions), "ShowPartitionsAck_Partition", "ShowPartitionsAck_Partition", 1) + `,`,
+		`Traffic:` + strings.Replace(fmt.Sprintf("%v", this.Traffic), "PartitionTraffic", "PartitionTraffic", 1) + `,`,
+		`SuperSteps:` + fmt.Sprintf("%v", this.SuperSteps) + `,`,
+		`Edges:` + fmt.Sprintf("%v", this.Edges) + `,`,
+		`CutEdges:` + fmt.Sprintf("%v", this.CutEdges) + `,`,
+		`LocalMessages:` + fmt.Sprintf("%v", this.LocalMessages) + `,`,
+		`RemoteMessages:` + fmt.Sprintf("%v", this.RemoteMessages) + `,`,
+		`MovedVertices:` + fmt.Sprintf("%v", this.MovedVertices) + `,`,
+		`MessageBatches:` + strings.Replace(fmt.Sprintf("%v", this.MessageBatches), "MessageBatchStats", "MessageBatchStats", 1) + `,`,
+		`FlowControl:` + strings.Replace(fmt.Sprintf("%v", this.FlowControl), "FlowControlStats", "FlowControlStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowPartitionsAck_Partition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShowPartitionsAck_Partition{`,
+		`Stats:` + strings.Replace(fmt.Sprintf("%v", this.Stats), "PartitionStats", "PartitionStats", 1) + `,`,
+		`Worker:` + fmt.Sprintf("%v", this.Worker) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartSuperStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartSuperStep{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SelectAlgorithm) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SelectAlgorithm{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SelectAlgorithmAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SelectAlgorithmAck{`,
+		`WorkerPid:` + strings.Replace(fmt.Sprintf("%v", this.WorkerPid), "PID", "actor.PID", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PipelineStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForParams := make([]string, 0, len(this.Params))
+	for k, _ := range this.Params {
+		keysForParams = append(keysForParams, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForParams)
+	mapStringForParams := "map[string]string{"
+	for _, k := range keysForParams {
+		mapStringForParams += fmt.Sprintf("%v: %v,", k, this.Params[k])
+	}
+	mapStringForParams += "}"
+	s := strings.Join([]string{`&PipelineStage{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Params:` + mapStringForParams + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartPipeline) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartPipeline{`,
+		`Stages:` + strings.Replace(fmt.Sprintf("%v", this.Stages), "PipelineStage", "PipelineStage", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartPipelineAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartPipelineAck{`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StageStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SuperSteps:` + fmt.Sprintf("%v", this.SuperSteps) + `,`,
+		`NrOfSentMessages:` + fmt.Sprintf("%v", this.NrOfSentMessages) + `,`,
+		`ElapsedMillis:` + fmt.Sprintf("%v", this.ElapsedMillis) + `,`,
+		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowPipeline) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShowPipeline{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowPipelineAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShowPipelineAck{`,
+		`Stages:` + strings.Replace(fmt.Sprintf("%v", this.Stages), "StageStats", "StageStats", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowAggregatedValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShowAggregatedValue{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShowAggregatedValueAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForAggregatedValues := make([]string, 0, len(this.AggregatedValues))
+	for k, _ := range this.AggregatedValues {
+		keysForAggregatedValues = append(keysForAggregatedValues, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAggregatedValues)
+	mapStringForAggregatedValues := "map[string]string{"
+	for _, k := range keysForAggregatedValues {
+		mapStringForAggregatedValues += fmt.Sprintf("%v: %v,", k, this.AggregatedValues[k])
+	}
+	mapStringForAggregatedValues += "}"
+	s := strings.Join([]string{`&ShowAggregatedValueAck{`,
+		`AggregatedValues:` + mapStringForAggregatedValues + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Shutdown) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Shutdown{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShutdownAck) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShutdownAck{`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCommand(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LoadVertex) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadVertex: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadVertex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadVertexAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadVertexAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadVertexAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadPartitionVertices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadPartitionVertices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadPartitionVertices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfPartitions", wireType)
+			}
+			m.NumOfPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfPartitions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadPartitionVerticesAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadPartitionVerticesAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadPartitionVerticesAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &PartitionStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LoadPartitionVerticesWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LoadPartitionVerticesWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LoadPartitionVerticesWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerPid == nil {
+				m.WorkerPid = &actor.PID{}
+			}
+			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionStats{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
+			}
+			m.Vertices = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Vertices |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+			}
+			m.Edges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Edges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesSent", wireType)
+			}
+			m.MessagesSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutEdges", wireType)
+			}
+			m.CutEdges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CutEdges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexBytes", wireType)
+			}
+			m.VertexBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VertexBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EdgeBytes", wireType)
+			}
+			m.EdgeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EdgeBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionTraffic) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionTraffic: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionTraffic: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcPartition", wireType)
+			}
+			m.SrcPartition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SrcPartition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestPartition", wireType)
+			}
+			m.DestPartition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestPartition |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalMessages", wireType)
+			}
+			m.LocalMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteMessages", wireType)
+			}
+			m.RemoteMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVertexValueAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVertexValueAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVertexValueAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepBarrier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepBarrier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+			}
+			m.SuperStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepBarrierAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepBarrierAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepBarrierAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepBarrierPartitionAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepBarrierPartitionAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepBarrierPartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepBarrierWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepBarrierWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepBarrierWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerPid == nil {
+				m.WorkerPid = &actor.PID{}
+			}
+			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Compute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+			}
+			m.SuperStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedValues == nil {
+				m.AggregatedValues = make(map[string]*types.Any)
+			}
+			var mapkey string
+			var mapvalue *types.Any
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Any{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AggregatedValues[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StageParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StageParams == nil {
+				m.StageParams = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StageParams[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackVertexTraffic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackVertexTraffic = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericVertexIds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumericVertexIds = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FlowControl == nil {
+				m.FlowControl = &FlowControl{}
+			}
+			if err := m.FlowControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComputeAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComputeAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComputeAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedValues == nil {
+				m.AggregatedValues = make(map[string]*types.Any)
+			}
+			var mapkey string
+			var mapvalue *types.Any
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Any{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AggregatedValues[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessagesSent", wireType)
+			}
+			m.MessagesSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessagesSent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComputePartitionAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComputePartitionAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComputePartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedValues == nil {
+				m.AggregatedValues = make(map[string]*types.Any)
+			}
+			var mapkey string
+			var mapvalue *types.Any
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Any{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AggregatedValues[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = &PartitionStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalMessages == nil {
+				m.InternalMessages = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.InternalMessages[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalMessages", wireType)
+			}
+			m.LocalMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ComputeWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ComputeWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ComputeWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerPid == nil {
+				m.WorkerPid = &actor.PID{}
+			}
+			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AggregatedValues == nil {
+				m.AggregatedValues = make(map[string]*types.Any)
+			}
+			var mapkey string
+			var mapvalue *types.Any
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &types.Any{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AggregatedValues[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &PartitionStats{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traffic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traffic = append(m.Traffic, &PartitionTraffic{})
+			if err := m.Traffic[len(m.Traffic)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexMoves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexMoves = append(m.VertexMoves, &VertexMove{})
+			if err := m.VertexMoves[len(m.VertexMoves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageBatches == nil {
+				m.MessageBatches = &MessageBatchStats{}
+			}
+			if err := m.MessageBatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FlowControl == nil {
+				m.FlowControl = &FlowControlStats{}
+			}
+			if err := m.FlowControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			m.To = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.To |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gain", wireType)
+			}
+			m.Gain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gain |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+			}
+			m.SuperStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcVertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcVertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestVertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestVertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &types.Any{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcNumericId", wireType)
+			}
+			m.SrcNumericId = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcNumericId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestNumericId", wireType)
+			}
+			m.DestNumericId = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestNumericId = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepMessageAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepMessageAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepMessageAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepMessageBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepMessageBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepMessageBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+			}
+			m.SuperStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericVertexIds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumericVertexIds = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
+			}
+			m.Compression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Compression |= BatchCompression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuperStepMessageBatchAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuperStepMessageBatchAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuperStepMessageBatchAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageBatchStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageBatchStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageBatchStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			m.Batches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Batches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			m.Messages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Messages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			m.RawBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RawBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedBytes", wireType)
+			}
+			m.EncodedBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EncodedBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchMessages", wireType)
+			}
+			m.MaxBatchMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchBytes", wireType)
+			}
+			m.MaxBatchBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexInflight", wireType)
+			}
+			m.VertexInflight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VertexInflight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionInflight", wireType)
+			}
+			m.PartitionInflight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionInflight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerInflight", wireType)
+			}
+			m.WorkerInflight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WorkerInflight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowControlStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowControlStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowControlStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StalledMessages", wireType)
+			}
+			m.StalledMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StalledMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StallNanos", wireType)
+			}
+			m.StallNanos = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StallNanos |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVertexMailbox", wireType)
+			}
+			m.MaxVertexMailbox = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVertexMailbox |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPartitionMailbox", wireType)
+			}
+			m.MaxPartitionMailbox = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPartitionMailbox |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWorkerMailbox", wireType)
+			}
+			m.MaxWorkerMailbox = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWorkerMailbox |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageSpill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageSpill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageSpill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			m.MemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PartitionStore) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PartitionStore: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PartitionStore: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryLimit", wireType)
+			}
+			m.MemoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitPartition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitPartition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericVertexIds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumericVertexIds = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Store == nil {
+				m.Store = &PartitionStore{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitPartitionAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitPartitionAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitPartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerInfo = append(m.WorkerInfo, &ClusterInfo_WorkerInfo{})
+			if err := m.WorkerInfo[len(m.WorkerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedVertices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MovedVertices == nil {
+				m.MovedVertices = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MovedVertices[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfo_WorkerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerPid == nil {
+				m.WorkerPid = &actor.PID{}
+			}
+			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommand
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommand
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PluginFingerprint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PluginFingerprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PluginFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregators = append(m.Aggregators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageTypes = append(m.MessageTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitWorker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitWorker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Coordinator == nil {
+				m.Coordinator = &actor.PID{}
+			}
+			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Partitions = append(m.Partitions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommand
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommand
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Partitions) == 0 {
+					m.Partitions = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Partitions = append(m.Partitions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fingerprint == nil {
+				m.Fingerprint = &PluginFingerprint{}
+			}
+			if err := m.Fingerprint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexCacheSize", wireType)
+			}
+			m.VertexCacheSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VertexCacheSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericVertexIds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumericVertexIds = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MessageBatch = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageCompression", wireType)
+			}
+			m.MessageCompression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageCompression |= BatchCompression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageSpill", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageSpill == nil {
+				m.MessageSpill = &MessageSpill{}
+			}
+			if err := m.MessageSpill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionStore == nil {
+				m.PartitionStore = &PartitionStore{}
+			}
+			if err := m.PartitionStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InitWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InitWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InitWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkerPid == nil {
+				m.WorkerPid = &actor.PID{}
+			}
+			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithms = append(m.Algorithms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fingerprint == nil {
+				m.Fingerprint = &PluginFingerprint{}
+			}
+			if err := m.Fingerprint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewCluster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewCluster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewCluster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Workers = append(m.Workers, &NewCluster_WorkerReq{})
+			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NrOfPartitions", wireType)
+			}
+			m.NrOfPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NrOfPartitions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRegisteredWorkers", wireType)
+			}
+			m.MinRegisteredWorkers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinRegisteredWorkers |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationTimeoutMillis", wireType)
+			}
+			m.RegistrationTimeoutMillis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationTimeoutMillis |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkerDns = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerDnsSrv", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WorkerDnsSrv = bool(v != 0)
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RebalanceThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.RebalanceThreshold = float64(math.Float64frombits(v))
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVertexMoves", wireType)
+			}
+			m.MaxVertexMoves = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxVertexMoves |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexCacheSize", wireType)
+			}
+			m.VertexCacheSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VertexCacheSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericVertexIds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NumericVertexIds = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MessageBatch = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageCompression", wireType)
+			}
+			m.MessageCompression = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageCompression |= BatchCompression(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FlowControl == nil {
+				m.FlowControl = &FlowControl{}
+			}
+			if err := m.FlowControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageSpill", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MessageSpill == nil {
+				m.MessageSpill = &MessageSpill{}
+			}
+			if err := m.MessageSpill.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionStore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PartitionStore == nil {
+				m.PartitionStore = &PartitionStore{}
+			}
+			if err := m.PartitionStore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperstepBarrier", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SuperstepBarrier = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewCluster_WorkerReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remote = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAndPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAndPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewClusterAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewClusterAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewClusterAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterWorker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterWorker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAndPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAndPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisterWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisterWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisterWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VertexState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VertexState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VertexState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vertex == nil {
+				m.Vertex = &types.Any{}
+			}
+			if err := m.Vertex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutEdges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutEdges == nil {
+				m.OutEdges = &OutEdges{}
+			}
+			if err := m.OutEdges.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&ShutdownAck{`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringCommand(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *LoadVertex) Unmarshal(dAtA []byte) error {
+func (m *OutEdges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4931,15 +20392,15 @@ func (m *LoadVertex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadVertex: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutEdges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadVertex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutEdges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dests", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4967,7 +20428,169 @@ func (m *LoadVertex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VertexId = string(dAtA[iNdEx:postIndex])
+			m.Dests = append(m.Dests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Uint32Values = append(m.Uint32Values, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommand
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommand
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Uint32Values) == 0 {
+					m.Uint32Values = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Uint32Values = append(m.Uint32Values, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uint32Values", wireType)
+			}
+		case 3:
+			if wireType == 1 {
+				var v uint64
+				if (iNdEx + 8) > l {
+					return io.ErrUnexpectedEOF
+				}
+				v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+				iNdEx += 8
+				v2 := float64(math.Float64frombits(v))
+				m.Float64Values = append(m.Float64Values, v2)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCommand
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCommand
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen / 8
+				if elementCount != 0 && len(m.Float64Values) == 0 {
+					m.Float64Values = make([]float64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					v2 := float64(math.Float64frombits(v))
+					m.Float64Values = append(m.Float64Values, v2)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Float64Values", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesValues", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BytesValues = append(m.BytesValues, make([]byte, postIndex-iNdEx))
+			copy(m.BytesValues[len(m.BytesValues)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4993,7 +20616,7 @@ func (m *LoadVertex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadVertexAck) Unmarshal(dAtA []byte) error {
+func (m *ExportVertex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5016,10 +20639,63 @@ func (m *LoadVertexAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadVertexAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportVertex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadVertexAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportVertex: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExportVertexAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExportVertexAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExportVertexAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5055,6 +20731,42 @@ func (m *LoadVertexAck) Unmarshal(dAtA []byte) error {
 			m.VertexId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &VertexState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -5110,7 +20822,7 @@ func (m *LoadVertexAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadPartitionVertices) Unmarshal(dAtA []byte) error {
+func (m *ExportPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5133,17 +20845,17 @@ func (m *LoadPartitionVertices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadPartitionVertices: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadPartitionVertices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfPartitions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
 			}
-			m.NumOfPartitions = 0
+			m.PartitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -5153,7 +20865,7 @@ func (m *LoadPartitionVertices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumOfPartitions |= uint64(b&0x7F) << shift
+				m.PartitionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5182,7 +20894,7 @@ func (m *LoadPartitionVertices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadPartitionVerticesAck) Unmarshal(dAtA []byte) error {
+func (m *ExportPartitionAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5205,10 +20917,10 @@ func (m *LoadPartitionVerticesAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadPartitionVerticesAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportPartitionAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadPartitionVerticesAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportPartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5231,6 +20943,40 @@ func (m *LoadPartitionVerticesAck) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vertices = append(m.Vertices, &VertexState{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
@@ -5286,7 +21032,7 @@ func (m *LoadPartitionVerticesAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LoadPartitionVerticesWorkerAck) Unmarshal(dAtA []byte) error {
+func (m *ImportPartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5309,15 +21055,34 @@ func (m *LoadPartitionVerticesWorkerAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LoadPartitionVerticesWorkerAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportPartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LoadPartitionVerticesWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportPartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5344,71 +21109,55 @@ func (m *LoadPartitionVerticesWorkerAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkerPid == nil {
-				m.WorkerPid = &actor.PID{}
-			}
-			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vertices = append(m.Vertices, &VertexState{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommand(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfPartitions", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetVertexValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommand
+			m.NumOfPartitions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfPartitions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumericVertexIds", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NumericVertexIds = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Store", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -5418,23 +21167,27 @@ func (m *GetVertexValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VertexId = string(dAtA[iNdEx:postIndex])
+			if m.Store == nil {
+				m.Store = &PartitionStore{}
+			}
+			if err := m.Store.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5460,7 +21213,7 @@ func (m *GetVertexValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVertexValueAck) Unmarshal(dAtA []byte) error {
+func (m *ImportPartitionAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5483,17 +21236,17 @@ func (m *GetVertexValueAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVertexValueAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportPartitionAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVertexValueAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportPartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
 			}
-			var stringLen uint64
+			m.PartitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -5503,27 +21256,14 @@ func (m *GetVertexValueAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PartitionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCommand
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VertexId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5551,7 +21291,7 @@ func (m *GetVertexValueAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5577,60 +21317,7 @@ func (m *GetVertexValueAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperStepBarrier) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommand
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuperStepBarrier: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperStepBarrier: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommand(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SuperStepBarrierAck) Unmarshal(dAtA []byte) error {
+func (m *ReleasePartition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5653,17 +21340,17 @@ func (m *SuperStepBarrierAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperStepBarrierAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleasePartition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperStepBarrierAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleasePartition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
 			}
-			var stringLen uint64
+			m.PartitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -5673,24 +21360,11 @@ func (m *SuperStepBarrierAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PartitionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCommand
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VertexId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -5715,7 +21389,7 @@ func (m *SuperStepBarrierAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperStepBarrierPartitionAck) Unmarshal(dAtA []byte) error {
+func (m *ExportVertices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5738,10 +21412,10 @@ func (m *SuperStepBarrierPartitionAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperStepBarrierPartitionAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportVertices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperStepBarrierPartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportVertices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5763,6 +21437,38 @@ func (m *SuperStepBarrierPartitionAck) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VertexIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VertexIds = append(m.VertexIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -5787,7 +21493,7 @@ func (m *SuperStepBarrierPartitionAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperStepBarrierWorkerAck) Unmarshal(dAtA []byte) error {
+func (m *ExportVerticesAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5810,15 +21516,34 @@ func (m *SuperStepBarrierWorkerAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperStepBarrierWorkerAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExportVerticesAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperStepBarrierWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExportVerticesAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5845,13 +21570,43 @@ func (m *SuperStepBarrierWorkerAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkerPid == nil {
-				m.WorkerPid = &actor.PID{}
-			}
-			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vertices = append(m.Vertices, &VertexState{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -5876,7 +21631,7 @@ func (m *SuperStepBarrierWorkerAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Compute) Unmarshal(dAtA []byte) error {
+func (m *ImportVertices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5899,17 +21654,17 @@ func (m *Compute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Compute: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImportVertices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Compute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImportVertices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
 			}
-			m.SuperStep = 0
+			m.PartitionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -5919,14 +21674,14 @@ func (m *Compute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuperStep |= uint64(b&0x7F) << shift
+				m.PartitionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vertices", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5953,105 +21708,114 @@ func (m *Compute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AggregatedValues == nil {
-				m.AggregatedValues = make(map[string]*types.Any)
+			m.Vertices = append(m.Vertices, &VertexState{})
+			if err := m.Vertices[len(m.Vertices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *types.Any
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.Any{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipCommand(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ImportVerticesAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ImportVerticesAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ImportVerticesAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			}
+			m.PartitionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PartitionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AggregatedValues[mapkey] = mapvalue
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6077,7 +21841,7 @@ func (m *Compute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComputeAck) Unmarshal(dAtA []byte) error {
+func (m *AddWorker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6100,15 +21864,35 @@ func (m *ComputeAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComputeAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddWorker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComputeAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddWorker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remote = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VertexId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAndPort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6136,31 +21920,64 @@ func (m *ComputeAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VertexId = string(dAtA[iNdEx:postIndex])
+			m.HostAndPort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommand
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
 			}
-			m.Halted = bool(v != 0)
-		case 3:
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6187,105 +22004,44 @@ func (m *ComputeAck) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AggregatedValues == nil {
-				m.AggregatedValues = make(map[string]*types.Any)
-			}
-			var mapkey string
-			var mapvalue *types.Any
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.Any{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipCommand(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if m.WorkerPid == nil {
+				m.WorkerPid = &actor.PID{}
+			}
+			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.AggregatedValues[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6311,7 +22067,7 @@ func (m *ComputeAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComputePartitionAck) Unmarshal(dAtA []byte) error {
+func (m *DrainWorker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6334,17 +22090,17 @@ func (m *ComputePartitionAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComputePartitionAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: DrainWorker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComputePartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DrainWorker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
-			m.PartitionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -6354,16 +22110,82 @@ func (m *ComputePartitionAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartitionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainWorkerAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainWorkerAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -6373,121 +22195,77 @@ func (m *ComputePartitionAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AggregatedValues == nil {
-				m.AggregatedValues = make(map[string]*types.Any)
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *types.Any
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.Any{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipCommand(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RebalanceWorkers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.AggregatedValues[mapkey] = mapvalue
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RebalanceWorkers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RebalanceWorkers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -6512,7 +22290,7 @@ func (m *ComputePartitionAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ComputeWorkerAck) Unmarshal(dAtA []byte) error {
+func (m *RebalanceWorkersAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6535,17 +22313,17 @@ func (m *ComputeWorkerAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ComputeWorkerAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: RebalanceWorkersAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ComputeWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RebalanceWorkersAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedPartitions", wireType)
 			}
-			var msglen int
+			m.MovedPartitions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -6555,33 +22333,16 @@ func (m *ComputeWorkerAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MovedPartitions |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCommand
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkerPid == nil {
-				m.WorkerPid = &actor.PID{}
-			}
-			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedValues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -6591,121 +22352,77 @@ func (m *ComputeWorkerAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AggregatedValues == nil {
-				m.AggregatedValues = make(map[string]*types.Any)
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *types.Any
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthCommand
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &types.Any{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipCommand(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if skippy < 0 {
-						return ErrInvalidLengthCommand
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthCommand
 			}
-			m.AggregatedValues[mapkey] = mapvalue
-			iNdEx = postIndex
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoordinatorStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -6730,7 +22447,7 @@ func (m *ComputeWorkerAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
+func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6743,25 +22460,82 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoordinatorStatsAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoordinatorStatsAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+			}
+			m.SuperStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperStep |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NrOfActiveVertex", wireType)
+			}
+			m.NrOfActiveVertex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NrOfActiveVertex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NrOfSentMessages", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SuperStepMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperStepMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NrOfSentMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NrOfSentMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6789,13 +22563,13 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
 			}
-			m.SuperStep = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -6805,14 +22579,27 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuperStep |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcVertexId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6840,11 +22627,11 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcVertexId = string(dAtA[iNdEx:postIndex])
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestVertexId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6872,13 +22659,13 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestVertexId = string(dAtA[iNdEx:postIndex])
+			m.Workers = append(m.Workers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -6888,27 +22675,23 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Message == nil {
-				m.Message = &types.Any{}
-			}
-			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6934,7 +22717,7 @@ func (m *SuperStepMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SuperStepMessageAck) Unmarshal(dAtA []byte) error {
+func (m *ShowPartitions) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6957,44 +22740,12 @@ func (m *SuperStepMessageAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SuperStepMessageAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShowPartitions: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SuperStepMessageAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShowPartitions: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uuid", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommand
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCommand
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Uuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7019,7 +22770,7 @@ func (m *SuperStepMessageAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitPartition) Unmarshal(dAtA []byte) error {
+func (m *ShowPartitionsAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7042,17 +22793,180 @@ func (m *InitPartition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitPartition: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShowPartitionsAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitPartition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShowPartitionsAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Partitions = append(m.Partitions, &ShowPartitionsAck_Partition{})
+			if err := m.Partitions[len(m.Partitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Traffic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Traffic = append(m.Traffic, &PartitionTraffic{})
+			if err := m.Traffic[len(m.Traffic)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperSteps", wireType)
+			}
+			m.SuperSteps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuperSteps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
+			}
+			m.Edges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Edges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CutEdges", wireType)
+			}
+			m.CutEdges = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CutEdges |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalMessages", wireType)
+			}
+			m.LocalMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LocalMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteMessages", wireType)
+			}
+			m.RemoteMessages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteMessages |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovedVertices", wireType)
 			}
-			m.PartitionId = 0
+			m.MovedVertices = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -7062,69 +22976,52 @@ func (m *InitPartition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartitionId |= uint64(b&0x7F) << shift
+				m.MovedVertices |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommand(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBatches", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InitPartitionAck) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommand
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.MessageBatches == nil {
+				m.MessageBatches = &MessageBatchStats{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.MessageBatches.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InitPartitionAck: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitPartitionAck: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionId", wireType)
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowControl", wireType)
 			}
-			m.PartitionId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -7134,11 +23031,28 @@ func (m *InitPartitionAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PartitionId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FlowControl == nil {
+				m.FlowControl = &FlowControlStats{}
+			}
+			if err := m.FlowControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7163,7 +23077,7 @@ func (m *InitPartitionAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *ShowPartitionsAck_Partition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7186,15 +23100,15 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Partition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Partition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7221,11 +23135,45 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WorkerInfo = append(m.WorkerInfo, &ClusterInfo_WorkerInfo{})
-			if err := m.WorkerInfo[len(m.WorkerInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Stats == nil {
+				m.Stats = &PartitionStats{}
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7250,7 +23198,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo_WorkerInfo) Unmarshal(dAtA []byte) error {
+func (m *StartSuperStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7269,128 +23217,16 @@ func (m *ClusterInfo_WorkerInfo) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkerPid", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommand
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCommand
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCommand
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.WorkerPid == nil {
-				m.WorkerPid = &actor.PID{}
-			}
-			if err := m.WorkerPid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Partitions = append(m.Partitions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthCommand
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthCommand
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Partitions) == 0 {
-					m.Partitions = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Partitions = append(m.Partitions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
-			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartSuperStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartSuperStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7415,7 +23251,7 @@ func (m *ClusterInfo_WorkerInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitWorker) Unmarshal(dAtA []byte) error {
+func (m *SelectAlgorithm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7438,17 +23274,17 @@ func (m *InitWorker) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitWorker: wiretype end group for non-group")
+			return fmt.Errorf("proto: SelectAlgorithm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitWorker: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SelectAlgorithm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coordinator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -7458,104 +23294,24 @@ func (m *InitWorker) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Coordinator == nil {
-				m.Coordinator = &actor.PID{}
-			}
-			if err := m.Coordinator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Partitions = append(m.Partitions, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowCommand
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthCommand
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthCommand
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Partitions) == 0 {
-					m.Partitions = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowCommand
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Partitions = append(m.Partitions, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Partitions", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7580,7 +23336,7 @@ func (m *InitWorker) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InitWorkerAck) Unmarshal(dAtA []byte) error {
+func (m *SelectAlgorithmAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7603,10 +23359,10 @@ func (m *InitWorkerAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InitWorkerAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: SelectAlgorithmAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InitWorkerAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SelectAlgorithmAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7645,6 +23401,38 @@ func (m *InitWorkerAck) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7669,7 +23457,7 @@ func (m *InitWorkerAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewCluster) Unmarshal(dAtA []byte) error {
+func (m *PipelineStage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7692,17 +23480,17 @@ func (m *NewCluster) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewCluster: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineStage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewCluster: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineStage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -7712,31 +23500,29 @@ func (m *NewCluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Workers = append(m.Workers, &NewCluster_WorkerReq{})
-			if err := m.Workers[len(m.Workers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NrOfPartitions", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			m.NrOfPartitions = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -7746,11 +23532,119 @@ func (m *NewCluster) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NrOfPartitions |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCommand
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCommand
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCommand
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCommand(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthCommand
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Params[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7775,7 +23669,7 @@ func (m *NewCluster) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewCluster_WorkerReq) Unmarshal(dAtA []byte) error {
+func (m *StartPipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7798,37 +23692,17 @@ func (m *NewCluster_WorkerReq) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WorkerReq: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WorkerReq: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCommand
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Remote = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAndPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -7838,23 +23712,25 @@ func (m *NewCluster_WorkerReq) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCommand
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAndPort = string(dAtA[iNdEx:postIndex])
+			m.Stages = append(m.Stages, &PipelineStage{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7880,7 +23756,7 @@ func (m *NewCluster_WorkerReq) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewClusterAck) Unmarshal(dAtA []byte) error {
+func (m *StartPipelineAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7903,65 +23779,44 @@ func (m *NewClusterAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewClusterAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartPipelineAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewClusterAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartPipelineAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCommand(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthCommand
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCommand
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CoordinatorStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCommand
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CoordinatorStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoordinatorStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -7986,7 +23841,7 @@ func (m *CoordinatorStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
+func (m *StageStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8009,17 +23864,17 @@ func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CoordinatorStatsAck: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CoordinatorStatsAck: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SuperStep", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.SuperStep = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -8029,16 +23884,29 @@ func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SuperStep |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NrOfActiveVertex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SuperSteps", wireType)
 			}
-			m.NrOfActiveVertex = 0
+			m.SuperSteps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -8048,7 +23916,7 @@ func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NrOfActiveVertex |= uint64(b&0x7F) << shift
+				m.SuperSteps |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8073,10 +23941,10 @@ func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedMillis", wireType)
 			}
-			var stringLen uint64
+			m.ElapsedMillis = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCommand
@@ -8086,24 +23954,84 @@ func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ElapsedMillis |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Completed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Completed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommand(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthCommand
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthCommand
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShowPipeline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommand
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShowPipeline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShowPipeline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
@@ -8128,7 +24056,7 @@ func (m *CoordinatorStatsAck) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartSuperStep) Unmarshal(dAtA []byte) error {
+func (m *ShowPipelineAck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8151,12 +24079,46 @@ func (m *StartSuperStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartSuperStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShowPipelineAck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartSuperStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShowPipelineAck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommand
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommand
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommand
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stages = append(m.Stages, &StageStats{})
+			if err := m.Stages[len(m.Stages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommand(dAtA[iNdEx:])
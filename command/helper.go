@@ -1,5 +1,7 @@
 package command
 
+import "time"
+
 // StatsCompleted returns if CoordinatorStatsAck shows processing has finished or not
 func (s *CoordinatorStatsAck) StatsCompleted() bool {
 	// Why I check the number of messages sent is that the number of actives is often incorrect.
@@ -33,3 +35,58 @@ func (info *ClusterInfo) NumOfPartitions() uint64 {
 	}
 	return size
 }
+
+// Add accumulates other into the stats
+func (s *MessageBatchStats) Add(other *MessageBatchStats) {
+	s.Batches += other.GetBatches()
+	s.Messages += other.GetMessages()
+	s.RawBytes += other.GetRawBytes()
+	s.EncodedBytes += other.GetEncodedBytes()
+	s.MaxBatchMessages = maxUint64(s.MaxBatchMessages, other.GetMaxBatchMessages())
+	s.MaxBatchBytes = maxUint64(s.MaxBatchBytes, other.GetMaxBatchBytes())
+}
+
+// AvgBatchBytes returns the average encoded size of batches, 0 if no batch has been sent
+func (s *MessageBatchStats) AvgBatchBytes() uint64 {
+	if s.GetBatches() == 0 {
+		return 0
+	}
+	return s.GetEncodedBytes() / s.GetBatches()
+}
+
+// AvgBatchMessages returns the average number of messages in a batch, 0 if no batch has been sent
+func (s *MessageBatchStats) AvgBatchMessages() uint64 {
+	if s.GetBatches() == 0 {
+		return 0
+	}
+	return s.GetMessages() / s.GetBatches()
+}
+
+// CompressionRatio returns encoded size of batches divided by size of the messages sent one by one, 0 if no batch has been sent
+func (s *MessageBatchStats) CompressionRatio() float64 {
+	if s.GetRawBytes() == 0 {
+		return 0
+	}
+	return float64(s.GetEncodedBytes()) / float64(s.GetRawBytes())
+}
+
+// Add accumulates other into the stats, mailbox depths are the maximum of both
+func (s *FlowControlStats) Add(other *FlowControlStats) {
+	s.StalledMessages += other.GetStalledMessages()
+	s.StallNanos += other.GetStallNanos()
+	s.MaxVertexMailbox = maxUint64(s.MaxVertexMailbox, other.GetMaxVertexMailbox())
+	s.MaxPartitionMailbox = maxUint64(s.MaxPartitionMailbox, other.GetMaxPartitionMailbox())
+	s.MaxWorkerMailbox = maxUint64(s.MaxWorkerMailbox, other.GetMaxWorkerMailbox())
+}
+
+// StallTime returns total time senders held messages
+func (s *FlowControlStats) StallTime() time.Duration {
+	return time.Duration(s.GetStallNanos())
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
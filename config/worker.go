@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/pkg/errors"
@@ -18,6 +19,10 @@ type CommonConfig struct {
 type WorkerEnv struct {
 	CommonConfig
 	ListenAddress string `envconfig:"LISTEN_ADDR" default:"127.0.0.1:8802" yaml:"listen_addr"`
+	// AdvertiseAddress is address registered with the master, ListenAddress by default
+	AdvertiseAddress string `envconfig:"ADVERTISE_ADDR" yaml:"advertise_addr"`
+	// MasterAddress is address of the master to register the worker with. The worker waits to be spawned by the master if empty
+	MasterAddress string `envconfig:"MASTER_ADDR" yaml:"master_addr"`
 }
 
 // MasterEnv is set of environments for workers
@@ -27,6 +32,41 @@ type MasterEnv struct {
 	APIPort         int      `envconfig:"API_PORT" default:":8881" yaml:"api_port"`
 	WorkerAddresses []string `envconfig:"WORKERS" default:"" yaml:"worker_addresses"`
 	Partitions      uint64   `envconfig:"PARTITIONS" yaml:"partitions"`
+	// MinWorkers is number of workers registering themselves to wait for before partitions are assigned
+	MinWorkers int `envconfig:"MIN_WORKERS" yaml:"min_workers"`
+	// WorkerDNS is DNS name to discover workers, host:port to look up A/AAAA records or a name of SRV records
+	WorkerDNS string `envconfig:"WORKER_DNS" yaml:"worker_dns"`
+	// WorkerDNSSRV makes WorkerDNS looked up as SRV records
+	WorkerDNSSRV bool `envconfig:"WORKER_DNS_SRV" yaml:"worker_dns_srv"`
+	// RegistrationTimeout is how long to wait for MinWorkers workers to register or to be discovered. The cluster is formed with registered workers on timeout
+	RegistrationTimeout time.Duration `envconfig:"REGISTRATION_TIMEOUT" default:"120s" yaml:"registration_timeout"`
+	// RebalanceThreshold moves partitions between supersteps when the most loaded worker exceeds the average by this ratio, e.g. 0.2. 0 disables it
+	RebalanceThreshold float64 `envconfig:"REBALANCE_THRESHOLD" yaml:"rebalance_threshold"`
+	// MaxVertexMoves is the number of vertices moved to partitions they send most messages to between supersteps. 0 disables it
+	MaxVertexMoves uint32 `envconfig:"MAX_VERTEX_MOVES" yaml:"max_vertex_moves"`
+	// VertexCacheSize is the number of vertices whose partition is cached by each worker. 0 disables it
+	VertexCacheSize uint32 `envconfig:"VERTEX_CACHE_SIZE" yaml:"vertex_cache_size"`
+	// NumericVertexIDs identifies vertices by integer IDs in messages and partitions
+	NumericVertexIDs bool `envconfig:"NUMERIC_VERTEX_IDS" yaml:"numeric_vertex_ids"`
+	// MessageBatch sends messages to other workers by a batch per destination worker in compact encoding
+	MessageBatch bool `envconfig:"MESSAGE_BATCH" yaml:"message_batch"`
+	// MessageCompression compresses message batches, gzip or none
+	MessageCompression string `envconfig:"MESSAGE_COMPRESSION" yaml:"message_compression"`
+	// VertexInflight, PartitionInflight and WorkerInflight limit messages sent and not acked yet by each vertex, partition and worker to each of other workers. 0 means no limit
+	VertexInflight    uint32 `envconfig:"VERTEX_INFLIGHT" yaml:"vertex_inflight"`
+	PartitionInflight uint32 `envconfig:"PARTITION_INFLIGHT" yaml:"partition_inflight"`
+	WorkerInflight    uint32 `envconfig:"WORKER_INFLIGHT" yaml:"worker_inflight"`
+	// MessageMemoryLimit spills messages queued in a worker process to SpillDir once they exceed this number of bytes. 0 keeps them in memory
+	MessageMemoryLimit uint64 `envconfig:"MESSAGE_MEMORY_LIMIT" yaml:"message_memory_limit"`
+	// SpillDir is directory of spill files, the temporary directory by default
+	SpillDir string `envconfig:"SPILL_DIR" yaml:"spill_dir"`
+	// PartitionStore keeps vertices on local disk in PartitionStoreDir, the plugin has to implement VertexMarshaler
+	PartitionStore    bool   `envconfig:"PARTITION_STORE" yaml:"partition_store"`
+	PartitionStoreDir string `envconfig:"PARTITION_STORE_DIR" yaml:"partition_store_dir"`
+	// PartitionMemoryLimit computes partitions of a worker in turns so that their stored vertices fit this number of bytes. 0 computes all at once
+	PartitionMemoryLimit uint64 `envconfig:"PARTITION_MEMORY_LIMIT" yaml:"partition_memory_limit"`
+	// SuperStepBarrier waits for the barrier before every superstep instead of starting supersteps by Compute
+	SuperStepBarrier bool `envconfig:"SUPERSTEP_BARRIER" yaml:"superstep_barrier"`
 }
 
 // LoadWorkerConfFromEnv reads configuration from env
@@ -54,6 +94,14 @@ func LoadWorkerConfFromEnv(prefix string) (interface{}, error) {
 	}
 }
 
+// RegisterAddress returns address registered with the master
+func (w *WorkerEnv) RegisterAddress() string {
+	if w.AdvertiseAddress != "" {
+		return w.AdvertiseAddress
+	}
+	return w.ListenAddress
+}
+
 // Logger returns logger
 func (cc *CommonConfig) Logger() *logrus.Logger {
 	logger := logrus.New()
@@ -14,6 +14,8 @@ import (
 
 var _ = (plugin.Vertex)(&ssspVert{})
 var _ = (plugin.Plugin)(&ssspPlugin{})
+var _ = (plugin.EdgeSource)(&ssspVert{})
+var _ = (plugin.EdgePlugin)(&ssspPlugin{})
 
 type ssspVert struct {
 	id        string
@@ -42,10 +44,11 @@ func (v *ssspVert) Compute(ctx plugin.ComputeContext) error {
 		v.parent = min.FromVertexId
 	}
 
-	for id, dist := range v.outgoings {
-		if err := ctx.SendMessageTo(plugin.VertexID(id), &sssp.SSSPMessage{
+	// distances are stored by the partition
+	for it := plugin.OutEdgesOf(ctx); it.Next(); {
+		if err := ctx.SendMessageTo(it.Dest(), &sssp.SSSPMessage{
 			FromVertexId: v.id,
-			Value:        v.value + dist,
+			Value:        v.value + it.Uint32(),
 		}); err != nil {
 			return err
 		}
@@ -54,6 +57,15 @@ func (v *ssspVert) Compute(ctx plugin.ComputeContext) error {
 	return nil
 }
 
+// TakeOutEdges hands distances over to the partition, which stores them far more compactly than the map
+func (v *ssspVert) TakeOutEdges(add func(e plugin.OutEdge)) error {
+	for id, dist := range v.outgoings {
+		add(plugin.OutEdge{Dest: plugin.VertexID(id), Uint32: dist})
+	}
+	v.outgoings = nil
+	return nil
+}
+
 func (v *ssspVert) GetID() plugin.VertexID {
 	return plugin.VertexID(v.id)
 }
@@ -94,6 +106,11 @@ func (p *ssspPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions ui
 	return nil
 }
 
+// EdgeValueType stores a distance per edge
+func (p *ssspPlugin) EdgeValueType() plugin.EdgeValueType {
+	return plugin.EdgeValueUint32
+}
+
 // Partition provides partition information
 func (p *ssspPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
 	return plugin.HashPartition(vertex, numOfPartitions)
@@ -0,0 +1,79 @@
+package plugin
+
+// EdgeValueType is the type of values of edges stored by the framework
+type EdgeValueType int
+
+const (
+	// EdgeValueNone stores destinations only
+	EdgeValueNone EdgeValueType = iota
+	// EdgeValueUint32 stores a uint32 per edge, e.g. distance
+	EdgeValueUint32
+	// EdgeValueFloat64 stores a float64 per edge, e.g. weight
+	EdgeValueFloat64
+	// EdgeValueBytes stores a byte slice per edge
+	EdgeValueBytes
+)
+
+// OutEdge is an outgoing edge handed over to the framework. Values other than the EdgeValueType of the plugin are ignored
+type OutEdge struct {
+	Dest    VertexID
+	Uint32  uint32
+	Float64 float64
+	Bytes   []byte
+}
+
+// EdgePlugin is a plugin whose outgoing edges are stored by the framework.
+// Each partition keeps edges of its vertices in compressed sparse row format, which is far smaller than maps or slices per vertex.
+type EdgePlugin interface {
+	EdgeValueType() EdgeValueType
+}
+
+// EdgeSource is a vertex which hands its outgoing edges over to the framework when it is loaded, if the plugin implements EdgePlugin.
+// The vertex should drop its edges after TakeOutEdges() returns, they are given by EdgeComputeContext instead.
+type EdgeSource interface {
+	TakeOutEdges(add func(e OutEdge)) error
+}
+
+// EdgeIterator iterates outgoing edges of a vertex in order of destinations, which is not the order they were added.
+//
+//	for it := ctx.OutEdges(); it.Next(); {
+//	    ctx.SendMessageTo(it.Dest(), it.Uint32())
+//	}
+type EdgeIterator interface {
+	// Next moves to the next edge, it returns false after the last edge
+	Next() bool
+	Dest() VertexID
+	// DestNumeric returns the integer ID of the destination, it's cheaper than Dest() with numeric vertex IDs
+	DestNumeric() uint64
+	Uint32() uint32
+	Float64() float64
+	// Bytes returns the value shared with the store, it must not be modified
+	Bytes() []byte
+}
+
+// EdgeComputeContext gives outgoing edges stored by the framework. ComputeContext implements it, edges are empty unless the plugin implements EdgePlugin
+type EdgeComputeContext interface {
+	ComputeContext
+	NumOfOutEdges() int
+	OutEdges() EdgeIterator
+}
+
+// OutEdgesOf returns the edge iterator of the context, it's empty if the context doesn't support stored edges
+func OutEdgesOf(ctx ComputeContext) EdgeIterator {
+	if ec, ok := ctx.(EdgeComputeContext); ok {
+		return ec.OutEdges()
+	}
+	return NoEdges
+}
+
+// NoEdges is an iterator of no edges
+var NoEdges EdgeIterator = emptyEdgeIterator{}
+
+type emptyEdgeIterator struct{}
+
+func (emptyEdgeIterator) Next() bool          { return false }
+func (emptyEdgeIterator) Dest() VertexID      { return "" }
+func (emptyEdgeIterator) DestNumeric() uint64 { return 0 }
+func (emptyEdgeIterator) Uint32() uint32      { return 0 }
+func (emptyEdgeIterator) Float64() float64    { return 0 }
+func (emptyEdgeIterator) Bytes() []byte       { return nil }
@@ -0,0 +1,71 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// NumericVertex is a vertex of a graph whose vertex IDs are integers.
+// GetID() has to return the same ID formatted by VertexIDOf().
+type NumericVertex interface {
+	Vertex
+	GetNumericID() uint64
+}
+
+// NumericComputeContext sends messages by integer vertex IDs.
+// ComputeContext implements it while the job runs with numeric vertex IDs.
+type NumericComputeContext interface {
+	ComputeContext
+	SendMessageToNumeric(dest uint64, m Message) error
+}
+
+// NumericPartitioner is a plugin which partitions vertices by integer IDs without formatting them.
+// PartitionNumeric() has to return the same partition as Partition() does for the ID formatted by VertexIDOf().
+type NumericPartitioner interface {
+	PartitionNumeric(vertex uint64, numOfPartitions uint64) (uint64, error)
+}
+
+// VertexIDOf converts an integer vertex ID to VertexID
+func VertexIDOf(id uint64) VertexID {
+	return VertexID(strconv.FormatUint(id, 10))
+}
+
+// ParseNumericID converts VertexID formatted by VertexIDOf() to integer
+func ParseNumericID(id VertexID) (uint64, error) {
+	n, err := strconv.ParseUint(string(id), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("vertex id is not numeric: %q", id)
+	}
+	return n, nil
+}
+
+// NumericIDOf returns integer ID of the vertex, GetID() is parsed unless the vertex implements NumericVertex
+func NumericIDOf(v Vertex) (uint64, error) {
+	if nv, ok := v.(NumericVertex); ok {
+		return nv.GetNumericID(), nil
+	}
+	return ParseNumericID(v.GetID())
+}
+
+// SendMessageToNumeric sends a message by integer vertex ID. The ID is formatted if the context doesn't support numeric IDs
+func SendMessageToNumeric(ctx ComputeContext, dest uint64, m Message) error {
+	if nc, ok := ctx.(NumericComputeContext); ok {
+		return nc.SendMessageToNumeric(dest, m)
+	}
+	return ctx.SendMessageTo(VertexIDOf(dest), m)
+}
+
+// HashPartitionNumeric mixes bits of id then mod. It's cheaper than HashPartition but partitions vertices differently
+func HashPartitionNumeric(id uint64, nrOfPartitions uint64) (uint64, error) {
+	if nrOfPartitions == 0 {
+		return 0, errors.New("no partitions")
+	}
+	// finalizer of splitmix64
+	id ^= id >> 30
+	id *= 0xbf58476d1ce4e5b9
+	id ^= id >> 27
+	id *= 0x94d049bb133111eb
+	id ^= id >> 31
+	return id % nrOfPartitions, nil
+}
@@ -0,0 +1,99 @@
+package partitioner
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+const defaultReplicas = 100
+
+// ConsistentHash is a partitioner which places partitions on a hash ring.
+// When the number of partitions changes from n to n+1 only about 1/(n+1) of vertices move.
+type ConsistentHash struct {
+	// Replicas is the number of points of each partition on the ring, 100 by default. More points distribute vertices more evenly
+	Replicas int
+
+	mux   sync.RWMutex
+	rings map[uint64]*ring
+}
+
+type ring struct {
+	hashes     []uint64
+	partitions []uint64
+}
+
+// Partition returns the partition of the first point on the ring at or after hash of the vertex
+func (c *ConsistentHash) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	if numOfPartitions == 0 {
+		return 0, fmt.Errorf("no partitions")
+	}
+	r := c.ring(numOfPartitions)
+	h := hash64(string(vertex))
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.partitions[i], nil
+}
+
+// ring returns the ring of numOfPartitions, rings are built once per number of partitions
+func (c *ConsistentHash) ring(numOfPartitions uint64) *ring {
+	c.mux.RLock()
+	r, ok := c.rings[numOfPartitions]
+	c.mux.RUnlock()
+	if ok {
+		return r
+	}
+
+	replicas := c.Replicas
+	if replicas <= 0 {
+		replicas = defaultReplicas
+	}
+	r = &ring{}
+	type point struct {
+		hash      uint64
+		partition uint64
+	}
+	points := make([]point, 0, numOfPartitions*uint64(replicas))
+	for p := uint64(0); p < numOfPartitions; p++ {
+		for i := 0; i < replicas; i++ {
+			points = append(points, point{hash: hash64(strconv.FormatUint(p, 10) + "#" + strconv.Itoa(i)), partition: p})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash == points[j].hash {
+			return points[i].partition < points[j].partition
+		}
+		return points[i].hash < points[j].hash
+	})
+	for _, pt := range points {
+		r.hashes = append(r.hashes, pt.hash)
+		r.partitions = append(r.partitions, pt.partition)
+	}
+
+	c.mux.Lock()
+	if c.rings == nil {
+		c.rings = make(map[uint64]*ring)
+	}
+	c.rings[numOfPartitions] = r
+	c.mux.Unlock()
+	return r
+}
+
+// hash64 returns FNV-1a hash of s. Its bits are mixed up as FNV doesn't spread short keys over the ring
+func hash64(s string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
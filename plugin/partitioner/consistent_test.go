@@ -0,0 +1,61 @@
+package partitioner
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func TestConsistentHash_Partition(t *testing.T) {
+	c := &ConsistentHash{}
+	if _, err := c.Partition("v", 0); err == nil {
+		t.Fatal("no partitions should be error")
+	}
+
+	const nrOfVertices = 10000
+	assign := func(n uint64) []uint64 {
+		parts := make([]uint64, nrOfVertices)
+		for i := range parts {
+			p, err := c.Partition(plugin.VertexID(fmt.Sprintf("v%d", i)), n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if p >= n {
+				t.Fatalf("partition out of range: %d", p)
+			}
+			parts[i] = p
+		}
+		return parts
+	}
+
+	before := assign(8)
+	if again := assign(8); fmt.Sprint(again) != fmt.Sprint(before) {
+		t.Fatal("partitions are not deterministic")
+	}
+	counts := make([]int, 8)
+	for _, p := range before {
+		counts[p]++
+	}
+	for p, cnt := range counts {
+		// average is 1250
+		if cnt < 800 || cnt > 1700 {
+			t.Fatalf("partition %d is imbalanced: %v", p, counts)
+		}
+	}
+
+	after := assign(9)
+	moved := 0
+	for i := range before {
+		if before[i] != after[i] {
+			if after[i] != 8 {
+				t.Fatalf("v%d moved between existing partitions: %d -> %d", i, before[i], after[i])
+			}
+			moved++
+		}
+	}
+	// about 1/9 of vertices move to the new partition
+	if moved == 0 || moved > nrOfVertices/5 {
+		t.Fatalf("unexpected number of moved vertices: %d", moved)
+	}
+}
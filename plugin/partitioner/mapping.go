@@ -0,0 +1,165 @@
+package partitioner
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// Mapping is a partitioner driven by a precomputed vertex to partition mapping, e.g. output of METIS
+type Mapping struct {
+	partitions map[plugin.VertexID]uint64
+	required   uint64
+
+	// Fallback assigns vertices missing in the mapping. Partition() returns an error for them if nil
+	Fallback Partitioner
+}
+
+// NewMapping returns a partitioner of the mapping
+func NewMapping(partitions map[plugin.VertexID]uint64) *Mapping {
+	m := &Mapping{partitions: make(map[plugin.VertexID]uint64, len(partitions))}
+	for id, p := range partitions {
+		m.set(id, p)
+	}
+	return m
+}
+
+func (m *Mapping) set(id plugin.VertexID, partition uint64) {
+	m.partitions[id] = partition
+	if partition+1 > m.required {
+		m.required = partition + 1
+	}
+}
+
+// NumOfPartitions returns the number of partitions the mapping requires at least
+func (m *Mapping) NumOfPartitions() uint64 {
+	return m.required
+}
+
+// Len returns the number of vertices in the mapping
+func (m *Mapping) Len() int {
+	return len(m.partitions)
+}
+
+// Partition returns the partition of the vertex in the mapping
+func (m *Mapping) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	if numOfPartitions < m.required {
+		return 0, fmt.Errorf("mapping requires %d partitions but %d", m.required, numOfPartitions)
+	}
+	p, ok := m.partitions[vertex]
+	if ok {
+		return p, nil
+	}
+	if m.Fallback == nil {
+		return 0, fmt.Errorf("vertex not in mapping: %v", vertex)
+	}
+	return m.Fallback.Partition(vertex, numOfPartitions)
+}
+
+// ReadMapping reads lines of '<vertex id> <partition>'. Empty lines and lines starting with '#' or '%' are skipped
+func ReadMapping(r io.Reader) (*Mapping, error) {
+	m := NewMapping(nil)
+	err := scanLines(r, func(lineNo int, fields []string) error {
+		if len(fields) != 2 {
+			return fmt.Errorf("line %d: expected '<vertex id> <partition>'", lineNo)
+		}
+		p, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid partition: %s", lineNo, fields[1])
+		}
+		id := plugin.VertexID(fields[0])
+		if _, ok := m.partitions[id]; ok {
+			return fmt.Errorf("line %d: duplicate vertex: %s", lineNo, id)
+		}
+		m.set(id, p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ReadMETIS reads a partition file written by METIS (gpmetis graph.part.N) where n-th line is the partition of n-th vertex in the graph file.
+// ids[n-1] is ID of n-th vertex. If ids is nil, vertices are identified by numbers starting from 1 as METIS does.
+func ReadMETIS(r io.Reader, ids []plugin.VertexID) (*Mapping, error) {
+	m := NewMapping(nil)
+	n := 0
+	err := scanLines(r, func(lineNo int, fields []string) error {
+		if len(fields) != 1 {
+			return fmt.Errorf("line %d: expected a partition", lineNo)
+		}
+		p, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid partition: %s", lineNo, fields[0])
+		}
+		var id plugin.VertexID
+		if ids == nil {
+			id = plugin.VertexID(strconv.Itoa(n + 1))
+		} else if n < len(ids) {
+			id = ids[n]
+		} else {
+			return fmt.Errorf("line %d: more vertices than %d ids", lineNo, len(ids))
+		}
+		m.set(id, p)
+		n++
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ids != nil && n != len(ids) {
+		return nil, fmt.Errorf("%d vertices in partition file but %d ids", n, len(ids))
+	}
+	return m, nil
+}
+
+// LoadMappingFile reads the mapping file, see ReadMapping()
+func LoadMappingFile(path string) (*Mapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open mapping file")
+	}
+	defer f.Close()
+	m, err := ReadMapping(f)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	return m, nil
+}
+
+// LoadMETISFile reads the METIS partition file, see ReadMETIS()
+func LoadMETISFile(path string, ids []plugin.VertexID) (*Mapping, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open METIS partition file")
+	}
+	defer f.Close()
+	m, err := ReadMETIS(f, ids)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %s", path)
+	}
+	return m, nil
+}
+
+func scanLines(r io.Reader, f func(lineNo int, fields []string) error) error {
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "%") {
+			continue
+		}
+		if err := f(lineNo, strings.Fields(line)); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
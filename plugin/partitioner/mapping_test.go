@@ -0,0 +1,95 @@
+package partitioner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func TestReadMapping(t *testing.T) {
+	m, err := ReadMapping(strings.NewReader("# vertex partition\na 0\n\nb 2\n  c   1  \n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Len() != 3 || m.NumOfPartitions() != 3 {
+		t.Fatalf("unexpected mapping: len=%d partitions=%d", m.Len(), m.NumOfPartitions())
+	}
+	for id, want := range map[plugin.VertexID]uint64{"a": 0, "b": 2, "c": 1} {
+		if p, err := m.Partition(id, 3); err != nil || p != want {
+			t.Fatalf("unexpected partition of %s: %d, %v", id, p, err)
+		}
+	}
+	if _, err := m.Partition("a", 2); err == nil {
+		t.Fatal("fewer partitions than the mapping should be error")
+	}
+	if _, err := m.Partition("unknown", 3); err == nil {
+		t.Fatal("vertex not in mapping should be error")
+	}
+	m.Fallback = Func(func(plugin.VertexID, uint64) (uint64, error) { return 1, nil })
+	if p, err := m.Partition("unknown", 3); err != nil || p != 1 {
+		t.Fatalf("fallback is not used: %d, %v", p, err)
+	}
+
+	for _, invalid := range []string{"a", "a 0 1", "a x", "a 0\na 1"} {
+		if _, err := ReadMapping(strings.NewReader(invalid)); err == nil {
+			t.Fatalf("%q should be rejected", invalid)
+		}
+	}
+}
+
+func TestReadMETIS(t *testing.T) {
+	m, err := ReadMETIS(strings.NewReader("1\n0\n1\n"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, want := range map[plugin.VertexID]uint64{"1": 1, "2": 0, "3": 1} {
+		if p, err := m.Partition(id, 2); err != nil || p != want {
+			t.Fatalf("unexpected partition of %s: %d, %v", id, p, err)
+		}
+	}
+
+	m, err = ReadMETIS(strings.NewReader("1\n0\n"), []plugin.VertexID{"x", "y"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, err := m.Partition("x", 2); err != nil || p != 1 {
+		t.Fatalf("unexpected partition of x: %d, %v", p, err)
+	}
+
+	if _, err := ReadMETIS(strings.NewReader("1\n0\n"), []plugin.VertexID{"x"}); err == nil {
+		t.Fatal("more vertices than ids should be error")
+	}
+	if _, err := ReadMETIS(strings.NewReader("1\n"), []plugin.VertexID{"x", "y"}); err == nil {
+		t.Fatal("fewer vertices than ids should be error")
+	}
+}
+
+func TestLoadMappingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "partitioner")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "graph.part.2")
+	if err := ioutil.WriteFile(path, []byte("0\n1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := LoadMETISFile(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Len() != 2 {
+		t.Fatalf("unexpected mapping: %d", m.Len())
+	}
+	if _, err := LoadMappingFile(path); err == nil {
+		t.Fatal("METIS file is not '<vertex id> <partition>'")
+	}
+	if _, err := LoadMappingFile(filepath.Join(dir, "none")); err == nil {
+		t.Fatal("missing file should be error")
+	}
+}
@@ -0,0 +1,23 @@
+// Package partitioner provides strategies to assign vertices to partitions.
+// A plugin can delegate its Partition() to one of them.
+package partitioner
+
+import (
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// Partitioner assigns vertices to partitions. It has the same signature as Plugin.Partition()
+type Partitioner interface {
+	Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error)
+}
+
+// Func is a function used as Partitioner
+type Func func(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error)
+
+// Partition calls f
+func (f Func) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	return f(vertex, numOfPartitions)
+}
+
+// Hash is a partitioner which calculates hash of id then mod. Most vertices move when the number of partitions changes
+var Hash Partitioner = Func(plugin.HashPartition)
@@ -0,0 +1,48 @@
+package partitioner
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// Range is a partitioner for numeric vertex IDs between Min and Max.
+// The range is split into contiguous ranges of the same size so that neighboring IDs fall into the same partition.
+type Range struct {
+	Min uint64
+	Max uint64
+}
+
+// Partition parses the vertex ID as an unsigned integer then returns the partition covering it
+func (r *Range) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	id, err := strconv.ParseUint(string(vertex), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("not numeric vertex id: %v", vertex)
+	}
+	return r.PartitionNumeric(id, numOfPartitions)
+}
+
+// PartitionNumeric returns the partition covering the integer vertex ID
+func (r *Range) PartitionNumeric(id uint64, numOfPartitions uint64) (uint64, error) {
+	if numOfPartitions == 0 {
+		return 0, fmt.Errorf("no partitions")
+	}
+	if r.Min > r.Max {
+		return 0, fmt.Errorf("invalid range: min=%d max=%d", r.Min, r.Max)
+	}
+	if id < r.Min || id > r.Max {
+		return 0, fmt.Errorf("vertex id %d out of range [%d, %d]", id, r.Min, r.Max)
+	}
+
+	// (id - min) * n / (max - min + 1) without overflow
+	hi, lo := bits.Mul64(id-r.Min, numOfPartitions)
+	width := r.Max - r.Min + 1
+	if width == 0 {
+		// the range covers all uint64
+		return hi, nil
+	}
+	q, _ := bits.Div64(hi, lo, width)
+	return q, nil
+}
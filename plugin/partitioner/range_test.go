@@ -0,0 +1,80 @@
+package partitioner
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func TestRange_Partition(t *testing.T) {
+	tests := []struct {
+		name    string
+		r       *Range
+		id      plugin.VertexID
+		n       uint64
+		want    uint64
+		wantErr bool
+	}{
+		{name: "first", r: &Range{Min: 0, Max: 99}, id: "0", n: 4, want: 0},
+		{name: "end of first range", r: &Range{Min: 0, Max: 99}, id: "24", n: 4, want: 0},
+		{name: "beginning of second range", r: &Range{Min: 0, Max: 99}, id: "25", n: 4, want: 1},
+		{name: "last", r: &Range{Min: 0, Max: 99}, id: "99", n: 4, want: 3},
+		{name: "offset", r: &Range{Min: 100, Max: 199}, id: "150", n: 2, want: 1},
+		{name: "whole uint64", r: &Range{Min: 0, Max: math.MaxUint64}, id: plugin.VertexID(strconv.FormatUint(math.MaxUint64, 10)), n: 8, want: 7},
+		{name: "out of range", r: &Range{Min: 0, Max: 99}, id: "100", n: 4, wantErr: true},
+		{name: "not numeric", r: &Range{Min: 0, Max: 99}, id: "v1", n: 4, wantErr: true},
+		{name: "no partitions", r: &Range{Min: 0, Max: 99}, id: "1", n: 0, wantErr: true},
+		{name: "invalid range", r: &Range{Min: 10, Max: 9}, id: "10", n: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.r.Partition(tt.id, tt.n)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Partition() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Fatalf("Partition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRange_sizes(t *testing.T) {
+	r := &Range{Min: 1, Max: 1000}
+	counts := make([]int, 7)
+	last := uint64(0)
+	for i := 1; i <= 1000; i++ {
+		p, err := r.Partition(plugin.VertexID(strconv.Itoa(i)), 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p < last {
+			t.Fatalf("partitions are not contiguous at %d", i)
+		}
+		last = p
+		counts[p]++
+	}
+	for p, c := range counts {
+		if c < 142 || c > 143 {
+			t.Fatalf("unexpected size of partition %d: %d", p, c)
+		}
+	}
+}
+
+func TestRange_PartitionNumeric(t *testing.T) {
+	r := &Range{Min: 10, Max: 200}
+	for i := uint64(10); i <= 200; i++ {
+		want, err := r.Partition(plugin.VertexIDOf(i), 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := r.PartitionNumeric(i, 6); err != nil || got != want {
+			t.Fatalf("PartitionNumeric(%d) = %v(%v), want %v", i, got, err, want)
+		}
+	}
+	if _, err := r.PartitionNumeric(201, 6); err == nil {
+		t.Fatal("out of range should fail")
+	}
+}
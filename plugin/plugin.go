@@ -30,7 +30,8 @@ type Vertex interface {
 	GetValueAsString() string
 }
 
-// Aggregator is Pregel aggregator implemented by user
+// Aggregator is Pregel aggregator implemented by user.
+// Values are aggregated without marshaling within a worker, so Aggregate() must not modify v1 and v2 but return a new value.
 type Aggregator interface {
 	Name() string
 	Aggregate(v1 AggregatableValue, v2 AggregatableValue) (AggregatableValue, error)
@@ -51,3 +52,42 @@ type Plugin interface {
 	GetCombiner() func(destination VertexID, messages []Message) ([]Message, error)
 	GetAggregators() []Aggregator
 }
+
+// Stage is a compute stage of a pipeline. It computes vertices loaded by the plugin in place of Vertex.Compute()
+type Stage interface {
+	Name() string
+	Compute(vertex Vertex, computeContext ComputeContext, params map[string]string) error
+}
+
+// PipelinePlugin is a plugin which provides compute stages to be run back to back over the same loaded graph
+type PipelinePlugin interface {
+	Plugin
+	GetStages() []Stage
+}
+
+// VersionedPlugin is a plugin which declares its version and names of message types.
+// They are compared between master and workers so that workers running another build are refused.
+type VersionedPlugin interface {
+	Plugin
+	Version() string
+	MessageTypes() []string
+}
+
+// VertexMarshaler is a plugin which can serialize vertices.
+// It is required to move loaded vertices to another worker when workers are added or drained.
+type VertexMarshaler interface {
+	MarshalVertex(v Vertex) (*types.Any, error)
+	UnmarshalVertex(pb *types.Any) (Vertex, error)
+}
+
+// EdgeCounter is a vertex which tells the number of its outgoing edges.
+// It is used as weight of partitions to balance load of workers.
+type EdgeCounter interface {
+	NumOfEdges() int
+}
+
+// EdgeLister is a vertex which tells destinations of its outgoing edges.
+// It is used to count edges cut by partitioning, and as EdgeCounter if the vertex is not.
+type EdgeLister interface {
+	OutEdges() []VertexID
+}
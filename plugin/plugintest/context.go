@@ -0,0 +1,212 @@
+package plugintest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// SentMessage is a message recorded by SendMessageTo()
+type SentMessage struct {
+	Dest    plugin.VertexID
+	Message plugin.Message
+}
+
+// Context is a fake plugin.ComputeContext which records calls made by Compute()
+type Context struct {
+	// Step is the number returned by SuperStep()
+	Step uint64
+	// Messages are messages returned by ReceivedMessages()
+	Messages []plugin.Message
+	// Aggregated are values aggregated in the previous superstep, returned by GetAggregated()
+	Aggregated map[string]plugin.AggregatableValue
+	// Sent are messages sent by SendMessageTo()
+	Sent []SentMessage
+	// Halted is true if VoteToHalt() has been called
+	Halted bool
+	// Aggregatables are values put by PutAggregatable() in order
+	Aggregatables map[string][]plugin.AggregatableValue
+	// SendError is returned by SendMessageTo() if not nil
+	SendError error
+	// Edges are outgoing edges returned by OutEdges() in order
+	Edges []plugin.OutEdge
+}
+
+var _ = (plugin.EdgeComputeContext)(&Context{})
+
+// NewContext returns a fake context of the superstep which receives messages
+func NewContext(superStep uint64, messages ...plugin.Message) *Context {
+	return &Context{
+		Step:          superStep,
+		Messages:      messages,
+		Aggregated:    make(map[string]plugin.AggregatableValue),
+		Aggregatables: make(map[string][]plugin.AggregatableValue),
+	}
+}
+
+// WithEdges sets outgoing edges stored by the framework
+func (c *Context) WithEdges(edges ...plugin.OutEdge) *Context {
+	c.Edges = edges
+	return c
+}
+
+// WithAggregated sets a value aggregated in the previous superstep
+func (c *Context) WithAggregated(name string, v plugin.AggregatableValue) *Context {
+	c.Aggregated[name] = v
+	return c
+}
+
+// SuperStep returns current superstep
+func (c *Context) SuperStep() uint64 {
+	return c.Step
+}
+
+// ReceivedMessages returns injected messages
+func (c *Context) ReceivedMessages() []plugin.Message {
+	return c.Messages
+}
+
+// SendMessageTo records a message
+func (c *Context) SendMessageTo(dest plugin.VertexID, m plugin.Message) error {
+	if c.SendError != nil {
+		return c.SendError
+	}
+	c.Sent = append(c.Sent, SentMessage{Dest: dest, Message: m})
+	return nil
+}
+
+// SendMessageToNumeric records a message with the destination formatted by plugin.VertexIDOf()
+func (c *Context) SendMessageToNumeric(dest uint64, m plugin.Message) error {
+	return c.SendMessageTo(plugin.VertexIDOf(dest), m)
+}
+
+// NumOfOutEdges returns the number of injected edges
+func (c *Context) NumOfOutEdges() int {
+	return len(c.Edges)
+}
+
+// OutEdges iterates injected edges
+func (c *Context) OutEdges() plugin.EdgeIterator {
+	return &edgeIterator{edges: c.Edges, i: -1}
+}
+
+// VoteToHalt records halting
+func (c *Context) VoteToHalt() {
+	c.Halted = true
+}
+
+// GetAggregated returns injected aggregated value
+func (c *Context) GetAggregated(aggregatorName string) (plugin.AggregatableValue, bool, error) {
+	v, ok := c.Aggregated[aggregatorName]
+	return v, ok, nil
+}
+
+// PutAggregatable records a value
+func (c *Context) PutAggregatable(aggregatorName string, v plugin.AggregatableValue) error {
+	c.Aggregatables[aggregatorName] = append(c.Aggregatables[aggregatorName], v)
+	return nil
+}
+
+// SentTo returns messages sent to dest in order
+func (c *Context) SentTo(dest plugin.VertexID) []plugin.Message {
+	var msgs []plugin.Message
+	for _, s := range c.Sent {
+		if s.Dest == dest {
+			msgs = append(msgs, s.Message)
+		}
+	}
+	return msgs
+}
+
+// AssertSent fails unless exactly the messages are sent to dest in order
+func (c *Context) AssertSent(t testing.TB, dest plugin.VertexID, msgs ...plugin.Message) {
+	t.Helper()
+	if actual := c.SentTo(dest); !equalMessages(msgs, actual) {
+		t.Errorf("unexpected messages sent to %v: expected=%v actual=%v", dest, msgs, actual)
+	}
+}
+
+// AssertNoMessages fails if any message is sent
+func (c *Context) AssertNoMessages(t testing.TB) {
+	t.Helper()
+	if len(c.Sent) > 0 {
+		t.Errorf("no messages expected but sent: %v", c.Sent)
+	}
+}
+
+// AssertHalted fails unless VoteToHalt() has been called
+func (c *Context) AssertHalted(t testing.TB) {
+	t.Helper()
+	if !c.Halted {
+		t.Error("vertex is expected to vote to halt")
+	}
+}
+
+// AssertNotHalted fails if VoteToHalt() has been called
+func (c *Context) AssertNotHalted(t testing.TB) {
+	t.Helper()
+	if c.Halted {
+		t.Error("vertex is not expected to vote to halt")
+	}
+}
+
+// AssertAggregatable fails unless exactly the values are put to the aggregator in order
+func (c *Context) AssertAggregatable(t testing.TB, name string, values ...plugin.AggregatableValue) {
+	t.Helper()
+	actual := c.Aggregatables[name]
+	if len(values) != len(actual) || (len(values) > 0 && !reflect.DeepEqual(values, actual)) {
+		t.Errorf("unexpected values put to %s: expected=%v actual=%v", name, values, actual)
+	}
+}
+
+func equalMessages(expected, actual []plugin.Message) bool {
+	if len(expected) != len(actual) {
+		return false
+	}
+	for i := range expected {
+		if !reflect.DeepEqual(expected[i], actual[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s SentMessage) String() string {
+	return fmt.Sprintf("%v<-%v", s.Dest, s.Message)
+}
+
+type edgeIterator struct {
+	edges []plugin.OutEdge
+	i     int
+}
+
+func (it *edgeIterator) Next() bool {
+	if it.i+1 >= len(it.edges) {
+		return false
+	}
+	it.i++
+	return true
+}
+
+func (it *edgeIterator) Dest() plugin.VertexID {
+	return it.edges[it.i].Dest
+}
+
+func (it *edgeIterator) DestNumeric() uint64 {
+	n, _ := plugin.ParseNumericID(it.edges[it.i].Dest)
+	return n
+}
+
+func (it *edgeIterator) Uint32() uint32 {
+	return it.edges[it.i].Uint32
+}
+
+func (it *edgeIterator) Float64() float64 {
+	return it.edges[it.i].Float64
+}
+
+func (it *edgeIterator) Bytes() []byte {
+	return it.edges[it.i].Bytes
+}
@@ -0,0 +1,50 @@
+package plugintest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func TestContext(t *testing.T) {
+	v := &maxVertex{id: "a", value: 2, edges: []plugin.VertexID{"b", "c"}}
+	ctx := NewContext(1, uint32(5), uint32(1)).WithAggregated("sum", uint32(10))
+	if err := v.Compute(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.AssertNotHalted(t)
+	ctx.AssertSent(t, "b", uint32(5))
+	ctx.AssertSent(t, "c", uint32(5))
+	ctx.AssertAggregatable(t, "sum", uint32(5))
+	if agg, ok, _ := ctx.GetAggregated("sum"); !ok || agg != uint32(10) {
+		t.Errorf("unexpected aggregated: %v", agg)
+	}
+
+	ctx = NewContext(1, uint32(1))
+	if err := v.Compute(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx.AssertHalted(t)
+	ctx.AssertNoMessages(t)
+
+	ctx = NewContext(0)
+	ctx.SendError = errors.New("failed")
+	if err := v.Compute(ctx); err == nil {
+		t.Error("send error should be returned")
+	}
+}
+
+func TestContext_WithEdges(t *testing.T) {
+	ctx := NewContext(0).WithEdges(plugin.OutEdge{Dest: "1", Uint32: 3}, plugin.OutEdge{Dest: "2", Uint32: 4})
+	if ctx.NumOfOutEdges() != 2 {
+		t.Fatalf("unexpected number of edges: %d", ctx.NumOfOutEdges())
+	}
+	for it := plugin.OutEdgesOf(ctx); it.Next(); {
+		if err := plugin.SendMessageToNumeric(ctx, it.DestNumeric(), it.Uint32()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ctx.AssertSent(t, "1", uint32(3))
+	ctx.AssertSent(t, "2", uint32(4))
+}
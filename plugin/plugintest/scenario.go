@@ -0,0 +1,145 @@
+package plugintest
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// Scenario drives a small graph of vertices through supersteps synchronously without actors.
+// Vertices are computed in order of their IDs. As same as the engine, every vertex is computed in superstep 0
+// and after that only vertices which receive messages are computed. The scenario terminates when no messages are sent.
+type Scenario struct {
+	vertices    map[plugin.VertexID]plugin.Vertex
+	ids         []plugin.VertexID
+	aggregators map[string]plugin.Aggregator
+	superStep   uint64
+	inbox       map[plugin.VertexID][]plugin.Message
+	aggregated  map[string]plugin.AggregatableValue
+	done        bool
+
+	// Contexts are contexts used in the last superstep by vertex
+	Contexts map[plugin.VertexID]*Context
+}
+
+// NewScenario returns a scenario of the vertices. aggregators are used by PutAggregatable() and GetAggregated()
+func NewScenario(aggregators []plugin.Aggregator, vertices ...plugin.Vertex) (*Scenario, error) {
+	s := &Scenario{
+		vertices:    make(map[plugin.VertexID]plugin.Vertex),
+		aggregators: make(map[string]plugin.Aggregator),
+		inbox:       make(map[plugin.VertexID][]plugin.Message),
+		aggregated:  make(map[string]plugin.AggregatableValue),
+	}
+	for _, v := range vertices {
+		if _, ok := s.vertices[v.GetID()]; ok {
+			return nil, fmt.Errorf("duplicate vertex: %v", v.GetID())
+		}
+		s.vertices[v.GetID()] = v
+		s.ids = append(s.ids, v.GetID())
+	}
+	sort.Slice(s.ids, func(i, j int) bool { return s.ids[i] < s.ids[j] })
+	for _, a := range aggregators {
+		if _, ok := s.aggregators[a.Name()]; ok {
+			return nil, fmt.Errorf("duplicate aggregator: %v", a.Name())
+		}
+		s.aggregators[a.Name()] = a
+	}
+	return s, nil
+}
+
+// SuperStep returns the number of the next superstep
+func (s *Scenario) SuperStep() uint64 {
+	return s.superStep
+}
+
+// Done returns true if the scenario has terminated
+func (s *Scenario) Done() bool {
+	return s.done
+}
+
+// Vertex returns the vertex
+func (s *Scenario) Vertex(id plugin.VertexID) plugin.Vertex {
+	return s.vertices[id]
+}
+
+// Aggregated returns values aggregated in the last superstep
+func (s *Scenario) Aggregated() map[string]plugin.AggregatableValue {
+	return s.aggregated
+}
+
+// Step runs a superstep. It returns true if the scenario has terminated
+func (s *Scenario) Step() (bool, error) {
+	if s.done {
+		return true, nil
+	}
+
+	contexts := make(map[plugin.VertexID]*Context)
+	for _, id := range s.ids {
+		messages, ok := s.inbox[id]
+		if s.superStep > 0 && !ok {
+			continue
+		}
+		ctx := NewContext(s.superStep, messages...)
+		for name, v := range s.aggregated {
+			ctx.WithAggregated(name, v)
+		}
+		if err := s.vertices[id].Compute(ctx); err != nil {
+			return false, errors.Wrapf(err, "failed to compute vertex %v at superstep %d", id, s.superStep)
+		}
+		contexts[id] = ctx
+	}
+
+	inbox := make(map[plugin.VertexID][]plugin.Message)
+	aggregated := make(map[string]plugin.AggregatableValue)
+	for _, id := range s.ids {
+		ctx, ok := contexts[id]
+		if !ok {
+			continue
+		}
+		for _, m := range ctx.Sent {
+			if _, ok := s.vertices[m.Dest]; !ok {
+				return false, fmt.Errorf("message sent to unknown vertex: %v -> %v", id, m.Dest)
+			}
+			inbox[m.Dest] = append(inbox[m.Dest], m.Message)
+		}
+		for name, values := range ctx.Aggregatables {
+			a, ok := s.aggregators[name]
+			if !ok {
+				return false, fmt.Errorf("no such aggregator: %v", name)
+			}
+			for _, v := range values {
+				if current, ok := aggregated[name]; ok {
+					agg, err := a.Aggregate(v, current)
+					if err != nil {
+						return false, errors.Wrapf(err, "failed to aggregate %s", name)
+					}
+					v = agg
+				}
+				aggregated[name] = v
+			}
+		}
+	}
+
+	s.Contexts = contexts
+	s.inbox = inbox
+	s.aggregated = aggregated
+	s.superStep++
+	s.done = len(inbox) == 0
+	return s.done, nil
+}
+
+// Run runs supersteps until the scenario terminates or maxSuperSteps supersteps have been run
+func (s *Scenario) Run(maxSuperSteps uint64) error {
+	for i := uint64(0); i < maxSuperSteps; i++ {
+		done, err := s.Step()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+	}
+	return fmt.Errorf("not terminated in %d supersteps", maxSuperSteps)
+}
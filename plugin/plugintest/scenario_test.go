@@ -0,0 +1,120 @@
+package plugintest
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// maxVertex propagates the maximum value to its edges
+type maxVertex struct {
+	id    plugin.VertexID
+	value uint32
+	edges []plugin.VertexID
+}
+
+func (v *maxVertex) Compute(ctx plugin.ComputeContext) error {
+	changed := ctx.SuperStep() == 0
+	for _, m := range ctx.ReceivedMessages() {
+		if n := m.(uint32); n > v.value {
+			v.value = n
+			changed = true
+		}
+	}
+	if err := ctx.PutAggregatable("sum", v.value); err != nil {
+		return err
+	}
+	if !changed {
+		ctx.VoteToHalt()
+		return nil
+	}
+	for _, e := range v.edges {
+		if err := ctx.SendMessageTo(e, v.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *maxVertex) GetID() plugin.VertexID { return v.id }
+
+func (v *maxVertex) GetValueAsString() string { return strconv.FormatUint(uint64(v.value), 10) }
+
+func TestScenario_Run(t *testing.T) {
+	s, err := NewScenario([]plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")},
+		&maxVertex{id: "a", value: 3, edges: []plugin.VertexID{"b"}},
+		&maxVertex{id: "b", value: 6, edges: []plugin.VertexID{"a", "c"}},
+		&maxVertex{id: "c", value: 1, edges: []plugin.VertexID{"b"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// step 0: every vertex is computed
+	if done, err := s.Step(); err != nil || done {
+		t.Fatalf("unexpected step: done=%v err=%v", done, err)
+	}
+	s.Contexts["a"].AssertSent(t, "b", uint32(3))
+	s.Contexts["b"].AssertSent(t, "a", uint32(6))
+	s.Contexts["b"].AssertSent(t, "c", uint32(6))
+	if diff := cmp.Diff(map[string]plugin.AggregatableValue{"sum": uint32(10)}, s.Aggregated()); diff != "" {
+		t.Fatalf("unexpected aggregated: %s", diff)
+	}
+
+	// step 1: b is halted as receiving smaller values
+	if done, err := s.Step(); err != nil || done {
+		t.Fatalf("unexpected step: done=%v err=%v", done, err)
+	}
+	s.Contexts["b"].AssertHalted(t)
+	s.Contexts["b"].AssertNoMessages(t)
+	s.Contexts["c"].AssertSent(t, "b", uint32(6))
+
+	if err := s.Run(10); err != nil {
+		t.Fatal(err)
+	}
+	if !s.Done() || s.SuperStep() != 3 {
+		t.Fatalf("unexpected termination: done=%v step=%v", s.Done(), s.SuperStep())
+	}
+	for _, id := range []plugin.VertexID{"a", "b", "c"} {
+		if v := s.Vertex(id).GetValueAsString(); v != "6" {
+			t.Errorf("unexpected value of %v: %v", id, v)
+		}
+	}
+}
+
+func TestScenario_errors(t *testing.T) {
+	if _, err := NewScenario(nil, &maxVertex{id: "a"}, &maxVertex{id: "a"}); err == nil {
+		t.Error("duplicate vertex should be rejected")
+	}
+
+	s, err := NewScenario(nil, &maxVertex{id: "a", edges: []plugin.VertexID{"unknown"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Step(); err == nil {
+		t.Error("unknown aggregator should be rejected")
+	}
+
+	s, err = NewScenario([]plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")},
+		&maxVertex{id: "a", edges: []plugin.VertexID{"unknown"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Step(); err == nil {
+		t.Error("message to unknown vertex should be rejected")
+	}
+
+	s, err = NewScenario([]plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")},
+		&maxVertex{id: "a", edges: []plugin.VertexID{"b"}},
+		&maxVertex{id: "b", value: 1, edges: []plugin.VertexID{"a"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Run(1); err == nil {
+		t.Error("scenario should not be terminated in 1 step")
+	}
+}
@@ -0,0 +1,58 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Registry holds plugins registered under names so that one binary can host many algorithms
+type Registry struct {
+	names   []string
+	plugins map[string]Plugin
+}
+
+// NewRegistry returns a new empty registry
+func NewRegistry() *Registry {
+	return &Registry{
+		plugins: make(map[string]Plugin),
+	}
+}
+
+// Register registers plugin under the name. The first registered plugin is active by default.
+func (r *Registry) Register(name string, p Plugin) error {
+	if name == "" {
+		return errors.New("empty plugin name")
+	}
+	if p == nil {
+		return fmt.Errorf("%s: nil plugin", name)
+	}
+	if _, ok := r.plugins[name]; ok {
+		return fmt.Errorf("%s: plugin has already been registered", name)
+	}
+	r.plugins[name] = p
+	r.names = append(r.names, name)
+	return nil
+}
+
+// Get returns plugin registered under the name
+func (r *Registry) Get(name string) (Plugin, bool) {
+	p, ok := r.plugins[name]
+	return p, ok
+}
+
+// Default returns name of the plugin registered first
+func (r *Registry) Default() string {
+	if len(r.names) == 0 {
+		return ""
+	}
+	return r.names[0]
+}
+
+// Names returns sorted names of registered plugins
+func (r *Registry) Names() []string {
+	names := make([]string, len(r.names))
+	copy(names, r.names)
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,293 @@
+package validation
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+const (
+	// CheckMessageCodec is a check of MarshalMessage() and UnmarshalMessage() round trip
+	CheckMessageCodec = "message-codec"
+	// CheckAggregatorCodec is a check of MarshalValue() and UnmarshalValue() round trip
+	CheckAggregatorCodec = "aggregator-codec"
+	// CheckAggregatorAssociativity is a check of Aggregate() associativity
+	CheckAggregatorAssociativity = "aggregator-associativity"
+	// CheckAggregatorCommutativity is a check of Aggregate() commutativity
+	CheckAggregatorCommutativity = "aggregator-commutativity"
+	// CheckCombinerOrder is a check that combiner returns the same result regardless of order of messages
+	CheckCombinerOrder = "combiner-order"
+	// CheckCombinerIdempotence is a check that combining combined messages changes nothing
+	CheckCombinerIdempotence = "combiner-idempotence"
+	// CheckCombinerDrop is a check that combiner doesn't drop all messages
+	CheckCombinerDrop = "combiner-drop"
+	// CheckPartitionDeterminism is a check that Partition() returns the same partition for the same vertex
+	CheckPartitionDeterminism = "partition-determinism"
+	// CheckPartitionRange is a check that Partition() returns value less than number of partitions
+	CheckPartitionRange = "partition-range"
+	// CheckNumericPartition is a check that PartitionNumeric() returns the same partition as Partition() for the formatted ID
+	CheckNumericPartition = "numeric-partition"
+)
+
+// Violation is a rule broken by the plugin
+type Violation struct {
+	Check  string
+	Detail string
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("%s: %s", v.Check, v.Detail)
+}
+
+// Options is options of validation
+type Options struct {
+	// Seed is seed of random sample values
+	Seed int64
+	// Samples is number of samples for each check, 20 by default
+	Samples int
+	// NewMessage generates a sample message. Message checks are skipped if nil
+	NewMessage func(r *rand.Rand) plugin.Message
+	// NewAggregatable generates a sample value by aggregator name. Aggregators which have no generator are skipped
+	NewAggregatable map[string]func(r *rand.Rand) plugin.AggregatableValue
+	// VertexIDs are vertices used for partition checks in addition to generated IDs.
+	// Partition() may fail for generated IDs as they may be unknown to the plugin, it's a violation only for VertexIDs
+	VertexIDs []plugin.VertexID
+	// NumOfPartitions are numbers of partitions used for partition checks, {1, 2, 7, 64} by default
+	NumOfPartitions []uint64
+	// NumericVertexIDs generates integer vertex IDs formatted by plugin.VertexIDOf() for partition checks
+	NumericVertexIDs bool
+}
+
+// Validate exercises the plugin with sample values then returns violations
+func Validate(plg plugin.Plugin, opts *Options) []Violation {
+	if opts == nil {
+		opts = &Options{}
+	}
+	samples := opts.Samples
+	if samples <= 0 {
+		samples = 20
+	}
+	r := rand.New(rand.NewSource(opts.Seed))
+
+	var violations []Violation
+	if opts.NewMessage != nil {
+		violations = append(violations, checkMessageCodec(plg, r, opts.NewMessage, samples)...)
+		if combiner := plg.GetCombiner(); combiner != nil {
+			violations = append(violations, checkCombiner(combiner, r, opts.NewMessage, samples)...)
+		}
+	}
+	for _, agg := range plg.GetAggregators() {
+		gen, ok := opts.NewAggregatable[agg.Name()]
+		if !ok {
+			continue
+		}
+		violations = append(violations, checkAggregator(agg, r, gen, samples)...)
+	}
+	violations = append(violations, checkPartition(plg, r, opts.VertexIDs, opts.NumOfPartitions, opts.NumericVertexIDs, samples)...)
+	if np, ok := plg.(plugin.NumericPartitioner); ok {
+		violations = append(violations, checkNumericPartition(plg, np, r, opts.NumOfPartitions, samples)...)
+	}
+	return violations
+}
+
+func checkMessageCodec(plg plugin.Plugin, r *rand.Rand, gen func(r *rand.Rand) plugin.Message, samples int) []Violation {
+	var violations []Violation
+	for i := 0; i < samples; i++ {
+		m := gen(r)
+		pb, err := plg.MarshalMessage(m)
+		if err != nil {
+			violations = append(violations, Violation{CheckMessageCodec, fmt.Sprintf("failed to marshal %#v: %v", m, err)})
+			continue
+		}
+		m2, err := plg.UnmarshalMessage(pb)
+		if err != nil {
+			violations = append(violations, Violation{CheckMessageCodec, fmt.Sprintf("failed to unmarshal %#v: %v", m, err)})
+			continue
+		}
+		if !equal(m, m2) {
+			violations = append(violations, Violation{CheckMessageCodec, fmt.Sprintf("%#v turned into %#v", m, m2)})
+		}
+	}
+	return violations
+}
+
+func checkAggregator(agg plugin.Aggregator, r *rand.Rand, gen func(r *rand.Rand) plugin.AggregatableValue, samples int) []Violation {
+	var violations []Violation
+	add := func(check string, format string, args ...interface{}) {
+		violations = append(violations, Violation{check, agg.Name() + ": " + fmt.Sprintf(format, args...)})
+	}
+	for i := 0; i < samples; i++ {
+		a, b, c := gen(r), gen(r), gen(r)
+
+		pb, err := agg.MarshalValue(a)
+		if err != nil {
+			add(CheckAggregatorCodec, "failed to marshal %#v: %v", a, err)
+		} else if a2, err := agg.UnmarshalValue(pb); err != nil {
+			add(CheckAggregatorCodec, "failed to unmarshal %#v: %v", a, err)
+		} else if !equal(a, a2) {
+			add(CheckAggregatorCodec, "%#v turned into %#v", a, a2)
+		}
+
+		ab, err1 := agg.Aggregate(a, b)
+		ba, err2 := agg.Aggregate(b, a)
+		if err1 != nil || err2 != nil {
+			add(CheckAggregatorCommutativity, "failed to aggregate %#v and %#v: %v %v", a, b, err1, err2)
+			continue
+		}
+		if !equal(ab, ba) {
+			add(CheckAggregatorCommutativity, "(%#v, %#v)=%#v but (%#v, %#v)=%#v", a, b, ab, b, a, ba)
+		}
+
+		abc, err1 := agg.Aggregate(ab, c)
+		bc, err2 := agg.Aggregate(b, c)
+		if err1 != nil || err2 != nil {
+			add(CheckAggregatorAssociativity, "failed to aggregate %#v, %#v and %#v: %v %v", a, b, c, err1, err2)
+			continue
+		}
+		abc2, err := agg.Aggregate(a, bc)
+		if err != nil {
+			add(CheckAggregatorAssociativity, "failed to aggregate %#v and %#v: %v", a, bc, err)
+			continue
+		}
+		if !equal(abc, abc2) {
+			add(CheckAggregatorAssociativity, "((%#v, %#v), %#v)=%#v but (%#v, (%#v, %#v))=%#v", a, b, c, abc, a, b, c, abc2)
+		}
+	}
+	return violations
+}
+
+func checkCombiner(combiner func(plugin.VertexID, []plugin.Message) ([]plugin.Message, error), r *rand.Rand, gen func(r *rand.Rand) plugin.Message, samples int) []Violation {
+	const dest = plugin.VertexID("validation-dest")
+	var violations []Violation
+	add := func(check string, format string, args ...interface{}) {
+		violations = append(violations, Violation{check, fmt.Sprintf(format, args...)})
+	}
+	for i := 0; i < samples; i++ {
+		messages := make([]plugin.Message, 2+r.Intn(6))
+		for j := range messages {
+			messages[j] = gen(r)
+		}
+
+		combined, err := combiner(dest, messages)
+		if err != nil {
+			add(CheckCombinerOrder, "failed to combine %#v: %v", messages, err)
+			continue
+		}
+		if len(combined) == 0 {
+			add(CheckCombinerDrop, "all messages are dropped: %#v", messages)
+			continue
+		}
+
+		shuffled := make([]plugin.Message, len(messages))
+		copy(shuffled, messages)
+		r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		combinedShuffled, err := combiner(dest, shuffled)
+		if err != nil {
+			add(CheckCombinerOrder, "failed to combine %#v: %v", shuffled, err)
+		} else if !equalMessages(combined, combinedShuffled) {
+			add(CheckCombinerOrder, "%#v is combined into %#v but %#v is combined into %#v", messages, combined, shuffled, combinedShuffled)
+		}
+
+		again, err := combiner(dest, combined)
+		if err != nil {
+			add(CheckCombinerIdempotence, "failed to combine %#v: %v", combined, err)
+		} else if !equalMessages(combined, again) {
+			add(CheckCombinerIdempotence, "%#v is combined into %#v again", combined, again)
+		}
+	}
+	return violations
+}
+
+func checkPartition(plg plugin.Plugin, r *rand.Rand, ids []plugin.VertexID, partitions []uint64, numeric bool, samples int) []Violation {
+	partitions = defaultPartitions(partitions)
+	vertices := append([]plugin.VertexID{}, ids...)
+	for i := 0; i < samples; i++ {
+		if numeric {
+			vertices = append(vertices, plugin.VertexIDOf(r.Uint64()))
+		} else {
+			vertices = append(vertices, plugin.VertexID(fmt.Sprintf("v%d", r.Int63())))
+		}
+	}
+
+	var violations []Violation
+	for _, n := range partitions {
+		for i, id := range vertices {
+			p1, err := plg.Partition(id, n)
+			if err != nil && i >= len(ids) {
+				// e.g. a range or mapping partitioner knows only vertices of the graph
+				continue
+			}
+			if err != nil {
+				violations = append(violations, Violation{CheckPartitionRange, fmt.Sprintf("failed to Partition(%v, %d): %v", id, n, err)})
+				continue
+			}
+			if p1 >= n {
+				violations = append(violations, Violation{CheckPartitionRange, fmt.Sprintf("Partition(%v, %d)=%d", id, n, p1)})
+			}
+			if p2, err := plg.Partition(id, n); err != nil || p1 != p2 {
+				violations = append(violations, Violation{CheckPartitionDeterminism, fmt.Sprintf("Partition(%v, %d) returns %d then %d(%v)", id, n, p1, p2, err)})
+			}
+		}
+	}
+	return violations
+}
+
+func checkNumericPartition(plg plugin.Plugin, np plugin.NumericPartitioner, r *rand.Rand, partitions []uint64, samples int) []Violation {
+	ids := []uint64{0, 1}
+	for i := 0; i < samples; i++ {
+		ids = append(ids, r.Uint64())
+	}
+
+	var violations []Violation
+	for _, n := range defaultPartitions(partitions) {
+		for _, id := range ids {
+			p1, err1 := np.PartitionNumeric(id, n)
+			p2, err2 := plg.Partition(plugin.VertexIDOf(id), n)
+			if (err1 == nil) != (err2 == nil) || p1 != p2 {
+				violations = append(violations, Violation{CheckNumericPartition, fmt.Sprintf("PartitionNumeric(%d, %d)=%d(%v) but Partition()=%d(%v)", id, n, p1, err1, p2, err2)})
+			}
+		}
+	}
+	return violations
+}
+
+func defaultPartitions(partitions []uint64) []uint64 {
+	if len(partitions) == 0 {
+		return []uint64{1, 2, 7, 64}
+	}
+	return partitions
+}
+
+func equal(a, b interface{}) bool {
+	pa, ok1 := a.(proto.Message)
+	pb, ok2 := b.(proto.Message)
+	if ok1 && ok2 {
+		return proto.Equal(pa, pb)
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// equalMessages compares messages regardless of order
+func equalMessages(a, b []plugin.Message) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	used := make([]bool, len(b))
+	for _, m := range a {
+		found := false
+		for j, m2 := range b {
+			if !used[j] && equal(m, m2) {
+				used[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
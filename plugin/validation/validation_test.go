@@ -0,0 +1,231 @@
+package validation
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+type testPlugin struct {
+	marshal     func(msg plugin.Message) (*types.Any, error)
+	combiner    func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error)
+	aggregators []plugin.Aggregator
+	partition   func(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error)
+}
+
+func (p *testPlugin) NewVertex(id plugin.VertexID) (plugin.Vertex, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *testPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	return nil
+}
+
+func (p *testPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	if p.partition != nil {
+		return p.partition(vertex, numOfPartitions)
+	}
+	return plugin.HashPartition(vertex, numOfPartitions)
+}
+
+// numericPlugin partitions vertices by integer IDs
+type numericPlugin struct {
+	testPlugin
+	broken bool
+}
+
+func (p *numericPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	id, err := plugin.ParseNumericID(vertex)
+	if err != nil {
+		return plugin.HashPartition(vertex, numOfPartitions)
+	}
+	return plugin.HashPartitionNumeric(id, numOfPartitions)
+}
+
+func (p *numericPlugin) PartitionNumeric(vertex uint64, numOfPartitions uint64) (uint64, error) {
+	if p.broken {
+		return plugin.HashPartition(plugin.VertexIDOf(vertex), numOfPartitions)
+	}
+	return plugin.HashPartitionNumeric(vertex, numOfPartitions)
+}
+
+func (p *testPlugin) MarshalMessage(msg plugin.Message) (*types.Any, error) {
+	if p.marshal != nil {
+		return p.marshal(msg)
+	}
+	return plugin.ConvertUint32ToAny(msg)
+}
+
+func (p *testPlugin) UnmarshalMessage(pb *types.Any) (plugin.Message, error) {
+	return plugin.ConvertAnyToUint32(pb)
+}
+
+func (p *testPlugin) GetCombiner() func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+	return p.combiner
+}
+
+func (p *testPlugin) GetAggregators() []plugin.Aggregator {
+	return p.aggregators
+}
+
+// subAggregator is neither associative nor commutative
+type subAggregator struct {
+	*aggregator.SumUint32Aggregator
+}
+
+func (a *subAggregator) Aggregate(v1 plugin.AggregatableValue, v2 plugin.AggregatableValue) (plugin.AggregatableValue, error) {
+	return v1.(uint32) - v2.(uint32), nil
+}
+
+func minCombiner(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+	min := messages[0].(uint32)
+	for _, m := range messages {
+		if n := m.(uint32); n < min {
+			min = n
+		}
+	}
+	return []plugin.Message{min}, nil
+}
+
+func genUint32(r *rand.Rand) plugin.Message {
+	return uint32(r.Intn(1000))
+}
+
+func genAggregatable(r *rand.Rand) plugin.AggregatableValue {
+	return uint32(r.Intn(1000))
+}
+
+func checks(violations []Violation) map[string]bool {
+	m := make(map[string]bool)
+	for _, v := range violations {
+		m[v.Check] = true
+	}
+	return m
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		plugin plugin.Plugin
+		want   []string
+	}{
+		{
+			name: "valid",
+			plugin: &testPlugin{
+				combiner:    minCombiner,
+				aggregators: []plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")},
+			},
+		},
+		{
+			name: "broken codec",
+			plugin: &testPlugin{
+				marshal: func(msg plugin.Message) (*types.Any, error) {
+					return plugin.ConvertUint32ToAny(msg.(uint32) + 1)
+				},
+			},
+			want: []string{CheckMessageCodec},
+		},
+		{
+			name: "broken aggregator",
+			plugin: &testPlugin{
+				aggregators: []plugin.Aggregator{&subAggregator{aggregator.NewSumUint32Aggregator("sum")}},
+			},
+			want: []string{CheckAggregatorAssociativity, CheckAggregatorCommutativity},
+		},
+		{
+			name: "combiner depending on order",
+			plugin: &testPlugin{
+				combiner: func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+					return messages[:1], nil
+				},
+			},
+			want: []string{CheckCombinerOrder},
+		},
+		{
+			name: "combiner dropping messages",
+			plugin: &testPlugin{
+				combiner: func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+					return nil, nil
+				},
+			},
+			want: []string{CheckCombinerDrop},
+		},
+		{
+			name: "combiner which is not idempotent",
+			plugin: &testPlugin{
+				combiner: func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+					var sum uint32
+					for _, m := range messages {
+						sum += m.(uint32)
+					}
+					return []plugin.Message{sum, uint32(1)}, nil
+				},
+			},
+			want: []string{CheckCombinerIdempotence},
+		},
+		{
+			name: "broken partition",
+			plugin: &testPlugin{
+				partition: func(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+					return uint64(rand.Intn(100)), nil
+				},
+			},
+			want: []string{CheckPartitionDeterminism, CheckPartitionRange},
+		},
+		{
+			name: "partition fails for unknown vertices",
+			plugin: &testPlugin{
+				partition: func(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+					return 0, errors.New("unknown vertex")
+				},
+			},
+			want: nil,
+		},
+		{
+			name:   "numeric partition",
+			plugin: &numericPlugin{},
+			want:   nil,
+		},
+		{
+			name:   "numeric partition disagrees",
+			plugin: &numericPlugin{broken: true},
+			want:   []string{CheckNumericPartition},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := Validate(tt.plugin, &Options{
+				NewMessage:      genUint32,
+				NewAggregatable: map[string]func(r *rand.Rand) plugin.AggregatableValue{"sum": genAggregatable},
+			})
+			got := checks(violations)
+			if len(got) != len(tt.want) {
+				t.Errorf("unexpected violations: %v", violations)
+			}
+			for _, c := range tt.want {
+				if !got[c] {
+					t.Errorf("%s is expected: %v", c, violations)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_partitionFailsForVertexIDs(t *testing.T) {
+	plg := &testPlugin{
+		partition: func(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+			if vertex == "broken" {
+				return 0, errors.New("unknown vertex")
+			}
+			return 0, nil
+		},
+	}
+	violations := Validate(plg, &Options{VertexIDs: []plugin.VertexID{"a", "broken"}, NumOfPartitions: []uint64{2}})
+	if len(violations) != 1 || violations[0].Check != CheckPartitionRange {
+		t.Fatalf("unexpected violations: %v", violations)
+	}
+}
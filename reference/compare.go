@@ -0,0 +1,58 @@
+package reference
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/rerorero/prerogel/worker"
+)
+
+// Difference is a vertex whose final value differs between the reference executor and the engine
+type Difference struct {
+	VertexID plugin.VertexID
+	// Reference is the value computed by the reference executor, empty if the vertex doesn't exist
+	Reference string
+	// Engine is the value computed by the engine, empty if the vertex doesn't exist
+	Engine string
+}
+
+// Compare runs the plugin with both the reference executor and the in-process engine, then returns differences of final vertex values.
+// The plugin has to load new vertices every time NewPartitionVertices() is called.
+func Compare(ctx context.Context, plg plugin.Plugin, opts *worker.JobOptions) ([]Difference, error) {
+	if opts == nil {
+		opts = &worker.JobOptions{}
+	}
+	nrOfPartitions := opts.NumOfPartitions
+	if nrOfPartitions == 0 && opts.NumOfWorkers > 0 {
+		nrOfPartitions = uint64(opts.NumOfWorkers)
+	}
+
+	ref, err := Run(plg, &Options{NumOfPartitions: nrOfPartitions})
+	if err != nil {
+		return nil, errors.Wrap(err, "reference executor failed")
+	}
+	eng, err := worker.RunJob(ctx, plg, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "engine failed")
+	}
+
+	return diffValues(ref.VertexValues, eng.VertexValues), nil
+}
+
+func diffValues(ref, eng map[plugin.VertexID]string) []Difference {
+	var diffs []Difference
+	for id, v := range ref {
+		if e, ok := eng[id]; !ok || e != v {
+			diffs = append(diffs, Difference{VertexID: id, Reference: v, Engine: e})
+		}
+	}
+	for id, e := range eng {
+		if _, ok := ref[id]; !ok {
+			diffs = append(diffs, Difference{VertexID: id, Engine: e})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool { return diffs[i].VertexID < diffs[j].VertexID })
+	return diffs
+}
@@ -0,0 +1,52 @@
+package reference
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/rerorero/prerogel/worker"
+)
+
+func TestCompare(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	diffs, err := Compare(ctx, &maxPlugin{size: 5, combiner: true}, &worker.JobOptions{NumOfWorkers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) > 0 {
+		t.Errorf("unexpected differences: %v", diffs)
+	}
+}
+
+func Test_diffValues(t *testing.T) {
+	diffs := diffValues(
+		map[plugin.VertexID]string{"a": "1", "b": "2", "c": "3"},
+		map[plugin.VertexID]string{"a": "1", "b": "5", "d": "4"},
+	)
+	want := []Difference{
+		{VertexID: "b", Reference: "2", Engine: "5"},
+		{VertexID: "c", Reference: "3"},
+		{VertexID: "d", Engine: "4"},
+	}
+	if diff := cmp.Diff(want, diffs); diff != "" {
+		t.Errorf("unexpected differences: %s", diff)
+	}
+}
+
+func TestCompare_notHaltedWithoutMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	diffs, err := Compare(ctx, &countPlugin{}, &worker.JobOptions{NumOfWorkers: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diffs) > 0 {
+		t.Errorf("unexpected differences: %v", diffs)
+	}
+}
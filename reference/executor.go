@@ -0,0 +1,251 @@
+package reference
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/rerorero/prerogel/worker"
+)
+
+// Options is options of the reference executor
+type Options struct {
+	// NumOfPartitions is number of partitions passed to NewPartitionVertices(), 1 by default
+	NumOfPartitions uint64
+	// MaxSuperSteps aborts the execution if it doesn't terminate, 10000 by default
+	MaxSuperSteps uint64
+}
+
+// executor runs a plugin sequentially by the same semantics as the engine.
+// Vertices are computed in order of their IDs and messages are delivered in order of sender IDs, then sent order.
+// As the engine does, every vertex is computed in superstep 0, and only vertices receiving messages are computed afterwards
+// even if they haven't voted to halt.
+type executor struct {
+	plugin      plugin.Plugin
+	aggregators map[string]plugin.Aggregator
+	ids         []plugin.VertexID
+	vertices    map[plugin.VertexID]plugin.Vertex
+	halted      map[plugin.VertexID]bool
+	inbox       map[plugin.VertexID][]plugin.Message
+	aggregated  map[string]plugin.AggregatableValue
+	superStep   uint64
+}
+
+type computeContext struct {
+	exec          *executor
+	messages      []plugin.Message
+	outbox        map[plugin.VertexID][]plugin.Message
+	aggregatables map[string]plugin.AggregatableValue
+	halted        bool
+	sent          uint64
+}
+
+func (c *computeContext) SuperStep() uint64 {
+	return c.exec.superStep
+}
+
+func (c *computeContext) ReceivedMessages() []plugin.Message {
+	return c.messages
+}
+
+func (c *computeContext) SendMessageTo(dest plugin.VertexID, m plugin.Message) error {
+	if _, ok := c.exec.vertices[dest]; !ok {
+		return fmt.Errorf("no such vertex: %v", dest)
+	}
+	// round trip as same as the engine
+	pb, err := c.exec.plugin.MarshalMessage(m)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal message: %#v", m)
+	}
+	msg, err := c.exec.plugin.UnmarshalMessage(pb)
+	if err != nil {
+		return errors.Wrapf(err, "failed to unmarshal message: %#v", pb)
+	}
+	c.outbox[dest] = append(c.outbox[dest], msg)
+	c.sent++
+	return nil
+}
+
+func (c *computeContext) VoteToHalt() {
+	c.halted = true
+}
+
+func (c *computeContext) GetAggregated(aggregatorName string) (plugin.AggregatableValue, bool, error) {
+	if _, ok := c.exec.aggregators[aggregatorName]; !ok {
+		return nil, false, fmt.Errorf("%s: no such aggregator", aggregatorName)
+	}
+	v, ok := c.exec.aggregated[aggregatorName]
+	return v, ok, nil
+}
+
+func (c *computeContext) PutAggregatable(aggregatorName string, v plugin.AggregatableValue) error {
+	agg, ok := c.exec.aggregators[aggregatorName]
+	if !ok {
+		return fmt.Errorf("%s: no such aggregator", aggregatorName)
+	}
+	if current, ok := c.aggregatables[aggregatorName]; ok {
+		val, err := agg.Aggregate(v, current)
+		if err != nil {
+			return errors.Wrap(err, "failed to Aggregate()")
+		}
+		v = val
+	}
+	c.aggregatables[aggregatorName] = v
+	return nil
+}
+
+// Run loads vertices via the plugin then runs supersteps sequentially until all vertices are halted and no messages are in transit.
+func Run(plg plugin.Plugin, opts *Options) (*worker.JobResult, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	nrOfPartitions := opts.NumOfPartitions
+	if nrOfPartitions == 0 {
+		nrOfPartitions = 1
+	}
+	maxSteps := opts.MaxSuperSteps
+	if maxSteps == 0 {
+		maxSteps = 10000
+	}
+
+	exec := &executor{
+		plugin:      plg,
+		aggregators: make(map[string]plugin.Aggregator),
+		vertices:    make(map[plugin.VertexID]plugin.Vertex),
+		halted:      make(map[plugin.VertexID]bool),
+		inbox:       make(map[plugin.VertexID][]plugin.Message),
+		aggregated:  make(map[string]plugin.AggregatableValue),
+	}
+	for _, agg := range plg.GetAggregators() {
+		if _, ok := exec.aggregators[agg.Name()]; ok {
+			return nil, fmt.Errorf("duplicate aggregator: %s", agg.Name())
+		}
+		exec.aggregators[agg.Name()] = agg
+	}
+	if err := exec.load(nrOfPartitions); err != nil {
+		return nil, err
+	}
+
+	result := &worker.JobResult{
+		Vertices:     exec.vertices,
+		VertexValues: make(map[plugin.VertexID]string, len(exec.vertices)),
+	}
+	for !exec.terminated() {
+		if exec.superStep >= maxSteps {
+			return nil, fmt.Errorf("not terminated in %d supersteps", maxSteps)
+		}
+		stats, err := exec.step()
+		if err != nil {
+			return nil, err
+		}
+		result.SuperSteps = append(result.SuperSteps, stats)
+	}
+
+	for id, v := range exec.vertices {
+		result.VertexValues[id] = v.GetValueAsString()
+	}
+	result.AggregatedValues = exec.aggregated
+	return result, nil
+}
+
+func (exec *executor) load(nrOfPartitions uint64) error {
+	for p := uint64(0); p < nrOfPartitions; p++ {
+		var err error
+		if e := exec.plugin.NewPartitionVertices(p, nrOfPartitions, func(v plugin.Vertex) {
+			if _, ok := exec.vertices[v.GetID()]; ok {
+				err = fmt.Errorf("duplicate vertex: %v", v.GetID())
+				return
+			}
+			exec.vertices[v.GetID()] = v
+			exec.ids = append(exec.ids, v.GetID())
+		}); e != nil {
+			return errors.Wrapf(e, "failed to load partition %d", p)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	sort.Slice(exec.ids, func(i, j int) bool { return exec.ids[i] < exec.ids[j] })
+	return nil
+}
+
+func (exec *executor) terminated() bool {
+	if exec.superStep == 0 {
+		return false
+	}
+	for _, id := range exec.ids {
+		if !exec.halted[id] || len(exec.inbox[id]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (exec *executor) step() (*worker.SuperStepStats, error) {
+	stats := &worker.SuperStepStats{
+		SuperStep:     exec.superStep,
+		TotalVertices: uint64(len(exec.ids)),
+	}
+	outbox := make(map[plugin.VertexID][]plugin.Message)
+	aggregated := make(map[string]plugin.AggregatableValue)
+
+	for _, id := range exec.ids {
+		messages := exec.inbox[id]
+		// a vertex is computed only when it receives messages after superstep 0
+		if exec.superStep > 0 && len(messages) == 0 {
+			exec.halted[id] = true
+			continue
+		}
+		ctx := &computeContext{
+			exec:          exec,
+			messages:      messages,
+			outbox:        outbox,
+			aggregatables: make(map[string]plugin.AggregatableValue),
+		}
+		if err := exec.vertices[id].Compute(ctx); err != nil {
+			return nil, errors.Wrapf(err, "failed to compute vertex %v at superstep %d", id, exec.superStep)
+		}
+		exec.halted[id] = ctx.halted
+		if !ctx.halted {
+			stats.ActiveVertices++
+		}
+		stats.MessagesSent += ctx.sent
+
+		for _, name := range sortedNames(ctx.aggregatables) {
+			v := ctx.aggregatables[name]
+			if current, ok := aggregated[name]; ok {
+				val, err := exec.aggregators[name].Aggregate(v, current)
+				if err != nil {
+					return nil, errors.Wrap(err, "failed to Aggregate()")
+				}
+				v = val
+			}
+			aggregated[name] = v
+		}
+	}
+
+	if combiner := exec.plugin.GetCombiner(); combiner != nil {
+		for dest, messages := range outbox {
+			combined, err := combiner(dest, messages)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to combine messages to %v", dest)
+			}
+			outbox[dest] = combined
+		}
+	}
+
+	exec.inbox = outbox
+	exec.aggregated = aggregated
+	exec.superStep++
+	return stats, nil
+}
+
+func sortedNames(m map[string]plugin.AggregatableValue) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,220 @@
+package reference
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// maxVertex propagates the maximum value to its edges
+type maxVertex struct {
+	id       plugin.VertexID
+	value    uint32
+	edges    []plugin.VertexID
+	received []uint32
+}
+
+func (v *maxVertex) Compute(ctx plugin.ComputeContext) error {
+	changed := ctx.SuperStep() == 0
+	for _, m := range ctx.ReceivedMessages() {
+		n := m.(uint32)
+		v.received = append(v.received, n)
+		if n > v.value {
+			v.value = n
+			changed = true
+		}
+	}
+	ctx.VoteToHalt()
+	if err := ctx.PutAggregatable("sum", v.value); err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	for _, e := range v.edges {
+		if err := ctx.SendMessageTo(e, v.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *maxVertex) GetID() plugin.VertexID { return v.id }
+
+func (v *maxVertex) GetValueAsString() string { return strconv.FormatUint(uint64(v.value), 10) }
+
+// maxPlugin loads a complete graph where the value of vertex i is i
+type maxPlugin struct {
+	size     int
+	combiner bool
+	loaded   []*maxVertex
+}
+
+func (p *maxPlugin) NewVertex(id plugin.VertexID) (plugin.Vertex, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *maxPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	for i := 0; i < p.size; i++ {
+		id := plugin.VertexID(fmt.Sprintf("v%d", i))
+		if part, _ := p.Partition(id, numOfPartitions); part != partitionID {
+			continue
+		}
+		v := &maxVertex{id: id, value: uint32(i)}
+		for j := 0; j < p.size; j++ {
+			if i != j {
+				v.edges = append(v.edges, plugin.VertexID(fmt.Sprintf("v%d", j)))
+			}
+		}
+		p.loaded = append(p.loaded, v)
+		register(v)
+	}
+	return nil
+}
+
+func (p *maxPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	return plugin.HashPartition(vertex, numOfPartitions)
+}
+
+func (p *maxPlugin) MarshalMessage(msg plugin.Message) (*types.Any, error) {
+	return plugin.ConvertUint32ToAny(msg)
+}
+
+func (p *maxPlugin) UnmarshalMessage(pb *types.Any) (plugin.Message, error) {
+	return plugin.ConvertAnyToUint32(pb)
+}
+
+func (p *maxPlugin) GetCombiner() func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+	if !p.combiner {
+		return nil
+	}
+	return func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+		var max uint32
+		for _, m := range messages {
+			if n := m.(uint32); n > max {
+				max = n
+			}
+		}
+		return []plugin.Message{max}, nil
+	}
+}
+
+func (p *maxPlugin) GetAggregators() []plugin.Aggregator {
+	return []plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")}
+}
+
+func TestRun(t *testing.T) {
+	plg := &maxPlugin{size: 3}
+	res, err := Run(plg, &Options{NumOfPartitions: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[plugin.VertexID]string{"v0": "2", "v1": "2", "v2": "2"}, res.VertexValues); diff != "" {
+		t.Errorf("unexpected values: %s", diff)
+	}
+	// step 0: all send, step 1: v0 and v1 change and send, step 2: nothing changes
+	if len(res.SuperSteps) != 3 {
+		t.Fatalf("unexpected supersteps: %d", len(res.SuperSteps))
+	}
+	if s := res.SuperSteps[0]; s.MessagesSent != 6 || s.ActiveVertices != 0 || s.TotalVertices != 3 {
+		t.Errorf("unexpected stats: %#v", s)
+	}
+	if diff := cmp.Diff(map[string]plugin.AggregatableValue{"sum": uint32(6)}, res.AggregatedValues); diff != "" {
+		t.Errorf("unexpected aggregated: %s", diff)
+	}
+
+	// messages are delivered in order of sender IDs
+	received := make(map[plugin.VertexID][]uint32)
+	for _, v := range plg.loaded {
+		received[v.id] = v.received
+	}
+	want := map[plugin.VertexID][]uint32{
+		"v0": {1, 2, 2},
+		"v1": {0, 2, 2},
+		"v2": {0, 1, 2, 2},
+	}
+	if diff := cmp.Diff(want, received); diff != "" {
+		t.Errorf("unexpected message order: %s", diff)
+	}
+}
+
+func TestRun_combiner(t *testing.T) {
+	plg := &maxPlugin{size: 3, combiner: true}
+	if _, err := Run(plg, nil); err != nil {
+		t.Fatal(err)
+	}
+	received := make(map[plugin.VertexID][]uint32)
+	for _, v := range plg.loaded {
+		received[v.id] = v.received
+	}
+	want := map[plugin.VertexID][]uint32{
+		"v0": {2, 2},
+		"v1": {2, 2},
+		"v2": {1, 2},
+	}
+	if diff := cmp.Diff(want, received); diff != "" {
+		t.Errorf("unexpected messages: %s", diff)
+	}
+}
+
+func TestRun_maxSuperSteps(t *testing.T) {
+	if _, err := Run(&maxPlugin{size: 3}, &Options{MaxSuperSteps: 2}); err == nil {
+		t.Error("should not terminate in 2 supersteps")
+	}
+}
+
+// countVertex counts how many times it is computed without voting to halt
+type countVertex struct {
+	id       plugin.VertexID
+	computed int
+}
+
+func (v *countVertex) Compute(ctx plugin.ComputeContext) error {
+	v.computed++
+	if ctx.SuperStep() == 0 && v.id == "v0" {
+		return ctx.SendMessageTo("v1", uint32(1))
+	}
+	return nil
+}
+
+func (v *countVertex) GetID() plugin.VertexID { return v.id }
+
+func (v *countVertex) GetValueAsString() string { return strconv.Itoa(v.computed) }
+
+type countPlugin struct {
+	maxPlugin
+}
+
+func (p *countPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	for _, id := range []plugin.VertexID{"v0", "v1", "v2"} {
+		if part, _ := p.Partition(id, numOfPartitions); part == partitionID {
+			register(&countVertex{id: id})
+		}
+	}
+	return nil
+}
+
+func (p *countPlugin) GetAggregators() []plugin.Aggregator {
+	return nil
+}
+
+func TestRun_notHaltedWithoutMessages(t *testing.T) {
+	res, err := Run(&countPlugin{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// vertices without messages are not computed after superstep 0 even if they don't vote to halt
+	if diff := cmp.Diff(map[plugin.VertexID]string{"v0": "1", "v1": "2", "v2": "1"}, res.VertexValues); diff != "" {
+		t.Errorf("unexpected values: %s", diff)
+	}
+	// v1 is active after superstep 1 then halted in superstep 2 due to no message
+	if len(res.SuperSteps) != 3 {
+		t.Errorf("unexpected supersteps: %d", len(res.SuperSteps))
+	}
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/AsynkronIT/protoactor-go/mailbox"
+)
+
+// MailboxGauge measures the deepest mailbox of actors spawned with Unbounded().
+// Every mailbox counts its own depth, the gauge keeps the ones having messages to find the deepest.
+type MailboxGauge struct {
+	mux    sync.Mutex
+	queued map[*mailboxDepth]struct{}
+	total  int64
+	max    int64
+}
+
+// mailboxDepth counts messages queued in a mailbox
+type mailboxDepth struct {
+	gauge *MailboxGauge
+	depth int64
+}
+
+var _ = (mailbox.Statistics)(&mailboxDepth{})
+
+// Unbounded returns a producer of unbounded mailboxes measured by the gauge
+func (g *MailboxGauge) Unbounded() mailbox.Producer {
+	return func() mailbox.Mailbox {
+		return mailbox.Unbounded(&mailboxDepth{gauge: g})()
+	}
+}
+
+// MailboxStarted implements mailbox.Statistics
+func (m *mailboxDepth) MailboxStarted() {}
+
+// MessagePosted implements mailbox.Statistics
+func (m *mailboxDepth) MessagePosted(message interface{}) {
+	g := m.gauge
+	atomic.AddInt64(&g.total, 1)
+	d := atomic.AddInt64(&m.depth, 1)
+	if d == 1 {
+		g.mux.Lock()
+		// the message may have been received already
+		if atomic.LoadInt64(&m.depth) > 0 {
+			if g.queued == nil {
+				g.queued = make(map[*mailboxDepth]struct{})
+			}
+			g.queued[m] = struct{}{}
+		}
+		g.mux.Unlock()
+	}
+	for {
+		max := atomic.LoadInt64(&g.max)
+		if d <= max || atomic.CompareAndSwapInt64(&g.max, max, d) {
+			return
+		}
+	}
+}
+
+// MessageReceived implements mailbox.Statistics
+func (m *mailboxDepth) MessageReceived(message interface{}) {
+	g := m.gauge
+	atomic.AddInt64(&g.total, -1)
+	if atomic.AddInt64(&m.depth, -1) == 0 {
+		g.mux.Lock()
+		// a message may have been posted again
+		if atomic.LoadInt64(&m.depth) == 0 {
+			delete(g.queued, m)
+		}
+		g.mux.Unlock()
+	}
+}
+
+// MailboxEmpty implements mailbox.Statistics
+func (m *mailboxDepth) MailboxEmpty() {}
+
+// Depth returns the number of messages queued now in all the mailboxes
+func (g *MailboxGauge) Depth() uint64 {
+	return positive(atomic.LoadInt64(&g.total))
+}
+
+// TakeMax returns the maximum depth of a single mailbox since the last call
+func (g *MailboxGauge) TakeMax() uint64 {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	// the deepest mailbox now is where the next maximum starts from
+	var deepest int64
+	for m := range g.queued {
+		if d := atomic.LoadInt64(&m.depth); d > deepest {
+			deepest = d
+		}
+	}
+	return positive(atomic.SwapInt64(&g.max, deepest))
+}
+
+func positive(n int64) uint64 {
+	if n < 0 {
+		return 0
+	}
+	return uint64(n)
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+func Test_MailboxGauge(t *testing.T) {
+	g := &MailboxGauge{}
+	m1 := &mailboxDepth{gauge: g}
+	m2 := &mailboxDepth{gauge: g}
+	for i := 0; i < 3; i++ {
+		m1.MessagePosted(i)
+	}
+	m2.MessagePosted(0)
+	m2.MessagePosted(1)
+	m1.MessageReceived(0)
+	if g.Depth() != 4 {
+		t.Fatalf("unexpected depth: %d", g.Depth())
+	}
+	// the deepest mailbox, not the total
+	if m := g.TakeMax(); m != 3 {
+		t.Fatalf("unexpected max: %d", m)
+	}
+	// max restarts from the deepest mailbox now
+	if m := g.TakeMax(); m != 2 {
+		t.Fatalf("unexpected max after take: %d", m)
+	}
+	m1.MessageReceived(1)
+	m1.MessageReceived(2)
+	m2.MessageReceived(0)
+	if m := g.TakeMax(); m != 2 {
+		t.Fatalf("unexpected max after take: %d", m)
+	}
+	m2.MessageReceived(1)
+	if g.Depth() != 0 {
+		t.Fatalf("unexpected depth: %d", g.Depth())
+	}
+	if m := g.TakeMax(); m != 1 {
+		t.Fatalf("unexpected max after take: %d", m)
+	}
+	if m := g.TakeMax(); m != 0 {
+		t.Fatalf("unexpected max of empty mailboxes: %d", m)
+	}
+	if len(g.queued) != 0 {
+		t.Fatalf("empty mailboxes should not be kept: %d", len(g.queued))
+	}
+}
+
+func Test_MailboxGauge_actor(t *testing.T) {
+	g := &MailboxGauge{}
+	release := make(chan struct{})
+	done := make(chan struct{}, 10)
+	props := actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(int); ok {
+			<-release
+			done <- struct{}{}
+		}
+	}).WithMailbox(g.Unbounded())
+	var pids []*actor.PID
+	for i := 0; i < 2; i++ {
+		pid := actor.EmptyRootContext.Spawn(props)
+		defer actor.EmptyRootContext.Stop(pid)
+		pids = append(pids, pid)
+	}
+
+	for _, pid := range pids {
+		for i := 0; i < 5; i++ {
+			actor.EmptyRootContext.Send(pid, i)
+		}
+	}
+	// a mailbox has the messages and may have Started, but not messages of the other
+	if m := g.TakeMax(); m < 5 || m > 6 {
+		t.Fatalf("messages should be queued in a mailbox: max=%d", m)
+	}
+	close(release)
+	for i := 0; i < 10; i++ {
+		<-done
+	}
+}
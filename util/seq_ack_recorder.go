@@ -0,0 +1,78 @@
+package util
+
+// SeqAckRecorder helps to manage acks of messages numbered by the sender in ascending order.
+// Numbers acked out of order are kept in a bitmap from the lowest unacked number,
+// so memory is proportional to the span of messages in flight and completion is checked by counters.
+// The zero value is ready to use.
+type SeqAckRecorder struct {
+	// start is the first number issued since Clear()
+	start uint64
+	// next is the number issued next
+	next uint64
+	// numbers below base have been acked
+	base   uint64
+	window []uint64
+	acked  uint64
+}
+
+const seqWindowBits = 64
+
+// Clear forgets numbers waiting for acks. Numbers keep increasing so that late acks are not taken for new ones
+func (r *SeqAckRecorder) Clear() {
+	if r.next == 0 {
+		r.next = 1
+	}
+	r.start = r.next
+	r.base = r.next
+	r.window = r.window[:0]
+	r.acked = 0
+}
+
+// Issue returns a new number to wait for its ack
+func (r *SeqAckRecorder) Issue() uint64 {
+	if r.next == 0 {
+		r.Clear()
+	}
+	seq := r.next
+	r.next++
+	return seq
+}
+
+// Ack records ack of the number, it returns false if the number is not waited for or already acked
+func (r *SeqAckRecorder) Ack(seq uint64) bool {
+	if seq < r.base || seq >= r.next {
+		return false
+	}
+	off := seq - r.base
+	w, bit := off/seqWindowBits, uint64(1)<<(off%seqWindowBits)
+	for uint64(len(r.window)) <= w {
+		r.window = append(r.window, 0)
+	}
+	if r.window[w]&bit != 0 {
+		return false
+	}
+	r.window[w] |= bit
+	r.acked++
+
+	// slide the window over numbers which have all been acked
+	for len(r.window) > 0 && r.window[0] == ^uint64(0) {
+		r.window = r.window[1:]
+		r.base += seqWindowBits
+	}
+	return true
+}
+
+// HasCompleted checks if all issued numbers have been acked
+func (r *SeqAckRecorder) HasCompleted() bool {
+	return r.acked == r.Size()
+}
+
+// Size returns the number of numbers issued since Clear()
+func (r *SeqAckRecorder) Size() uint64 {
+	return r.next - r.start
+}
+
+// Pending returns the number of numbers waiting for acks
+func (r *SeqAckRecorder) Pending() uint64 {
+	return r.Size() - r.acked
+}
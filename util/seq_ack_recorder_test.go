@@ -0,0 +1,65 @@
+package util
+
+import "testing"
+
+func Test_SeqAckRecorder(t *testing.T) {
+	var r SeqAckRecorder
+	if !r.HasCompleted() {
+		t.Fatal("zero value should be completed")
+	}
+	if r.Ack(1) {
+		t.Fatal("number not issued should not be acked")
+	}
+
+	var seqs []uint64
+	for i := 0; i < 200; i++ {
+		seqs = append(seqs, r.Issue())
+	}
+	if r.Size() != 200 || r.Pending() != 200 {
+		t.Fatalf("unexpected size=%d pending=%d", r.Size(), r.Pending())
+	}
+
+	// ack out of order
+	for i := len(seqs) - 1; i >= 0; i -= 2 {
+		if !r.Ack(seqs[i]) {
+			t.Fatalf("failed to ack %d", seqs[i])
+		}
+	}
+	if r.Ack(seqs[len(seqs)-1]) {
+		t.Fatal("duplicated ack should be refused")
+	}
+	if r.HasCompleted() {
+		t.Fatal("not completed")
+	}
+	for i := 0; i < len(seqs); i += 2 {
+		if !r.Ack(seqs[i]) {
+			t.Fatalf("failed to ack %d", seqs[i])
+		}
+	}
+	if !r.HasCompleted() || r.Pending() != 0 {
+		t.Fatalf("should be completed: pending=%d", r.Pending())
+	}
+	if len(r.window) > 1 {
+		t.Fatalf("window should slide over acked numbers: %d words", len(r.window))
+	}
+	if r.Ack(seqs[0]) {
+		t.Fatal("duplicated ack should be refused after the window slides")
+	}
+
+	// numbers keep increasing after Clear
+	seq := r.Issue()
+	r.Clear()
+	if !r.HasCompleted() || r.Size() != 0 {
+		t.Fatal("should be cleared")
+	}
+	if r.Ack(seq) {
+		t.Fatal("late ack should be refused")
+	}
+	next := r.Issue()
+	if next <= seq {
+		t.Fatalf("number should increase: %d <= %d", next, seq)
+	}
+	if !r.Ack(next) || !r.HasCompleted() {
+		t.Fatal("failed to ack")
+	}
+}
@@ -8,44 +8,68 @@ import (
 	"github.com/rerorero/prerogel/plugin"
 )
 
-func aggregateValueMap(aggregators []plugin.Aggregator, base map[string]*types.Any, extra map[string]*types.Any) error {
-	// TODO: there is still room for improvement
-	for name, extraAny := range extra {
-		baseAny, ok := base[name]
-		if !ok {
-			base[name] = extraAny
-			continue
-		}
+// aggregateValue aggregates v into base, values are kept unmarshaled while they don't leave the process
+func aggregateValue(aggregators []plugin.Aggregator, base map[string]plugin.AggregatableValue, name string, v plugin.AggregatableValue) error {
+	agg, err := findAggregator(aggregators, name)
+	if err != nil {
+		return err
+	}
 
-		agg, err := findAggregator(aggregators, name)
-		if err != nil {
+	current, ok := base[name]
+	if !ok {
+		base[name] = v
+		return nil
+	}
+
+	val, err := agg.Aggregate(current, v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to Aggregate()")
+	}
+	base[name] = val
+	return nil
+}
+
+func aggregateValues(aggregators []plugin.Aggregator, base map[string]plugin.AggregatableValue, extra map[string]plugin.AggregatableValue) error {
+	for name, v := range extra {
+		if err := aggregateValue(aggregators, base, name, v); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		baseValue, err := agg.UnmarshalValue(baseAny)
+// marshalAggregatedValues marshals values to be sent to another process
+func marshalAggregatedValues(aggregators []plugin.Aggregator, values map[string]plugin.AggregatableValue) (map[string]*types.Any, error) {
+	pbs := make(map[string]*types.Any, len(values))
+	for name, v := range values {
+		agg, err := findAggregator(aggregators, name)
 		if err != nil {
-			return errors.Wrapf(err, "failed to unmarshal aggregated value: %+v", baseAny)
+			return nil, err
 		}
-
-		extraValue, err := agg.UnmarshalValue(extraAny)
+		pb, err := agg.MarshalValue(v)
 		if err != nil {
-			return errors.Wrapf(err, "failed to unmarshal aggregated value: %+v", extraAny)
+			return nil, errors.Wrapf(err, "failed to marshal aggregatable value: %#v", v)
 		}
+		pbs[name] = pb
+	}
+	return pbs, nil
+}
 
-		val, err := agg.Aggregate(baseValue, extraValue)
+// unmarshalAggregatedValues unmarshals values received from another process
+func unmarshalAggregatedValues(aggregators []plugin.Aggregator, pbs map[string]*types.Any) (map[string]plugin.AggregatableValue, error) {
+	values := make(map[string]plugin.AggregatableValue, len(pbs))
+	for name, pb := range pbs {
+		agg, err := findAggregator(aggregators, name)
 		if err != nil {
-			return errors.Wrapf(err, "failed to Aggregate()")
+			return nil, err
 		}
-
-		merged, err := agg.MarshalValue(val)
+		v, err := agg.UnmarshalValue(pb)
 		if err != nil {
-			return errors.Wrapf(err, "failed to marshal aggregatable value: %#v, %#v", baseAny, extraAny)
+			return nil, errors.Wrapf(err, "failed to unmarshal aggregated value: %+v", pb)
 		}
-
-		base[name] = merged
+		values[name] = v
 	}
-
-	return nil
+	return values, nil
 }
 
 func findAggregator(aggregators []plugin.Aggregator, name string) (plugin.Aggregator, error) {
@@ -57,30 +81,24 @@ func findAggregator(aggregators []plugin.Aggregator, name string) (plugin.Aggreg
 	return nil, fmt.Errorf("%s: no such aggregator", name)
 }
 
-func getAggregatedValue(aggregators []plugin.Aggregator, aggregated map[string]*types.Any, name string) (plugin.AggregatableValue, error) {
-	ag, err := findAggregator(aggregators, name)
-	if err != nil {
-		return nil, err
-	}
-
-	anyVal, ok := aggregated[name]
+func getAggregatedValue(aggregated map[string]plugin.AggregatableValue, name string) (plugin.AggregatableValue, error) {
+	v, ok := aggregated[name]
 	if !ok {
 		return nil, fmt.Errorf("%s no such aggregated value", name)
 	}
-
-	return ag.UnmarshalValue(anyVal)
+	return v, nil
 }
 
-func getAggregatedValueString(aggregators []plugin.Aggregator, aggregated map[string]*types.Any, name string) (string, error) {
-	pb, err := getAggregatedValue(aggregators, aggregated, name)
+func getAggregatedValueString(aggregators []plugin.Aggregator, aggregated map[string]plugin.AggregatableValue, name string) (string, error) {
+	ag, err := findAggregator(aggregators, name)
 	if err != nil {
 		return "", err
 	}
 
-	ag, err := findAggregator(aggregators, name)
+	v, err := getAggregatedValue(aggregated, name)
 	if err != nil {
 		return "", err
 	}
 
-	return ag.ToString(pb), nil
+	return ag.ToString(v), nil
 }
@@ -8,71 +8,71 @@ import (
 	"github.com/rerorero/prerogel/plugin"
 )
 
-func Test_aggregateValueMap(t *testing.T) {
+func Test_aggregateValues(t *testing.T) {
 	type args struct {
 		aggregators []plugin.Aggregator
-		base        map[string]*types.Any
-		extra       map[string]*types.Any
+		base        map[string]plugin.AggregatableValue
+		extra       map[string]plugin.AggregatableValue
 	}
 	tests := []struct {
 		name     string
 		args     args
 		wantErr  bool
-		wantBase map[string]*types.Any
+		wantBase map[string]plugin.AggregatableValue
 	}{
 		{
 			name: "aggregate",
 			args: args{
 				aggregators: aggregators,
-				base: map[string]*types.Any{
-					"concat": {Value: []byte("AA")},
+				base: map[string]plugin.AggregatableValue{
+					"concat": "AA",
 				},
-				extra: map[string]*types.Any{
-					"concat": {Value: []byte("BB")},
-					"sum":    {Value: []byte{uint8(3)}},
+				extra: map[string]plugin.AggregatableValue{
+					"concat": "BB",
+					"sum":    uint8(3),
 				},
 			},
 			wantErr: false,
-			wantBase: map[string]*types.Any{
-				"concat": {Value: []byte("AABB")},
-				"sum":    {Value: []byte{uint8(3)}},
+			wantBase: map[string]plugin.AggregatableValue{
+				"concat": "AABB",
+				"sum":    uint8(3),
 			},
 		},
 		{
 			name: "nil extra",
 			args: args{
 				aggregators: aggregators,
-				base: map[string]*types.Any{
-					"concat": {Value: []byte("AA")},
+				base: map[string]plugin.AggregatableValue{
+					"concat": "AA",
 				},
 				extra: nil,
 			},
 			wantErr: false,
-			wantBase: map[string]*types.Any{
-				"concat": {Value: []byte("AA")},
+			wantBase: map[string]plugin.AggregatableValue{
+				"concat": "AA",
 			},
 		},
 		{
 			name: "unknown",
 			args: args{
 				aggregators: aggregators,
-				base: map[string]*types.Any{
-					"foo": {Value: []byte("AA")},
+				base: map[string]plugin.AggregatableValue{
+					"foo": "AA",
 				},
-				extra: map[string]*types.Any{
-					"foo": {Value: []byte{uint8(3)}},
+				extra: map[string]plugin.AggregatableValue{
+					"foo": uint8(3),
 				},
 			},
 			wantErr: true,
-			wantBase: map[string]*types.Any{
-				"foo": {Value: []byte("AA")},
+			wantBase: map[string]plugin.AggregatableValue{
+				"foo": "AA",
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if err := aggregateValueMap(tt.args.aggregators, tt.args.base, tt.args.extra); (err != nil) != tt.wantErr {
-				t.Fatalf("aggregateValueMap() error = %v, wantErr %v", err, tt.wantErr)
+			if err := aggregateValues(tt.args.aggregators, tt.args.base, tt.args.extra); (err != nil) != tt.wantErr {
+				t.Fatalf("aggregateValues() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 		if diff := cmp.Diff(tt.args.base, tt.wantBase); diff != "" {
@@ -80,3 +80,35 @@ func Test_aggregateValueMap(t *testing.T) {
 		}
 	}
 }
+
+func Test_marshalAggregatedValues(t *testing.T) {
+	values := map[string]plugin.AggregatableValue{
+		"concat": "AA",
+		"sum":    uint8(3),
+	}
+	pbs, err := marshalAggregatedValues(aggregators, values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[string]*types.Any{
+		"concat": {Value: []byte("AA")},
+		"sum":    {Value: []byte{uint8(3)}},
+	}, pbs); diff != "" {
+		t.Fatalf("unexpected marshaled values: %s", diff)
+	}
+
+	got, err := unmarshalAggregatedValues(aggregators, pbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(values, got); diff != "" {
+		t.Fatalf("unexpected unmarshaled values: %s", diff)
+	}
+
+	if _, err := marshalAggregatedValues(aggregators, map[string]plugin.AggregatableValue{"foo": "AA"}); err == nil {
+		t.Fatal("expected error for unknown aggregator")
+	}
+	if _, err := unmarshalAggregatedValues(aggregators, map[string]*types.Any{"foo": {}}); err == nil {
+		t.Fatal("expected error for unknown aggregator")
+	}
+}
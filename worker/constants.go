@@ -12,8 +12,14 @@ const (
 	// CoordinatorActorID is actor id of coordinator
 	CoordinatorActorID = "coordinator"
 
+	// ProtocolVersion is version of messages between master and workers, incremented on incompatible changes
+	ProtocolVersion = 3
+
+	// ReservedAggregatorPrefix is prefix of aggregator names used for internal
+	ReservedAggregatorPrefix = "prerogel/"
+
 	// VertexStatsName is aggregator name of VertexStatsAggregator
-	VertexStatsName = "prerogel/vertex-stats"
+	VertexStatsName = ReservedAggregatorPrefix + "vertex-stats"
 )
 
 var (
@@ -1,7 +1,11 @@
 package worker
 
 import (
+	ctxpkg "context"
 	"fmt"
+	"net"
+	"reflect"
+	"sort"
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
@@ -17,26 +21,79 @@ import (
 
 type lastAggregated struct {
 	superstep uint64
-	values    map[string]*types.Any
+	values    map[string]plugin.AggregatableValue
+	// marshaled is values sent to workers with Compute
+	marshaled map[string]*types.Any
 }
 
+// algorithmSelection is selecting an algorithm on workers, the coordinator switches after all of them have selected it
+type algorithmSelection struct {
+	name     string
+	previous string
+	// selected are workers which have selected the algorithm, they are rolled back if any worker fails
+	selected    []*actor.PID
+	err         string
+	rollingBack bool
+}
+
+// registrationTimeout is local message to stop waiting for registration of workers
+type registrationTimeout struct{}
+
 type coordinatorActor struct {
 	util.ActorUtil
 	behavior              actor.Behavior
 	plugin                plugin.Plugin
 	workerProps           *actor.Props
 	clusterInfo           *command.ClusterInfo
+	router                *router
 	ackRecorder           *util.AckRecorder
-	aggregatedCurrentStep map[string]*types.Any
+	aggregatedCurrentStep map[string]plugin.AggregatableValue
 	lastAggregatedValue   lastAggregated
 	currentStep           uint64
 	stateName             string
+	pipeline              *pipeline
+	verticesLoaded        bool
+	history               []*SuperStepStats
+	registeredWorkers     []string
+	pendingCluster        *command.NewCluster
+	resolver              Resolver
+	resolveInterval       time.Duration
+	nextWorkerIndex       int
+	migration             *migration
+	partitionStats        map[uint64]*command.PartitionStats
+	traffic               map[partitionPair]*command.PartitionTraffic
+	trafficSteps          uint64
+	rebalanceThreshold    float64
+	maxVertexMoves        uint32
+	numericVertexIDs      bool
+	messageBatch          bool
+	messageCompression    command.BatchCompression
+	vertexMoves           []*command.VertexMove
+	vertexMigration       *vertexMigration
+	movedVertices         uint64
+	respondTo             *actor.PID
 	shutdownHandler       func()
+	selection             *algorithmSelection
+	// batchStats is accumulated like traffic, stepBatchStats is of the current superstep
+	batchStats     *command.MessageBatchStats
+	stepBatchStats *command.MessageBatchStats
+	// flowControl limits messages in flight, flowStats and stepFlowStats are kept like batch stats
+	flowControl    *command.FlowControl
+	messageSpill   *command.MessageSpill
+	partitionStore *command.PartitionStore
+	flowStats      *command.FlowControlStats
+	stepFlowStats  *command.FlowControlStats
+	// jobErr is the error which stopped the last supersteps
+	jobErr string
+	// superstepBarrier sends SuperStepBarrier before every superstep instead of the first one of a run
+	superstepBarrier bool
 }
 
 const (
 	// CoordinatorStateInit describes state: on initializing
 	CoordinatorStateInit = "initializing cluster"
+	// CoordinatorStateWaitingWorkers describes state: waiting for workers to register
+	CoordinatorStateWaitingWorkers = "waiting for workers"
 	// CoordinatorStateIdle describes state: idle
 	CoordinatorStateIdle = "idle"
 	// CoordinatorStateLoadingVertices describes state: loading vertices
@@ -45,10 +102,25 @@ const (
 	CoordinatorStateProcessing = "processing superstep"
 	// CoordinatorStateProcessingComputing describes state: computing
 	CoordinatorStateProcessingComputing = "processing superstep - computing"
+	// CoordinatorStateMigrating describes state: moving partitions between workers
+	CoordinatorStateMigrating = "migrating partitions"
+	// CoordinatorStateMigratingVertices describes state: moving vertices between partitions
+	CoordinatorStateMigratingVertices = "migrating vertices"
 )
 
+// CoordinatorOption configures coordinator actor
+type CoordinatorOption func(*coordinatorActor)
+
+// WithResolver replaces resolver used to discover workers by DNS
+func WithResolver(r Resolver, interval time.Duration) CoordinatorOption {
+	return func(a *coordinatorActor) {
+		a.resolver = r
+		a.resolveInterval = interval
+	}
+}
+
 // NewCoordinatorActor returns an actor instance
-func NewCoordinatorActor(plg plugin.Plugin, workerProps *actor.Props, shutdown func(), logger *logrus.Logger) actor.Actor {
+func NewCoordinatorActor(plg plugin.Plugin, workerProps *actor.Props, shutdown func(), logger *logrus.Logger, opts ...CoordinatorOption) actor.Actor {
 	ar := &util.AckRecorder{}
 	ar.Clear()
 	a := &coordinatorActor{
@@ -57,9 +129,15 @@ func NewCoordinatorActor(plg plugin.Plugin, workerProps *actor.Props, shutdown f
 			Logger: logger,
 		},
 		workerProps:     workerProps,
+		router:          newRouter(plg, 0),
 		ackRecorder:     ar,
 		stateName:       CoordinatorStateInit,
 		shutdownHandler: shutdown,
+		resolver:        net.DefaultResolver,
+		resolveInterval: defaultResolveInterval,
+	}
+	for _, opt := range opts {
+		opt(a)
 	}
 	a.behavior.Become(a.setup)
 	return a
@@ -76,6 +154,7 @@ func (state *coordinatorActor) Receive(context actor.Context) {
 	case *command.CoordinatorStats:
 		s := &command.CoordinatorStatsAck{
 			State: state.stateName,
+			Error: state.jobErr,
 		}
 		if state.lastAggregatedValue.values != nil {
 			stats, err := state.getStats(state.lastAggregatedValue.values)
@@ -87,9 +166,66 @@ func (state *coordinatorActor) Receive(context actor.Context) {
 			s.NrOfActiveVertex = stats.ActiveVertices
 			s.NrOfSentMessages = stats.MessagesSent
 		}
+		if state.pipeline != nil {
+			s.Stage = state.pipeline.currentStage().Name
+		}
+		if sel, ok := state.plugin.(algorithmSelector); ok {
+			s.Algorithm = sel.activeAlgorithm()
+		}
+		if state.clusterInfo != nil {
+			for _, wi := range state.clusterInfo.WorkerInfo {
+				s.Workers = append(s.Workers, workerName(wi.WorkerPid))
+			}
+		}
 		context.Respond(s)
 		return
 
+	case *command.AddWorker, *command.DrainWorker, *command.RebalanceWorkers:
+		if state.stateName != CoordinatorStateIdle || state.respondTo != nil {
+			err := fmt.Sprintf("workers can't be changed while %s", state.stateName)
+			state.ActorUtil.LogWarn(context, err)
+			switch cmd.(type) {
+			case *command.AddWorker:
+				context.Respond(&command.AddWorkerAck{Error: err})
+			case *command.DrainWorker:
+				context.Respond(&command.DrainWorkerAck{Error: err})
+			default:
+				context.Respond(&command.RebalanceWorkersAck{Error: err})
+			}
+			return
+		}
+		state.behavior.Receive(context)
+		return
+
+	case *command.RegisterWorker:
+		state.registerWorker(context, cmd)
+		return
+
+	case *registrationTimeout, *resolvedWorkers:
+		if state.clusterInfo == nil {
+			state.behavior.Receive(context)
+		}
+		return
+
+	case *getJobResultLocal:
+		context.Respond(&getJobResultLocalAck{
+			aggregated: state.lastAggregatedValue.values,
+			history:    state.history,
+		})
+		return
+
+	case *command.ShowPipeline:
+		ack := &command.ShowPipelineAck{}
+		if state.pipeline != nil {
+			ack.Stages = state.pipeline.stats
+		}
+		context.Respond(ack)
+		return
+
+	case *command.ShowPartitions:
+		context.Respond(state.partitionsReport())
+		return
+
 	case *command.ShowAggregatedValue:
 		ack := &command.ShowAggregatedValueAck{
 			AggregatedValues: make(map[string]string),
@@ -142,49 +278,70 @@ func (state *coordinatorActor) setup(context actor.Context) {
 			return
 		}
 
-		assigned, err := assignPartition(len(cmd.Workers), cmd.NrOfPartitions)
-		if err != nil {
-			state.ActorUtil.Fail(context, err)
+		// NewClusterAck is responded when all workers have been initialized
+		state.respondTo = context.Sender()
+		state.pendingCluster = cmd
+		state.rebalanceThreshold = cmd.RebalanceThreshold
+		state.maxVertexMoves = cmd.MaxVertexMoves
+		state.router.setCacheSize(int(cmd.VertexCacheSize))
+		state.numericVertexIDs = cmd.NumericVertexIds
+		state.messageBatch = cmd.MessageBatch
+		state.messageCompression = cmd.MessageCompression
+		state.flowControl = cmd.FlowControl
+		state.messageSpill = cmd.MessageSpill
+		state.partitionStore = cmd.PartitionStore
+		state.superstepBarrier = cmd.SuperstepBarrier
+		if cmd.WorkerDns != "" {
+			state.resolveWorkersAfter(context, 0)
+		}
+		if !state.enoughWorkers() {
+			if cmd.RegistrationTimeoutMillis > 0 {
+				self := context.Self()
+				time.AfterFunc(time.Duration(cmd.RegistrationTimeoutMillis)*time.Millisecond, func() {
+					actor.EmptyRootContext.Send(self, &registrationTimeout{})
+				})
+			}
+			state.stateName = CoordinatorStateWaitingWorkers
+			state.ActorUtil.LogInfo(context, fmt.Sprintf("waiting for %d workers to register", cmd.MinRegisteredWorkers))
 			return
 		}
-		state.ackRecorder.Clear()
+		state.formCluster(context, cmd)
+		return
 
-		ci := &command.ClusterInfo{
-			WorkerInfo: make([]*command.ClusterInfo_WorkerInfo, len(cmd.Workers)),
+	case *resolvedWorkers:
+		if state.pendingCluster == nil {
+			return
 		}
-		for i, wreq := range cmd.Workers {
-			var pid *actor.PID
-			if wreq.Remote {
-				// remote actor
-				pidRes, err := remote.SpawnNamed(wreq.HostAndPort, fmt.Sprintf("worker-%d", i), WorkerActorKind, 30*time.Second)
-				if err != nil {
-					state.ActorUtil.Fail(context, errors.Wrap(err, "failed to spawn remote actor"))
-					return
-				}
-				pid = pidRes.Pid
-			} else {
-				// local actor
-				pid = context.Spawn(state.workerProps)
-			}
-
-			context.Request(pid, &command.InitWorker{
-				Coordinator: context.Self(),
-				Partitions:  assigned[i],
-			})
-			state.ackRecorder.AddToWaitList(pid.GetId())
-			ci.WorkerInfo[i] = &command.ClusterInfo_WorkerInfo{
-				WorkerPid:  pid,
-				Partitions: assigned[i],
+		if cmd.err != nil {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("failed to discover workers: %v", cmd.err))
+		}
+		for _, addr := range cmd.addresses {
+			if state.addWorker(addr) {
+				state.ActorUtil.LogInfo(context, fmt.Sprintf("worker discovered: %s", addr))
 			}
 		}
-
-		state.clusterInfo = ci
-		for _, wi := range state.clusterInfo.WorkerInfo {
-			context.Send(wi.WorkerPid, ci)
+		if state.enoughWorkers() {
+			state.formCluster(context, state.pendingCluster)
+			return
 		}
+		state.resolveWorkersAfter(context, state.resolveInterval)
+		return
 
-		context.Respond(&command.NewClusterAck{})
-		state.ActorUtil.LogDebug(context, "start initializing workers")
+	case *registrationTimeout:
+		if state.pendingCluster == nil {
+			return
+		}
+		pending := state.pendingCluster
+		if len(pending.Workers)+len(state.registeredWorkers) == 0 {
+			err := fmt.Sprintf("no workers have registered in %dms", pending.RegistrationTimeoutMillis)
+			state.ActorUtil.LogError(context, err)
+			state.pendingCluster = nil
+			state.stateName = CoordinatorStateInit
+			state.respond(context, &command.NewClusterAck{Error: err})
+			return
+		}
+		state.ActorUtil.LogWarn(context, fmt.Sprintf("registration timed out, forming cluster with %d registered workers", len(state.registeredWorkers)))
+		state.formCluster(context, pending)
 		return
 
 	case *command.InitWorkerAck:
@@ -192,8 +349,17 @@ func (state *coordinatorActor) setup(context actor.Context) {
 			state.ActorUtil.LogError(context, fmt.Sprintf("InitWorkerAck from unknown worker: %v", cmd.WorkerPid))
 			return
 		}
+		if expected := algorithmsOf(state.plugin); !reflect.DeepEqual(expected, cmd.Algorithms) {
+			state.abortSetup(context, fmt.Sprintf("worker %v has different algorithms: expected=%v actual=%v", cmd.WorkerPid, expected, cmd.Algorithms))
+			return
+		}
+		if err := compareFingerprint(fingerprintOf(state.plugin), cmd.Fingerprint); err != nil {
+			state.abortSetup(context, fmt.Sprintf("worker %v runs incompatible plugin: %v", cmd.WorkerPid, err))
+			return
+		}
 		if state.ackRecorder.HasCompleted() {
 			state.ackRecorder.Clear()
+			state.respond(context, &command.NewClusterAck{})
 			state.behavior.Become(state.idle)
 			state.stateName = CoordinatorStateIdle
 			state.ActorUtil.LogDebug(context, "become idle")
@@ -206,6 +372,140 @@ func (state *coordinatorActor) setup(context actor.Context) {
 	}
 }
 
+// formCluster spawns workers requested by NewCluster and registered workers, then initializes them
+func (state *coordinatorActor) formCluster(context actor.Context, cmd *command.NewCluster) {
+	state.pendingCluster = nil
+	state.stateName = CoordinatorStateInit
+	workers := append([]*command.NewCluster_WorkerReq{}, cmd.Workers...)
+	for _, addr := range state.registeredWorkers {
+		workers = append(workers, &command.NewCluster_WorkerReq{Remote: true, HostAndPort: addr})
+	}
+
+	assigned, err := assignPartition(len(workers), cmd.NrOfPartitions)
+	if err != nil {
+		state.ActorUtil.Fail(context, err)
+		return
+	}
+	state.ackRecorder.Clear()
+
+	ci := &command.ClusterInfo{
+		WorkerInfo: make([]*command.ClusterInfo_WorkerInfo, len(workers)),
+	}
+	for i, wreq := range workers {
+		var pid *actor.PID
+		if wreq.Remote {
+			// remote actor
+			pidRes, err := remote.SpawnNamed(wreq.HostAndPort, fmt.Sprintf("worker-%d", i), WorkerActorKind, 30*time.Second)
+			if err != nil {
+				state.ActorUtil.Fail(context, errors.Wrap(err, "failed to spawn remote actor"))
+				return
+			}
+			pid = pidRes.Pid
+		} else {
+			// local actor
+			pid = context.Spawn(state.workerProps)
+		}
+
+		context.Request(pid, &command.InitWorker{
+			Coordinator:        context.Self(),
+			Partitions:         assigned[i],
+			Fingerprint:        fingerprintOf(state.plugin),
+			VertexCacheSize:    uint32(state.router.cacheSize),
+			NumericVertexIds:   state.numericVertexIDs,
+			MessageBatch:       state.messageBatch,
+			MessageCompression: state.messageCompression,
+			MessageSpill:       state.messageSpill,
+			PartitionStore:     state.partitionStore,
+		})
+		state.ackRecorder.AddToWaitList(pid.GetId())
+		ci.WorkerInfo[i] = &command.ClusterInfo_WorkerInfo{
+			WorkerPid:  pid,
+			Partitions: assigned[i],
+		}
+	}
+	state.nextWorkerIndex = len(workers)
+
+	state.clusterInfo = ci
+	state.router.update(ci)
+	for _, wi := range state.clusterInfo.WorkerInfo {
+		context.Send(wi.WorkerPid, ci)
+	}
+
+	state.ActorUtil.LogDebug(context, "start initializing workers")
+}
+
+// abortSetup stops workers spawned by formCluster then responds error. NewCluster can be sent again
+func (state *coordinatorActor) abortSetup(context actor.Context, err string) {
+	state.ActorUtil.LogError(context, err)
+	for _, wi := range state.clusterInfo.GetWorkerInfo() {
+		context.Stop(wi.WorkerPid)
+	}
+	state.clusterInfo = nil
+	state.router.update(nil)
+	state.nextWorkerIndex = 0
+	// acks from the other workers are ignored
+	state.ackRecorder.Clear()
+	state.stateName = CoordinatorStateInit
+	state.respond(context, &command.NewClusterAck{Error: err})
+}
+
+// registerWorker records a worker which has registered itself, then forms the cluster if enough workers have registered
+func (state *coordinatorActor) registerWorker(context actor.Context, cmd *command.RegisterWorker) {
+	if state.clusterInfo != nil {
+		context.Respond(&command.RegisterWorkerAck{Error: "cluster has already been formed"})
+		return
+	}
+	if cmd.HostAndPort == "" {
+		context.Respond(&command.RegisterWorkerAck{Error: "empty worker address"})
+		return
+	}
+	// registration may be retried by the worker
+	if state.addWorker(cmd.HostAndPort) {
+		state.ActorUtil.LogInfo(context, fmt.Sprintf("worker registered: %s", cmd.HostAndPort))
+	}
+	context.Respond(&command.RegisterWorkerAck{})
+
+	if state.pendingCluster != nil && state.enoughWorkers() {
+		state.formCluster(context, state.pendingCluster)
+	}
+}
+
+// addWorker adds a registered or discovered worker address, returns false if it already exists
+func (state *coordinatorActor) addWorker(addr string) bool {
+	for _, a := range state.registeredWorkers {
+		if a == addr {
+			return false
+		}
+	}
+	state.registeredWorkers = append(state.registeredWorkers, addr)
+	return true
+}
+
+// enoughWorkers returns true if the pending cluster can be formed
+func (state *coordinatorActor) enoughWorkers() bool {
+	needed := int(state.pendingCluster.MinRegisteredWorkers)
+	if state.pendingCluster.WorkerDns != "" && needed == 0 {
+		// at least one worker has to be discovered
+		needed = 1
+	}
+	return len(state.registeredWorkers) >= needed
+}
+
+// resolveWorkersAfter looks up workers of the pending cluster in background then notifies the result to itself
+func (state *coordinatorActor) resolveWorkersAfter(context actor.Context, delay time.Duration) {
+	self := context.Self()
+	name := state.pendingCluster.WorkerDns
+	srv := state.pendingCluster.WorkerDnsSrv
+	resolver := state.resolver
+	go func() {
+		time.Sleep(delay)
+		ctx, cancel := ctxpkg.WithTimeout(ctxpkg.Background(), resolveTimeout)
+		defer cancel()
+		addrs, err := resolveWorkers(ctx, resolver, name, srv)
+		actor.EmptyRootContext.Send(self, &resolvedWorkers{addresses: addrs, err: err})
+	}()
+}
+
 func (state *coordinatorActor) idle(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.LoadVertex:
@@ -216,10 +516,49 @@ func (state *coordinatorActor) idle(context actor.Context) {
 			context.Respond(&command.LoadVertexAck{VertexId: string(cmd.VertexId), Error: err})
 			return
 		}
+		state.verticesLoaded = true
 		context.Forward(w.WorkerPid)
 		return
 
+	case *command.SelectAlgorithm:
+		sel, ok := state.plugin.(algorithmSelector)
+		if !ok {
+			context.Respond(&command.SelectAlgorithmAck{Error: "algorithm registry is not used"})
+			return
+		}
+		if state.verticesLoaded && sel.activeAlgorithm() != cmd.Name {
+			err := fmt.Sprintf("vertices have already been loaded by %s", sel.activeAlgorithm())
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.SelectAlgorithmAck{Error: err})
+			return
+		}
+		if err := sel.checkAlgorithm(cmd.Name); err != nil {
+			state.ActorUtil.LogError(context, err.Error())
+			context.Respond(&command.SelectAlgorithmAck{Error: err.Error()})
+			return
+		}
+		state.selection = &algorithmSelection{name: cmd.Name, previous: sel.activeAlgorithm()}
+		state.ackRecorder.Clear()
+		for _, wi := range state.clusterInfo.WorkerInfo {
+			context.Request(wi.WorkerPid, cmd)
+			state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
+		}
+		state.respondTo = context.Sender()
+		state.behavior.Become(state.waitSelectAlgorithm)
+		state.ActorUtil.LogInfo(context, fmt.Sprintf("selecting algorithm %s", cmd.Name))
+		return
+
 	case *command.LoadPartitionVertices:
+		state.verticesLoaded = true
+		state.traffic = nil
+		state.trafficSteps = 0
+		state.batchStats = nil
+		state.flowStats = nil
+		state.movedVertices = 0
+		if len(state.clusterInfo.MovedVertices) > 0 {
+			// vertices are loaded into partitions given by the plugin
+			state.broadcastClusterInfo(context, &command.ClusterInfo{WorkerInfo: state.clusterInfo.WorkerInfo})
+		}
 		for _, wi := range state.clusterInfo.WorkerInfo {
 			context.Request(wi.WorkerPid, &command.LoadPartitionVertices{
 				NumOfPartitions: state.clusterInfo.NumOfPartitions(),
@@ -232,16 +571,48 @@ func (state *coordinatorActor) idle(context actor.Context) {
 		return
 
 	case *command.StartSuperStep:
-		state.aggregatedCurrentStep = make(map[string]*types.Any)
-		state.currentStep = 0
-		for _, wi := range state.clusterInfo.WorkerInfo {
-			context.Request(wi.WorkerPid, &command.SuperStepBarrier{})
-			state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
+		state.pipeline = nil
+		state.history = nil
+		state.startSuperSteps(context)
+		return
+
+	case *command.StartPipeline:
+		p, err := newPipeline(state.plugin, cmd.Stages)
+		if err != nil {
+			state.ActorUtil.LogError(context, fmt.Sprintf("failed to start pipeline: %v", err))
+			context.Respond(&command.StartPipelineAck{Error: err.Error()})
+			return
+		}
+		context.Respond(&command.StartPipelineAck{})
+		state.pipeline = p
+		state.history = nil
+		state.startStage(context)
+		return
+
+	case *command.AddWorker:
+		state.addWorkerToCluster(context, cmd)
+		return
+
+	case *command.RebalanceWorkers:
+		state.respondTo = context.Sender()
+		state.migration = &migration{workers: state.clusterInfo.WorkerInfo}
+		state.startMigration(context)
+		return
+
+	case *command.DrainWorker:
+		wi := findWorker(state.clusterInfo.WorkerInfo, cmd.Worker)
+		if wi == nil {
+			err := fmt.Sprintf("no such worker: %s", cmd.Worker)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.DrainWorkerAck{Error: err})
+			return
 		}
-		// TODO: handle worker timeout
-		state.behavior.Become(state.superstep)
-		state.stateName = CoordinatorStateProcessing
-		state.ActorUtil.LogInfo(context, "------ superstep 0 started ------")
+		state.respondTo = context.Sender()
+		state.migration = &migration{
+			workers: state.clusterInfo.WorkerInfo,
+			drained: wi.WorkerPid,
+		}
+		state.startMigration(context)
 		return
 
 	default:
@@ -250,6 +621,266 @@ func (state *coordinatorActor) idle(context actor.Context) {
 	}
 }
 
+// addWorkerToCluster spawns a new worker without partitions, then partitions are moved to it after it is initialized
+func (state *coordinatorActor) addWorkerToCluster(context actor.Context, cmd *command.AddWorker) {
+	var pid *actor.PID
+	if cmd.Remote {
+		pidRes, err := remote.SpawnNamed(cmd.HostAndPort, fmt.Sprintf("worker-%d", state.nextWorkerIndex), WorkerActorKind, 30*time.Second)
+		if err != nil {
+			err := fmt.Sprintf("failed to spawn remote actor: %v", err)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.AddWorkerAck{Error: err})
+			return
+		}
+		pid = pidRes.Pid
+	} else {
+		pid = context.Spawn(state.workerProps)
+	}
+	state.nextWorkerIndex++
+
+	state.respondTo = context.Sender()
+	state.migration = &migration{
+		workers: append(append([]*command.ClusterInfo_WorkerInfo{}, state.clusterInfo.WorkerInfo...), &command.ClusterInfo_WorkerInfo{WorkerPid: pid}),
+		added:   pid,
+	}
+	state.ackRecorder.Clear()
+	state.ackRecorder.AddToWaitList(pid.GetId())
+	context.Request(pid, &command.InitWorker{
+		Coordinator:        context.Self(),
+		Fingerprint:        fingerprintOf(state.plugin),
+		VertexCacheSize:    uint32(state.router.cacheSize),
+		NumericVertexIds:   state.numericVertexIDs,
+		MessageBatch:       state.messageBatch,
+		MessageCompression: state.messageCompression,
+		MessageSpill:       state.messageSpill,
+		PartitionStore:     state.partitionStore,
+	})
+	state.behavior.Become(state.waitAddedWorker)
+	state.stateName = CoordinatorStateMigrating
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("adding worker %v", workerName(pid)))
+}
+
+func (state *coordinatorActor) waitAddedWorker(context actor.Context) {
+	switch cmd := context.Message().(type) {
+	case *command.InitWorkerAck:
+		if ok := state.ackRecorder.Ack(cmd.WorkerPid.GetId()); !ok {
+			state.ActorUtil.LogError(context, fmt.Sprintf("InitWorkerAck from unknown worker: %v", cmd.WorkerPid))
+			return
+		}
+		if expected := algorithmsOf(state.plugin); !reflect.DeepEqual(expected, cmd.Algorithms) {
+			state.abortMigration(context, fmt.Sprintf("worker %v has different algorithms: expected=%v actual=%v", cmd.WorkerPid, expected, cmd.Algorithms))
+			return
+		}
+		if err := compareFingerprint(fingerprintOf(state.plugin), cmd.Fingerprint); err != nil {
+			state.abortMigration(context, fmt.Sprintf("worker %v runs incompatible plugin: %v", cmd.WorkerPid, err))
+			return
+		}
+		// the new worker has to compute the same algorithm as others
+		if sel, ok := state.plugin.(algorithmSelector); ok && sel.activeAlgorithm() != "" {
+			context.Request(cmd.WorkerPid, &command.SelectAlgorithm{Name: sel.activeAlgorithm()})
+			return
+		}
+		state.startMigration(context)
+		return
+
+	case *command.SelectAlgorithmAck:
+		if cmd.Error != "" {
+			state.abortMigration(context, fmt.Sprintf("failed to select algorithm on worker %v: %s", cmd.WorkerPid, cmd.Error))
+			return
+		}
+		state.startMigration(context)
+		return
+
+	default:
+		state.ActorUtil.Fail(context, fmt.Errorf("[waitAddedWorker] unhandled corrdinator command: command=%#v", cmd))
+		return
+	}
+}
+
+// startMigration moves partitions one by one so that loads of workers are balanced
+func (state *coordinatorActor) startMigration(context actor.Context) {
+	m := state.migration
+	moves, err := planMigration(m.workers, m.drained, state.partitionStats)
+	if err != nil {
+		state.abortMigration(context, err.Error())
+		return
+	}
+	if _, ok := vertexMarshalerOf(state.plugin); !ok && state.verticesLoaded && len(moves) > 0 {
+		state.abortMigration(context, "vertices have been loaded but plugin doesn't implement VertexMarshaler")
+		return
+	}
+	m.moves = moves
+	state.behavior.Become(state.migrating)
+	state.stateName = CoordinatorStateMigrating
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("start migration: %d partitions are moved", len(moves)))
+	state.exportNextPartition(context)
+}
+
+func (state *coordinatorActor) exportNextPartition(context actor.Context) {
+	m := state.migration
+	if m.next >= len(m.moves) {
+		state.finishMigration(context)
+		return
+	}
+	move := m.moves[m.next]
+	context.Request(move.from, &command.ExportPartition{PartitionId: move.partition})
+}
+
+func (state *coordinatorActor) migrating(context actor.Context) {
+	switch cmd := context.Message().(type) {
+	case *command.ExportPartitionAck:
+		if cmd.Error != "" {
+			state.abortMigration(context, fmt.Sprintf("failed to export partition %v: %s", cmd.PartitionId, cmd.Error))
+			return
+		}
+		move := state.migration.moves[state.migration.next]
+		context.Request(move.to, &command.ImportPartition{
+			PartitionId:      move.partition,
+			Vertices:         cmd.Vertices,
+			NumOfPartitions:  state.clusterInfo.NumOfPartitions(),
+			NumericVertexIds: state.numericVertexIDs,
+			Store:            state.partitionStore,
+		})
+		return
+
+	case *command.ImportPartitionAck:
+		if cmd.Error != "" {
+			state.abortMigration(context, fmt.Sprintf("failed to import partition %v: %s", cmd.PartitionId, cmd.Error))
+			return
+		}
+		// the exporting worker has kept the partition until now
+		context.Send(state.migration.moves[state.migration.next].from, &command.ReleasePartition{PartitionId: cmd.PartitionId})
+		state.ActorUtil.LogDebug(context, fmt.Sprintf("partition %v has been moved", cmd.PartitionId))
+		state.migration.next++
+		state.exportNextPartition(context)
+		return
+
+	default:
+		state.ActorUtil.Fail(context, fmt.Errorf("[migrating] unhandled corrdinator command: command=%#v", cmd))
+		return
+	}
+}
+
+// finishMigration broadcasts new ClusterInfo then stops the drained worker
+func (state *coordinatorActor) finishMigration(context actor.Context) {
+	m := state.migration
+	workers := applyMoves(m.workers, m.moves)
+	if m.drained != nil {
+		for i, wi := range workers {
+			if samePID(wi.WorkerPid, m.drained) {
+				workers = append(workers[:i], workers[i+1:]...)
+				break
+			}
+		}
+		context.Stop(m.drained)
+	}
+	if len(m.moves) > 0 || m.added != nil || m.drained != nil {
+		state.updateClusterInfo(context, workers)
+	}
+	state.migration = nil
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("migration completed: %d workers", len(workers)))
+
+	if m.resume {
+		state.nextSuperStep(context)
+		return
+	}
+	state.respond(context, m.ack(""))
+	state.behavior.Become(state.idle)
+	state.stateName = CoordinatorStateIdle
+}
+
+// abortMigration keeps partitions moved so far then responds error. The partition being moved stays with the exporting worker
+func (state *coordinatorActor) abortMigration(context actor.Context, err string) {
+	state.ActorUtil.LogError(context, err)
+	m := state.migration
+	if m.next > 0 {
+		state.updateClusterInfo(context, applyMoves(m.workers, m.moves[:m.next]))
+	} else if m.added != nil {
+		context.Stop(m.added)
+	}
+	state.migration = nil
+	state.ackRecorder.Clear()
+
+	if m.resume {
+		// supersteps go on with partitions moved so far
+		state.nextSuperStep(context)
+		return
+	}
+	state.respond(context, m.ack(err))
+	state.behavior.Become(state.idle)
+	state.stateName = CoordinatorStateIdle
+}
+
+func (state *coordinatorActor) updateClusterInfo(context actor.Context, workers []*command.ClusterInfo_WorkerInfo) {
+	state.broadcastClusterInfo(context, &command.ClusterInfo{WorkerInfo: workers, MovedVertices: state.clusterInfo.GetMovedVertices()})
+}
+
+// broadcastClusterInfo replaces ClusterInfo. It must not be modified after that as local workers share it
+func (state *coordinatorActor) broadcastClusterInfo(context actor.Context, info *command.ClusterInfo) {
+	state.clusterInfo = info
+	state.router.update(info)
+	for _, wi := range info.WorkerInfo {
+		context.Send(wi.WorkerPid, info)
+	}
+}
+
+func (state *coordinatorActor) waitSelectAlgorithm(context actor.Context) {
+	switch cmd := context.Message().(type) {
+	case *command.SelectAlgorithmAck:
+		if ok := state.ackRecorder.Ack(cmd.WorkerPid.GetId()); !ok {
+			state.ActorUtil.LogError(context, fmt.Sprintf("SelectAlgorithmAck from unknown worker: %v", cmd.WorkerPid))
+			return
+		}
+		sel := state.selection
+		if cmd.Error != "" {
+			state.ActorUtil.LogError(context, fmt.Sprintf("failed to select algorithm on worker %v: %s", cmd.WorkerPid, cmd.Error))
+			if sel.err == "" && !sel.rollingBack {
+				sel.err = cmd.Error
+			}
+		} else if !sel.rollingBack {
+			sel.selected = append(sel.selected, cmd.WorkerPid)
+		}
+		if !state.ackRecorder.HasCompleted() {
+			return
+		}
+
+		if sel.err == "" {
+			if err := state.plugin.(algorithmSelector).selectAlgorithm(sel.name); err != nil {
+				sel.err = err.Error()
+			} else {
+				// the algorithm may partition vertices differently
+				state.router.clearCache()
+				state.finishSelectAlgorithm(context, &command.SelectAlgorithmAck{})
+				state.ActorUtil.LogInfo(context, "algorithm has been selected")
+				return
+			}
+		}
+		if !sel.rollingBack && len(sel.selected) > 0 {
+			// workers keep computing the same algorithm as the coordinator
+			state.ActorUtil.LogInfo(context, fmt.Sprintf("rolling back algorithm to %s", sel.previous))
+			sel.rollingBack = true
+			for _, pid := range sel.selected {
+				context.Request(pid, &command.SelectAlgorithm{Name: sel.previous})
+				state.ackRecorder.AddToWaitList(pid.GetId())
+			}
+			return
+		}
+		state.finishSelectAlgorithm(context, &command.SelectAlgorithmAck{Error: sel.err})
+		return
+
+	default:
+		state.ActorUtil.Fail(context, fmt.Errorf("[waitSelectAlgorithm] unhandled corrdinator command: command=%#v", cmd))
+		return
+	}
+}
+
+func (state *coordinatorActor) finishSelectAlgorithm(context actor.Context, ack *command.SelectAlgorithmAck) {
+	state.ackRecorder.Clear()
+	state.selection = nil
+	state.respond(context, ack)
+	state.behavior.Become(state.idle)
+}
+
 func (state *coordinatorActor) waitLoadPartitionVertices(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.LoadPartitionVerticesWorkerAck:
@@ -257,6 +888,7 @@ func (state *coordinatorActor) waitLoadPartitionVertices(context actor.Context)
 			state.ActorUtil.LogError(context, fmt.Sprintf("loadPartitionVertices ack from unknown worker: %v", cmd.WorkerPid))
 			return
 		}
+		state.recordPartitionStats(cmd.Partitions)
 		if state.ackRecorder.HasCompleted() {
 			state.ackRecorder.Clear()
 			state.behavior.Become(state.idle)
@@ -279,16 +911,7 @@ func (state *coordinatorActor) superstep(context actor.Context) {
 		}
 		if state.ackRecorder.HasCompleted() {
 			state.ackRecorder.Clear()
-			for _, wi := range state.clusterInfo.WorkerInfo {
-				context.Request(wi.WorkerPid, &command.Compute{
-					SuperStep:        state.currentStep,
-					AggregatedValues: state.lastAggregatedValue.values,
-				})
-				state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
-			}
-			state.behavior.Become(state.computing)
-			state.stateName = CoordinatorStateProcessingComputing
-			state.ActorUtil.LogDebug(context, fmt.Sprintf("start computing: step=%v", state.currentStep))
+			state.startComputing(context)
 		}
 		return
 
@@ -304,9 +927,22 @@ func (state *coordinatorActor) computing(context actor.Context) {
 			state.ActorUtil.LogError(context, fmt.Sprintf("compute ack from unknown worker: %v", cmd.WorkerPid))
 			return
 		}
+		state.recordPartitionStats(cmd.Partitions)
+		state.recordTraffic(cmd.Traffic)
+		state.recordBatchStats(cmd.MessageBatches)
+		state.recordFlowStats(cmd.FlowControl)
+		if cmd.Error != "" && state.jobErr == "" {
+			state.jobErr = cmd.Error
+		}
+		state.vertexMoves = append(state.vertexMoves, cmd.VertexMoves...)
 
 		if cmd.AggregatedValues != nil {
-			if err := aggregateValueMap(state.plugin.GetAggregators(), state.aggregatedCurrentStep, cmd.AggregatedValues); err != nil {
+			values, err := unmarshalAggregatedValues(state.plugin.GetAggregators(), cmd.AggregatedValues)
+			if err != nil {
+				state.ActorUtil.Fail(context, err)
+				return
+			}
+			if err := aggregateValues(state.plugin.GetAggregators(), state.aggregatedCurrentStep, values); err != nil {
 				state.ActorUtil.Fail(context, err)
 				return
 			}
@@ -321,31 +957,41 @@ func (state *coordinatorActor) computing(context actor.Context) {
 				return
 			}
 
+			// update aggregated values, they are marshaled once for all workers
+			marshaled, err := marshalAggregatedValues(state.plugin.GetAggregators(), state.aggregatedCurrentStep)
+			if err != nil {
+				state.ActorUtil.Fail(context, err)
+				return
+			}
+			state.lastAggregatedValue.superstep = state.currentStep
+			state.lastAggregatedValue.values = state.aggregatedCurrentStep
+			state.lastAggregatedValue.marshaled = marshaled
+			state.aggregatedCurrentStep = make(map[string]plugin.AggregatableValue)
+			state.recordStats(stats)
+			state.trafficSteps++
+
+			if state.jobErr != "" {
+				state.ActorUtil.LogError(context, fmt.Sprintf("supersteps stopped: step=%v, %s", state.currentStep, state.jobErr))
+				state.behavior.Become(state.idle)
+				state.stateName = CoordinatorStateIdle
+				return
+			}
+
 			// As the number of actives is often incorrect I have to check the number of messages
 			// Vertex actor returns its active state with ComputeAck, but then it may receives a message until the next superstep is started
 			if stats.ActiveVertices == 0 && stats.MessagesSent == 0 {
 				// finish superstep
+				state.ActorUtil.LogInfo(context, fmt.Sprintf("finish computing: step=%v", state.currentStep))
+				if state.pipeline != nil && state.pipeline.next() {
+					state.startStage(context)
+					return
+				}
 				state.behavior.Become(state.idle)
 				state.stateName = CoordinatorStateIdle
-				state.ActorUtil.LogInfo(context, fmt.Sprintf("finish computing: step=%v", state.currentStep))
 
-			} else {
-				// move step forward
-				state.currentStep += uint64(1)
-				for _, wi := range state.clusterInfo.WorkerInfo {
-					context.Request(wi.WorkerPid, &command.SuperStepBarrier{})
-					state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
-				}
-				// TODO: handle worker timeout
-				state.behavior.Become(state.superstep)
-				state.stateName = CoordinatorStateProcessing
-				state.ActorUtil.LogDebug(context, fmt.Sprintf("----- superstep %v started -----", state.currentStep))
+			} else if !state.rebalanceBetweenSteps(context) && !state.moveVerticesBetweenSteps(context) {
+				state.nextSuperStep(context)
 			}
-
-			// update aggregated values
-			state.lastAggregatedValue.superstep = state.currentStep
-			state.lastAggregatedValue.values = state.aggregatedCurrentStep
-			state.aggregatedCurrentStep = make(map[string]*types.Any)
 		}
 		return
 
@@ -355,8 +1001,323 @@ func (state *coordinatorActor) computing(context actor.Context) {
 	}
 }
 
-func (state *coordinatorActor) getStats(aggregated map[string]*types.Any) (*aggregator.VertexStats, error) {
-	v, err := getAggregatedValue(state.plugin.GetAggregators(), aggregated, VertexStatsName)
+// nextSuperStep moves step forward
+func (state *coordinatorActor) nextSuperStep(context actor.Context) {
+	state.vertexMoves = nil
+	state.currentStep += uint64(1)
+	state.lastAggregatedValue.superstep = state.currentStep
+	state.ActorUtil.LogDebug(context, fmt.Sprintf("----- superstep %v started -----", state.currentStep))
+	if !state.superstepBarrier {
+		// vertices start the superstep by Compute, messages of the superstep arriving before it are kept apart
+		state.startComputing(context)
+		return
+	}
+	for _, wi := range state.clusterInfo.WorkerInfo {
+		context.Request(wi.WorkerPid, &command.SuperStepBarrier{SuperStep: state.currentStep})
+		state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
+	}
+	// TODO: handle worker timeout
+	state.behavior.Become(state.superstep)
+	state.stateName = CoordinatorStateProcessing
+}
+
+// startComputing sends Compute of the current step to workers
+func (state *coordinatorActor) startComputing(context actor.Context) {
+	for _, wi := range state.clusterInfo.WorkerInfo {
+		compute := &command.Compute{
+			SuperStep:          state.currentStep,
+			AggregatedValues:   state.lastAggregatedValue.marshaled,
+			TrackVertexTraffic: state.movesVertices(),
+			NumericVertexIds:   state.numericVertexIDs,
+			FlowControl:        state.flowControl,
+		}
+		if state.pipeline != nil {
+			compute.Stage = state.pipeline.currentStage().Name
+			compute.StageParams = state.pipeline.currentStage().Params
+		}
+		context.Request(wi.WorkerPid, compute)
+		state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
+	}
+	state.behavior.Become(state.computing)
+	state.stateName = CoordinatorStateProcessingComputing
+	state.ActorUtil.LogDebug(context, fmt.Sprintf("start computing: step=%v", state.currentStep))
+}
+
+// rebalanceBetweenSteps starts moving partitions if loads of workers are imbalanced, then the next superstep is started after that
+func (state *coordinatorActor) rebalanceBetweenSteps(context actor.Context) bool {
+	if state.rebalanceThreshold <= 0 || len(state.clusterInfo.WorkerInfo) < 2 {
+		return false
+	}
+	if _, ok := vertexMarshalerOf(state.plugin); !ok {
+		return false
+	}
+	ratio := imbalance(state.clusterInfo.WorkerInfo, state.partitionStats)
+	if ratio <= state.rebalanceThreshold {
+		return false
+	}
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("rebalance workers: imbalance=%.2f", ratio))
+	state.migration = &migration{
+		workers: state.clusterInfo.WorkerInfo,
+		resume:  true,
+	}
+	state.startMigration(context)
+	return true
+}
+
+// movesVertices returns if vertices are moved to partitions they send most messages to
+func (state *coordinatorActor) movesVertices() bool {
+	if state.maxVertexMoves == 0 {
+		return false
+	}
+	_, ok := vertexMarshalerOf(state.plugin)
+	return ok
+}
+
+// moveVerticesBetweenSteps starts moving vertices proposed by workers, then the next superstep is started after that
+func (state *coordinatorActor) moveVerticesBetweenSteps(context actor.Context) bool {
+	if !state.movesVertices() || len(state.vertexMoves) == 0 {
+		return false
+	}
+	vertices := make(map[uint64]uint64)
+	for _, wi := range state.clusterInfo.WorkerInfo {
+		for _, p := range wi.Partitions {
+			vertices[p] = state.partitionStats[p].GetVertices()
+		}
+	}
+	groups := planVertexMoves(state.vertexMoves, vertices, state.currentStep, int(state.maxVertexMoves))
+	state.vertexMoves = nil
+	if len(groups) == 0 {
+		return false
+	}
+	routes := make(map[string]uint64, len(state.clusterInfo.MovedVertices))
+	for id, p := range state.clusterInfo.MovedVertices {
+		routes[id] = p
+	}
+	state.vertexMigration = &vertexMigration{groups: groups, routes: routes}
+	state.behavior.Become(state.migratingVertices)
+	state.stateName = CoordinatorStateMigratingVertices
+	state.exportNextVertices(context)
+	return true
+}
+
+func (state *coordinatorActor) exportNextVertices(context actor.Context) {
+	m := state.vertexMigration
+	if m.next >= len(m.groups) {
+		state.finishVertexMigration(context, "")
+		return
+	}
+	g := m.groups[m.next]
+	from := state.router.workerOfPartition(g.from)
+	if from == nil || state.router.workerOfPartition(g.to) == nil {
+		state.finishVertexMigration(context, fmt.Sprintf("partition is not found: %v -> %v", g.from, g.to))
+		return
+	}
+	context.Request(from.WorkerPid, &command.ExportVertices{PartitionId: g.from, VertexIds: g.vertices})
+}
+
+func (state *coordinatorActor) migratingVertices(context actor.Context) {
+	m := state.vertexMigration
+	switch cmd := context.Message().(type) {
+	case *command.ExportVerticesAck:
+		if cmd.Error != "" {
+			// vertices are kept in the source partition
+			state.finishVertexMigration(context, fmt.Sprintf("failed to export vertices from partition %v: %s", cmd.PartitionId, cmd.Error))
+			return
+		}
+		g := m.groups[m.next]
+		m.exported = cmd.Vertices
+		context.Request(state.router.workerOfPartition(g.to).WorkerPid, &command.ImportVertices{PartitionId: g.to, Vertices: cmd.Vertices})
+		return
+
+	case *command.ImportVerticesAck:
+		g := m.groups[m.next]
+		if m.rollback {
+			if cmd.Error != "" {
+				state.ActorUtil.Fail(context, fmt.Errorf("vertices are lost, failed to import them back to partition %v: %s", g.from, cmd.Error))
+				return
+			}
+			state.finishVertexMigration(context, fmt.Sprintf("vertices are kept in partition %v", g.from))
+			return
+		}
+		if cmd.Error != "" {
+			state.ActorUtil.LogError(context, fmt.Sprintf("failed to import vertices to partition %v: %s", g.to, cmd.Error))
+			m.rollback = true
+			context.Request(state.router.workerOfPartition(g.from).WorkerPid, &command.ImportVertices{PartitionId: g.from, Vertices: m.exported})
+			return
+		}
+		for _, id := range g.vertices {
+			if p, err := state.plugin.Partition(plugin.VertexID(id), state.clusterInfo.NumOfPartitions()); err == nil && p == g.to {
+				// back to the partition given by the plugin
+				delete(m.routes, id)
+			} else {
+				m.routes[id] = g.to
+			}
+		}
+		m.moved += len(g.vertices)
+		m.exported = nil
+		m.next++
+		state.exportNextVertices(context)
+		return
+
+	default:
+		state.ActorUtil.Fail(context, fmt.Errorf("[migratingVertices] unhandled corrdinator command: command=%#v", cmd))
+		return
+	}
+}
+
+// finishVertexMigration broadcasts routes of moved vertices then starts the next superstep. Vertices moved so far are kept on error
+func (state *coordinatorActor) finishVertexMigration(context actor.Context, err string) {
+	m := state.vertexMigration
+	if err != "" {
+		state.ActorUtil.LogError(context, err)
+	}
+	if m.moved > 0 {
+		state.broadcastClusterInfo(context, &command.ClusterInfo{WorkerInfo: state.clusterInfo.WorkerInfo, MovedVertices: m.routes})
+	}
+	state.movedVertices += uint64(m.moved)
+	state.vertexMigration = nil
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("%d vertices have been moved", m.moved))
+	state.nextSuperStep(context)
+}
+
+// recordPartitionStats keeps the latest stats of partitions reported by workers
+func (state *coordinatorActor) recordPartitionStats(stats []*command.PartitionStats) {
+	if state.partitionStats == nil {
+		state.partitionStats = make(map[uint64]*command.PartitionStats)
+	}
+	for _, s := range stats {
+		state.partitionStats[s.PartitionId] = s
+	}
+}
+
+// recordTraffic accumulates messages between partitions reported by a worker
+func (state *coordinatorActor) recordTraffic(traffic []*command.PartitionTraffic) {
+	if state.traffic == nil {
+		state.traffic = make(map[partitionPair]*command.PartitionTraffic)
+	}
+	for _, t := range traffic {
+		key := partitionPair{src: t.SrcPartition, dest: t.DestPartition}
+		sum, ok := state.traffic[key]
+		if !ok {
+			sum = &command.PartitionTraffic{SrcPartition: t.SrcPartition, DestPartition: t.DestPartition}
+			state.traffic[key] = sum
+		}
+		sum.LocalMessages += t.LocalMessages
+		sum.RemoteMessages += t.RemoteMessages
+	}
+}
+
+// partitionsReport returns stats of partitions and messages between them
+func (state *coordinatorActor) partitionsReport() *command.ShowPartitionsAck {
+	ack := &command.ShowPartitionsAck{SuperSteps: state.trafficSteps, MovedVertices: state.movedVertices}
+	if state.clusterInfo != nil {
+		for _, wi := range state.clusterInfo.WorkerInfo {
+			for _, p := range wi.Partitions {
+				s, ok := state.partitionStats[p]
+				if !ok {
+					s = &command.PartitionStats{PartitionId: p}
+				}
+				ack.Partitions = append(ack.Partitions, &command.ShowPartitionsAck_Partition{Stats: s, Worker: workerName(wi.WorkerPid)})
+				ack.Edges += s.Edges
+				ack.CutEdges += s.CutEdges
+			}
+		}
+	}
+	sort.Slice(ack.Partitions, func(i, j int) bool {
+		return ack.Partitions[i].Stats.PartitionId < ack.Partitions[j].Stats.PartitionId
+	})
+	for _, t := range state.traffic {
+		ack.Traffic = append(ack.Traffic, t)
+		ack.LocalMessages += t.LocalMessages
+		ack.RemoteMessages += t.RemoteMessages
+	}
+	sortTraffic(ack.Traffic)
+	ack.MessageBatches = state.batchStats
+	ack.FlowControl = state.flowStats
+	return ack
+}
+
+// recordBatchStats accumulates size of message batches sent by a worker
+func (state *coordinatorActor) recordBatchStats(stats *command.MessageBatchStats) {
+	if stats == nil {
+		return
+	}
+	if state.batchStats == nil {
+		state.batchStats = &command.MessageBatchStats{}
+	}
+	state.batchStats.Add(stats)
+	if state.stepBatchStats == nil {
+		state.stepBatchStats = &command.MessageBatchStats{}
+	}
+	state.stepBatchStats.Add(stats)
+}
+
+// recordFlowStats accumulates messages held by the flow control and depth of mailboxes of a worker
+func (state *coordinatorActor) recordFlowStats(stats *command.FlowControlStats) {
+	if stats == nil {
+		return
+	}
+	if state.flowStats == nil {
+		state.flowStats = &command.FlowControlStats{}
+	}
+	state.flowStats.Add(stats)
+	if state.stepFlowStats == nil {
+		state.stepFlowStats = &command.FlowControlStats{}
+	}
+	state.stepFlowStats.Add(stats)
+}
+
+func (state *coordinatorActor) startSuperSteps(context actor.Context) {
+	state.aggregatedCurrentStep = make(map[string]plugin.AggregatableValue)
+	state.currentStep = 0
+	state.jobErr = ""
+	// the barrier is always sent at first, vertices reset the queues of the previous run by it
+	for _, wi := range state.clusterInfo.WorkerInfo {
+		context.Request(wi.WorkerPid, &command.SuperStepBarrier{SuperStep: state.currentStep})
+		state.ackRecorder.AddToWaitList(wi.WorkerPid.GetId())
+	}
+	// TODO: handle worker timeout
+	state.behavior.Become(state.superstep)
+	state.stateName = CoordinatorStateProcessing
+	state.ActorUtil.LogInfo(context, "------ superstep 0 started ------")
+}
+
+func (state *coordinatorActor) startStage(context actor.Context) {
+	// aggregated values are not carried over to the next stage
+	state.lastAggregatedValue = lastAggregated{}
+	state.pipeline.start()
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("====== stage %s started ======", state.pipeline.currentStage().Name))
+	state.startSuperSteps(context)
+}
+
+func (state *coordinatorActor) recordStats(stats *aggregator.VertexStats) {
+	s := &SuperStepStats{
+		SuperStep:      state.currentStep,
+		ActiveVertices: stats.ActiveVertices,
+		TotalVertices:  stats.TotalVertices,
+		MessagesSent:   stats.MessagesSent,
+		MessageBatches: state.stepBatchStats,
+		FlowControl:    state.stepFlowStats,
+	}
+	state.stepBatchStats = nil
+	state.stepFlowStats = nil
+	if state.pipeline != nil {
+		s.Stage = state.pipeline.currentStage().Name
+		state.pipeline.step(stats.MessagesSent)
+	}
+	state.history = append(state.history, s)
+}
+
+// respond sends ack to the sender of the pending request once
+func (state *coordinatorActor) respond(context actor.Context, ack interface{}) {
+	if state.respondTo != nil {
+		context.Send(state.respondTo, ack)
+		state.respondTo = nil
+	}
+}
+
+func (state *coordinatorActor) getStats(aggregated map[string]plugin.AggregatableValue) (*aggregator.VertexStats, error) {
+	v, err := getAggregatedValue(aggregated, VertexStatsName)
 	if err != nil {
 		return nil, err
 	}
@@ -370,12 +1331,12 @@ func (state *coordinatorActor) getStats(aggregated map[string]*types.Any) (*aggr
 }
 
 func (state *coordinatorActor) findWorkerInfoByVertex(context actor.Context, vid plugin.VertexID) *command.ClusterInfo_WorkerInfo {
-	p, err := state.plugin.Partition(vid, state.clusterInfo.NumOfPartitions())
+	w, err := state.router.workerOf(vid)
 	if err != nil {
 		state.ActorUtil.LogError(context, fmt.Sprintf("failed to Partition(): %v", err))
 		return nil
 	}
-	return state.clusterInfo.FindWoerkerInfoByPartition(p)
+	return w
 }
 
 func assignPartition(nrOfWorkers int, nrOfPartitions uint64) ([][]uint64, error) {
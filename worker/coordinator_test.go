@@ -1,7 +1,9 @@
 package worker
 
 import (
+	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -133,7 +135,7 @@ func TestNewCoordinatorActor(t *testing.T) {
 		case *command.InitWorker:
 			initCount++
 			receivedPartitions = append(receivedPartitions, cmd.Partitions...)
-			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self()})
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Fingerprint: cmd.Fingerprint})
 			if initCount == 3 {
 				waitCh <- "InitWorker"
 				initCount = 0
@@ -190,7 +192,8 @@ func TestNewCoordinatorActor(t *testing.T) {
 			{Remote: false},
 			{Remote: false},
 		},
-		NrOfPartitions: 10,
+		NrOfPartitions:   10,
+		SuperstepBarrier: true,
 	})
 
 	t.Log("wait for InitWorker")
@@ -229,6 +232,11 @@ func TestNewCoordinatorActor(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if stats := resp.(*command.CoordinatorStatsAck); len(stats.Workers) != 3 {
+		t.Fatalf("unexpected workers: %v", stats.Workers)
+	} else {
+		stats.Workers = nil
+	}
 	// TODO: fix unstable test
 	if diff := cmp.Diff(resp, &command.CoordinatorStatsAck{
 		SuperStep:        1,
@@ -263,6 +271,11 @@ func TestNewCoordinatorActor(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if stats := resp.(*command.CoordinatorStatsAck); len(stats.Workers) != 3 {
+		t.Fatalf("unexpected workers: %v", stats.Workers)
+	} else {
+		stats.Workers = nil
+	}
 	if diff := cmp.Diff(resp, &command.CoordinatorStatsAck{
 		SuperStep:        2,
 		NrOfActiveVertex: 0,
@@ -272,3 +285,581 @@ func TestNewCoordinatorActor(t *testing.T) {
 		t.Fatalf("unexpected stats: %s", diff)
 	}
 }
+
+func TestNewCoordinatorActor_impliedBarrier(t *testing.T) {
+	var mux sync.Mutex
+	var received []string
+	done := make(chan struct{})
+	logger, _ := test.NewNullLogger()
+	plg := &MockedPlugin{
+		PartitionMock: func(id plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+			return plugin.HashPartition(id, numOfPartitions)
+		},
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return []plugin.Aggregator{vertexStatsAggregatorInstance}
+		},
+	}
+
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		mux.Lock()
+		defer mux.Unlock()
+		switch cmd := c.Message().(type) {
+		case *command.InitWorker:
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Fingerprint: cmd.Fingerprint})
+		case *command.SuperStepBarrier:
+			received = append(received, fmt.Sprintf("barrier %d", cmd.SuperStep))
+			c.Respond(&command.SuperStepBarrierWorkerAck{WorkerPid: c.Self()})
+		case *command.Compute:
+			received = append(received, fmt.Sprintf("compute %d", cmd.SuperStep))
+			active := 1
+			if cmd.SuperStep == 2 {
+				active = 0 // to finish
+			}
+			v, err := vertexStatsAggregatorInstance.MarshalValue(&aggregator.VertexStats{
+				ActiveVertices: uint64(active),
+				TotalVertices:  1,
+				MessagesSent:   uint64(active),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			c.Respond(&command.ComputeWorkerAck{
+				WorkerPid:        c.Self(),
+				AggregatedValues: map[string]*types.Any{VertexStatsName: v},
+			})
+			if active == 0 {
+				close(done)
+			}
+		}
+	})
+
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	})
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, coordinatorProps, func(ctx actor.Context) {})
+	if _, err := proxy.SendAndAwait(context, &command.NewCluster{
+		Workers:        []*command.NewCluster_WorkerReq{{Remote: false}},
+		NrOfPartitions: 1,
+	}, &command.NewClusterAck{}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	proxy.Send(context, &command.StartSuperStep{})
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("supersteps didn't finish")
+	}
+	mux.Lock()
+	defer mux.Unlock()
+	// the barrier is sent only before the first superstep
+	if diff := cmp.Diff([]string{"barrier 0", "compute 0", "compute 1", "compute 2"}, received); diff != "" {
+		t.Fatalf("unexpected commands: %s", diff)
+	}
+}
+
+func TestNewCoordinatorActor_pipeline(t *testing.T) {
+	var mux sync.Mutex
+	var initCount int32
+	var computeCount int32
+	var computed []*command.Compute
+	waitCh := make(chan string, 1)
+	logger, _ := test.NewNullLogger()
+	stageMock := func(name string) plugin.Stage {
+		return &MockedStage{NameMock: func() string { return name }}
+	}
+	plg := &MockedPipelinePlugin{
+		MockedPlugin: MockedPlugin{
+			GetAggregatorsMock: func() []plugin.Aggregator {
+				return []plugin.Aggregator{vertexStatsAggregatorInstance}
+			},
+		},
+		GetStagesMock: func() []plugin.Stage {
+			return []plugin.Stage{stageMock("cc"), stageMock("pagerank")}
+		},
+	}
+
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		mux.Lock()
+		defer mux.Unlock()
+		switch cmd := c.Message().(type) {
+		case *command.InitWorker:
+			initCount++
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Fingerprint: cmd.Fingerprint})
+			if initCount == 2 {
+				waitCh <- "InitWorker"
+			}
+		case *command.SuperStepBarrier:
+			c.Respond(&command.SuperStepBarrierWorkerAck{WorkerPid: c.Self()})
+		case *command.Compute:
+			computeCount++
+			if computeCount%2 == 1 {
+				computed = append(computed, cmd)
+			}
+			// "cc" finishes at step 0, "pagerank" finishes at step 1
+			var sent uint64
+			if cmd.Stage == "pagerank" && cmd.SuperStep == 0 {
+				sent = 1
+			}
+			v, err := vertexStatsAggregatorInstance.MarshalValue(&aggregator.VertexStats{
+				TotalVertices: 1,
+				MessagesSent:  sent,
+			})
+			if err != nil {
+				t.Error(err)
+			}
+			c.Respond(&command.ComputeWorkerAck{
+				WorkerPid:        c.Self(),
+				AggregatedValues: map[string]*types.Any{VertexStatsName: v},
+			})
+			if computeCount == 6 {
+				waitCh <- "Compute"
+			}
+		}
+	})
+
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	})
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, coordinatorProps, func(ctx actor.Context) {})
+
+	proxy.Send(context, &command.NewCluster{
+		Workers:        []*command.NewCluster_WorkerReq{{Remote: false}, {Remote: false}},
+		NrOfPartitions: 4,
+	})
+	if s := <-waitCh; s != "InitWorker" {
+		t.Fatal("unexpected init")
+	}
+
+	res, err := proxy.SendAndAwait(context, &command.StartPipeline{
+		Stages: []*command.PipelineStage{{Name: "cc"}, {Name: "unknown"}},
+	}, &command.StartPipelineAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.(*command.StartPipelineAck).Error == "" {
+		t.Fatal("unknown stage should be rejected")
+	}
+
+	res, err = proxy.SendAndAwait(context, &command.StartPipeline{
+		Stages: []*command.PipelineStage{
+			{Name: "cc"},
+			{Name: "pagerank", Params: map[string]string{"damping": "0.85"}},
+		},
+	}, &command.StartPipelineAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := res.(*command.StartPipelineAck).Error; e != "" {
+		t.Fatal(e)
+	}
+
+	if s := <-waitCh; s != "Compute" {
+		t.Fatal("unexpected compute")
+	}
+
+	mux.Lock()
+	var got []string
+	for _, c := range computed {
+		got = append(got, fmt.Sprintf("%s/%d/%v", c.Stage, c.SuperStep, c.StageParams))
+	}
+	mux.Unlock()
+	if diff := cmp.Diff([]string{
+		"cc/0/map[]",
+		"pagerank/0/map[damping:0.85]",
+		"pagerank/1/map[damping:0.85]",
+	}, got); diff != "" {
+		t.Fatalf("unexpected compute: %s", diff)
+	}
+
+	resp, err := proxy.SendAndAwait(context, &command.ShowPipeline{}, &command.ShowPipelineAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stages := resp.(*command.ShowPipelineAck).Stages
+	if len(stages) != 2 {
+		t.Fatalf("unexpected stages: %#v", stages)
+	}
+	if !stages[0].Completed || stages[0].SuperSteps != 1 || stages[0].NrOfSentMessages != 0 {
+		t.Fatalf("unexpected stats of cc: %#v", stages[0])
+	}
+	if !stages[1].Completed || stages[1].SuperSteps != 2 || stages[1].NrOfSentMessages != 2 {
+		t.Fatalf("unexpected stats of pagerank: %#v", stages[1])
+	}
+}
+
+func TestNewCoordinatorActor_registryMismatch(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	reg := plugin.NewRegistry()
+	for _, name := range []string{"sssp", "pagerank"} {
+		if err := reg.Register(name, &MockedPlugin{
+			GetAggregatorsMock: func() []plugin.Aggregator { return nil },
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plg, err := newRegistryPluginProxy(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		if cmd, ok := c.Message().(*command.InitWorker); ok {
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Algorithms: []string{"sssp"}, Fingerprint: cmd.Fingerprint})
+		}
+	})
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	})
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, coordinatorProps, nil)
+
+	res, err := proxy.SendAndAwait(context, &command.NewCluster{
+		Workers:        []*command.NewCluster_WorkerReq{{Remote: false}},
+		NrOfPartitions: 1,
+	}, &command.NewClusterAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.(*command.NewClusterAck).Error == "" {
+		t.Fatal("worker with different registry should be refused")
+	}
+}
+
+func TestNewCoordinatorActor_selectAlgorithm(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	reg := plugin.NewRegistry()
+	for _, name := range []string{"sssp", "pagerank"} {
+		if err := reg.Register(name, &MockedPlugin{
+			GetAggregatorsMock: func() []plugin.Aggregator { return nil },
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	plg, err := newRegistryPluginProxy(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the second request to select pagerank fails
+	var mux sync.Mutex
+	var pagerankCount int
+	selected := make(map[string][]string)
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		switch cmd := c.Message().(type) {
+		case *command.InitWorker:
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Algorithms: []string{"pagerank", "sssp"}, Fingerprint: cmd.Fingerprint})
+		case *command.SelectAlgorithm:
+			mux.Lock()
+			defer mux.Unlock()
+			ack := &command.SelectAlgorithmAck{WorkerPid: c.Self()}
+			if cmd.Name == "pagerank" {
+				pagerankCount++
+				if pagerankCount == 2 {
+					ack.Error = "failure"
+				}
+			}
+			if ack.Error == "" {
+				selected[c.Self().Id] = append(selected[c.Self().Id], cmd.Name)
+			}
+			c.Respond(ack)
+		}
+	})
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	})
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, coordinatorProps, nil)
+
+	res, err := proxy.SendAndAwait(context, &command.NewCluster{
+		Workers:        []*command.NewCluster_WorkerReq{{Remote: false}, {Remote: false}},
+		NrOfPartitions: 2,
+	}, &command.NewClusterAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := res.(*command.NewClusterAck).Error; e != "" {
+		t.Fatal(e)
+	}
+
+	// the worker which has selected pagerank is rolled back
+	res, err = proxy.SendAndAwait(context, &command.SelectAlgorithm{Name: "pagerank"}, &command.SelectAlgorithmAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.(*command.SelectAlgorithmAck).Error != "failure" {
+		t.Fatalf("unexpected ack: %#v", res)
+	}
+	if plg.activeAlgorithm() != "sssp" {
+		t.Fatalf("coordinator should not switch algorithm: %s", plg.activeAlgorithm())
+	}
+	mux.Lock()
+	var histories [][]string
+	for _, h := range selected {
+		histories = append(histories, h)
+	}
+	mux.Unlock()
+	if diff := cmp.Diff([][]string{{"pagerank", "sssp"}}, histories); diff != "" {
+		t.Fatalf("unexpected selection: %s", diff)
+	}
+
+	res, err = proxy.SendAndAwait(context, &command.SelectAlgorithm{Name: "pagerank"}, &command.SelectAlgorithmAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := res.(*command.SelectAlgorithmAck).Error; e != "" {
+		t.Fatal(e)
+	}
+	if plg.activeAlgorithm() != "pagerank" {
+		t.Fatalf("coordinator should switch algorithm: %s", plg.activeAlgorithm())
+	}
+}
+
+func TestNewCoordinatorActor_fingerprintMismatch(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	plg := &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return []plugin.Aggregator{vertexStatsAggregatorInstance}
+		},
+	}
+
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		if _, ok := c.Message().(*command.InitWorker); ok {
+			// worker built with an older plugin
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Fingerprint: &command.PluginFingerprint{
+				ProtocolVersion: ProtocolVersion,
+				Aggregators:     []string{"max", VertexStatsName},
+			}})
+		}
+	})
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	})
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, coordinatorProps, nil)
+
+	res, err := proxy.SendAndAwait(context, &command.NewCluster{
+		Workers:        []*command.NewCluster_WorkerReq{{Remote: false}},
+		NrOfPartitions: 1,
+	}, &command.NewClusterAck{}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := res.(*command.NewClusterAck).Error; !strings.Contains(e, "different aggregators") {
+		t.Fatalf("unexpected error: %s", e)
+	}
+}
+
+func TestNewCoordinatorActor_fingerprintMismatchOfOneWorker(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	plg := &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return []plugin.Aggregator{vertexStatsAggregatorInstance}
+		},
+	}
+
+	var mu sync.Mutex
+	initialized := 0
+	stopped := 0
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		switch cmd := c.Message().(type) {
+		case *command.InitWorker:
+			mu.Lock()
+			initialized++
+			n := initialized
+			mu.Unlock()
+			fp := cmd.Fingerprint
+			if n == 2 {
+				// only the second worker is built with an older plugin
+				fp = &command.PluginFingerprint{ProtocolVersion: ProtocolVersion, Aggregators: []string{"max", VertexStatsName}}
+			}
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Fingerprint: fp})
+		case *actor.Stopped:
+			mu.Lock()
+			stopped++
+			mu.Unlock()
+		}
+	})
+	context := actor.EmptyRootContext
+	coordinator := context.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	}))
+	defer context.Stop(coordinator)
+
+	newCluster := &command.NewCluster{
+		Workers:        []*command.NewCluster_WorkerReq{{Remote: false}, {Remote: false}, {Remote: false}},
+		NrOfPartitions: 3,
+	}
+	res, err := context.RequestFuture(coordinator, newCluster, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := res.(*command.NewClusterAck).Error; !strings.Contains(e, "different aggregators") {
+		t.Fatalf("unexpected error: %s", e)
+	}
+
+	// acks from the other workers don't make the coordinator idle
+	time.Sleep(50 * time.Millisecond)
+	stats, err := context.RequestFuture(coordinator, &command.CoordinatorStats{}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := stats.(*command.CoordinatorStatsAck); s.State != CoordinatorStateInit || len(s.Workers) != 0 {
+		t.Fatalf("unexpected coordinator state: %v", s)
+	}
+	mu.Lock()
+	if stopped != 3 {
+		t.Fatalf("spawned workers should be stopped: %d", stopped)
+	}
+	mu.Unlock()
+
+	// NewCluster can be retried
+	res, err = context.RequestFuture(coordinator, newCluster, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := res.(*command.NewClusterAck).Error; e != "" {
+		t.Fatalf("unexpected error: %s", e)
+	}
+	stats, err = context.RequestFuture(coordinator, &command.CoordinatorStats{}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := stats.(*command.CoordinatorStatsAck); s.State != CoordinatorStateIdle || len(s.Workers) != 3 {
+		t.Fatalf("unexpected coordinator state: %v", s)
+	}
+}
+
+func TestNewCoordinatorActor_registration(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	plg := &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return []plugin.Aggregator{vertexStatsAggregatorInstance}
+		},
+	}
+	workerProps := actor.PropsFromFunc(func(c actor.Context) {
+		if cmd, ok := c.Message().(*command.InitWorker); ok {
+			c.Respond(&command.InitWorkerAck{WorkerPid: c.Self(), Fingerprint: cmd.Fingerprint})
+		}
+	})
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, workerProps, nil, logger)
+	})
+	context := actor.EmptyRootContext
+
+	t.Run("no workers registered until timeout", func(t *testing.T) {
+		coordinator := context.Spawn(coordinatorProps)
+		res, err := context.RequestFuture(coordinator, &command.NewCluster{
+			NrOfPartitions:            1,
+			MinRegisteredWorkers:      1,
+			RegistrationTimeoutMillis: 50,
+		}, time.Second).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e := res.(*command.NewClusterAck).Error; !strings.Contains(e, "no workers have registered") {
+			t.Fatalf("unexpected error: %s", e)
+		}
+	})
+
+	t.Run("formed with static workers on timeout", func(t *testing.T) {
+		coordinator := context.Spawn(coordinatorProps)
+		f := context.RequestFuture(coordinator, &command.NewCluster{
+			Workers:                   []*command.NewCluster_WorkerReq{{Remote: false}},
+			NrOfPartitions:            1,
+			MinRegisteredWorkers:      2,
+			RegistrationTimeoutMillis: 100,
+		}, time.Second)
+
+		stats, err := context.RequestFuture(coordinator, &command.CoordinatorStats{}, time.Second).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := stats.(*command.CoordinatorStatsAck).State; s != CoordinatorStateWaitingWorkers {
+			t.Fatalf("unexpected state: %s", s)
+		}
+
+		res, err := f.Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e := res.(*command.NewClusterAck).Error; e != "" {
+			t.Fatalf("unexpected error: %s", e)
+		}
+
+		// registration after the cluster is formed
+		res, err = context.RequestFuture(coordinator, &command.RegisterWorker{HostAndPort: "127.0.0.1:8802"}, time.Second).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.(*command.RegisterWorkerAck).Error == "" {
+			t.Fatal("late registration should be refused")
+		}
+	})
+
+	t.Run("registration is idempotent", func(t *testing.T) {
+		coordinator := context.Spawn(coordinatorProps)
+		for i := 0; i < 2; i++ {
+			res, err := context.RequestFuture(coordinator, &command.RegisterWorker{HostAndPort: "127.0.0.1:8802"}, time.Second).Result()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e := res.(*command.RegisterWorkerAck).Error; e != "" {
+				t.Fatalf("unexpected error: %s", e)
+			}
+		}
+	})
+}
+
+func TestCoordinator_showPartitions(t *testing.T) {
+	plg := &maxPlugin{size: 8}
+	coordinator, request, waitUntilIdle := startLocalCluster(t, plg, 2, 4)
+	defer actor.EmptyRootContext.Stop(coordinator)
+
+	actor.EmptyRootContext.Send(coordinator, &command.StartSuperStep{})
+	waitUntilIdle()
+	ack := request(&command.ShowPartitions{}).(*command.ShowPartitionsAck)
+
+	// edges of the ring crossing partitions
+	edges := make(map[partitionPair]bool)
+	var cut uint64
+	for i := 0; i < plg.size; i++ {
+		src, _ := plg.Partition(plugin.VertexID(fmt.Sprintf("v%d", i)), 4)
+		dest, _ := plg.Partition(plugin.VertexID(fmt.Sprintf("v%d", (i+1)%plg.size)), 4)
+		edges[partitionPair{src: src, dest: dest}] = true
+		if src != dest {
+			cut++
+		}
+	}
+	if ack.Edges != 8 || ack.CutEdges != cut {
+		t.Fatalf("unexpected edges: edges=%d cut=%d, want cut=%d", ack.Edges, ack.CutEdges, cut)
+	}
+
+	workerOf := make(map[uint64]string)
+	var vertices uint64
+	for i, p := range ack.Partitions {
+		if p.Stats.PartitionId != uint64(i) {
+			t.Fatalf("partitions are not sorted: %v", ack.Partitions)
+		}
+		workerOf[p.Stats.PartitionId] = p.Worker
+		vertices += p.Stats.Vertices
+	}
+	if len(ack.Partitions) != 4 || vertices != 8 {
+		t.Fatalf("unexpected partitions: %v", ack.Partitions)
+	}
+
+	if ack.SuperSteps == 0 || ack.LocalMessages+ack.RemoteMessages < 8 {
+		t.Fatalf("messages are not counted: %#v", ack)
+	}
+	for _, tr := range ack.Traffic {
+		if !edges[partitionPair{src: tr.SrcPartition, dest: tr.DestPartition}] {
+			t.Fatalf("messages between partitions without edges: %v", tr)
+		}
+		local := workerOf[tr.SrcPartition] == workerOf[tr.DestPartition]
+		if (local && tr.RemoteMessages > 0) || (!local && tr.LocalMessages > 0) {
+			t.Fatalf("messages are counted as wrong locality: %v", tr)
+		}
+	}
+}
@@ -44,6 +44,20 @@ const (
 	APIPathShutdown = "/ctl/shutdown"
 	// APIPathGetVertexValue is path for getting vertex valu
 	APIPathGetVertexValue = "/ctl/vertex/value"
+	// APIPathStartPipeline is path for starting pipeline
+	APIPathStartPipeline = "/ctl/pipeline/start"
+	// APIPathShowPipeline is path for showing stats of pipeline stages
+	APIPathShowPipeline = "/ctl/pipeline"
+	// APIPathSelectAlgorithm is path for selecting active algorithm from registry
+	APIPathSelectAlgorithm = "/ctl/algo"
+	// APIPathAddWorker is path for adding a worker to the cluster
+	APIPathAddWorker = "/ctl/worker/add"
+	// APIPathDrainWorker is path for removing a worker from the cluster
+	APIPathDrainWorker = "/ctl/worker/drain"
+	// APIPathRebalanceWorkers is path for moving partitions to balance loads of workers
+	APIPathRebalanceWorkers = "/ctl/worker/rebalance"
+	// APIPathShowPartitions is path for showing quality of partitioning
+	APIPathShowPartitions = "/ctl/partitions"
 )
 
 func newCtrlServer(coordinator *actor.PID, logger *logrus.Logger) *CtrlServer {
@@ -62,6 +76,13 @@ func newCtrlServer(coordinator *actor.PID, logger *logrus.Logger) *CtrlServer {
 	s.mux.Handle(APIPathShowAggregatedValue, http.HandlerFunc(s.showAggValueHandler))
 	s.mux.Handle(APIPathShutdown, http.HandlerFunc(s.shutdownHandler))
 	s.mux.Handle(APIPathGetVertexValue, http.HandlerFunc(s.getVertexValueHandler))
+	s.mux.Handle(APIPathStartPipeline, http.HandlerFunc(s.startPipelineHandler))
+	s.mux.Handle(APIPathShowPipeline, http.HandlerFunc(s.showPipelineHandler))
+	s.mux.Handle(APIPathSelectAlgorithm, http.HandlerFunc(s.selectAlgorithmHandler))
+	s.mux.Handle(APIPathAddWorker, http.HandlerFunc(s.addWorkerHandler))
+	s.mux.Handle(APIPathDrainWorker, http.HandlerFunc(s.drainWorkerHandler))
+	s.mux.Handle(APIPathRebalanceWorkers, http.HandlerFunc(s.rebalanceWorkersHandler))
+	s.mux.Handle(APIPathShowPartitions, http.HandlerFunc(s.showPartitionsHandler))
 
 	return s
 }
@@ -205,3 +226,140 @@ func (s *CtrlServer) getVertexValueHandler(w http.ResponseWriter, r *http.Reques
 
 	s.respond(w, http.StatusOK, ack)
 }
+
+func (s *CtrlServer) startPipelineHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.redirectAndWait(w, r, &command.StartPipeline{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.StartPipelineAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not start pipeline ack: %#v", res)))
+		return
+	}
+
+	if ack.Error != "" {
+		s.respondError(w, http.StatusBadRequest, errors.New(ack.Error))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
+
+func (s *CtrlServer) showPipelineHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.requestAndWait(w, &command.ShowPipeline{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.ShowPipelineAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not show pipeline ack: %#v", res)))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
+
+func (s *CtrlServer) showPartitionsHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.requestAndWait(w, &command.ShowPartitions{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.ShowPartitionsAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not show partitions ack: %#v", res)))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
+
+func (s *CtrlServer) selectAlgorithmHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.redirectAndWait(w, r, &command.SelectAlgorithm{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.SelectAlgorithmAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not select algorithm ack: %#v", res)))
+		return
+	}
+
+	if ack.Error != "" {
+		s.respondError(w, http.StatusBadRequest, errors.New(ack.Error))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
+
+func (s *CtrlServer) addWorkerHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.redirectAndWait(w, r, &command.AddWorker{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.AddWorkerAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not add worker ack: %#v", res)))
+		return
+	}
+
+	if ack.Error != "" {
+		s.respondError(w, http.StatusBadRequest, errors.New(ack.Error))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
+
+func (s *CtrlServer) drainWorkerHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.redirectAndWait(w, r, &command.DrainWorker{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.DrainWorkerAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not drain worker ack: %#v", res)))
+		return
+	}
+
+	if ack.Error != "" {
+		s.respondError(w, http.StatusBadRequest, errors.New(ack.Error))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
+
+func (s *CtrlServer) rebalanceWorkersHandler(w http.ResponseWriter, r *http.Request) {
+	res, err := s.requestAndWait(w, &command.RebalanceWorkers{})
+	if err != nil {
+		s.respondError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	ack, ok := res.(*command.RebalanceWorkersAck)
+	if !ok {
+		s.respondError(w, http.StatusInternalServerError, errors.New(fmt.Sprintf("not rebalance workers ack: %#v", res)))
+		return
+	}
+
+	if ack.Error != "" {
+		s.respondError(w, http.StatusBadRequest, errors.New(ack.Error))
+		return
+	}
+
+	s.respond(w, http.StatusOK, ack)
+}
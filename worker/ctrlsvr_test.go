@@ -38,7 +38,7 @@ func Test_newCtrlServer(t *testing.T) {
 	ln := testListener(t)
 	go func() {
 		if err := sut.serve(ln); err != nil {
-			t.Fatal(err)
+			t.Error(err)
 		}
 	}()
 	defer sut.shutdown(context.TODO())
@@ -216,6 +216,205 @@ func Test_newCtrlServer(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "start pipeline ok",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if cmd, ok := c.Message().(*command.StartPipeline); ok {
+						if len(cmd.Stages) != 2 || cmd.Stages[1].Params["k"] != "v" {
+							t.Errorf("unexpected stages: %#v", cmd.Stages)
+						}
+						c.Respond(&command.StartPipelineAck{})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   APIPathStartPipeline,
+				req: &command.StartPipeline{
+					Stages: []*command.PipelineStage{
+						{Name: "a"},
+						{Name: "b", Params: map[string]string{"k": "v"}},
+					},
+				},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusOK {
+					t.Fatal("not ok")
+				}
+			},
+		},
+		{
+			name: "start pipeline with unknown stage",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if _, ok := c.Message().(*command.StartPipeline); ok {
+						c.Respond(&command.StartPipelineAck{Error: "a: no such stage"})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   APIPathStartPipeline,
+				req: &command.StartPipeline{
+					Stages: []*command.PipelineStage{{Name: "a"}},
+				},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusBadRequest {
+					t.Fatal("not bad request")
+				}
+			},
+		},
+		{
+			name: "show pipeline ok",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if _, ok := c.Message().(*command.ShowPipeline); ok {
+						c.Respond(&command.ShowPipelineAck{
+							Stages: []*command.StageStats{{Name: "a", SuperSteps: 3, Completed: true}},
+						})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodGet,
+				path:   APIPathShowPipeline,
+				req:    nil,
+			},
+			wantRes: func(r *http.Response) {
+				var ack command.ShowPipelineAck
+				if err := json.NewDecoder(r.Body).Decode(&ack); err != nil {
+					t.Fatal(err)
+				}
+				if r.StatusCode != http.StatusOK {
+					t.Fatal("not ok")
+				}
+				if len(ack.Stages) != 1 || ack.Stages[0].SuperSteps != 3 {
+					t.Fatalf("not match: %#v", ack)
+				}
+			},
+		},
+		{
+			name: "select algorithm ok",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if cmd, ok := c.Message().(*command.SelectAlgorithm); ok {
+						if cmd.Name != "pagerank" {
+							t.Errorf("unexpected algorithm: %s", cmd.Name)
+						}
+						c.Respond(&command.SelectAlgorithmAck{})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   APIPathSelectAlgorithm,
+				req:    &command.SelectAlgorithm{Name: "pagerank"},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusOK {
+					t.Fatal("not ok")
+				}
+			},
+		},
+		{
+			name: "add worker ok",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if cmd, ok := c.Message().(*command.AddWorker); ok {
+						if !cmd.Remote || cmd.HostAndPort != "10.0.0.1:8801" {
+							t.Errorf("unexpected request: %#v", cmd)
+						}
+						c.Respond(&command.AddWorkerAck{})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   APIPathAddWorker,
+				req:    &command.AddWorker{Remote: true, HostAndPort: "10.0.0.1:8801"},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusOK {
+					t.Fatal("not ok")
+				}
+			},
+		},
+		{
+			name: "drain unknown worker",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if _, ok := c.Message().(*command.DrainWorker); ok {
+						c.Respond(&command.DrainWorkerAck{Error: "no such worker"})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   APIPathDrainWorker,
+				req:    &command.DrainWorker{Worker: "unknown"},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusBadRequest {
+					t.Fatalf("unexpected status: %d", r.StatusCode)
+				}
+			},
+		},
+		{
+			name: "show partitions",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if _, ok := c.Message().(*command.ShowPartitions); ok {
+						c.Respond(&command.ShowPartitionsAck{Edges: 10, CutEdges: 3})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodGet,
+				path:   APIPathShowPartitions,
+				req:    &command.ShowPartitions{},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusOK {
+					t.Fatal("not ok")
+				}
+				var ack command.ShowPartitionsAck
+				if err := json.NewDecoder(r.Body).Decode(&ack); err != nil {
+					t.Fatal(err)
+				}
+				if ack.Edges != 10 || ack.CutEdges != 3 {
+					t.Fatalf("unexpected ack: %#v", ack)
+				}
+			},
+		},
+		{
+			name: "rebalance workers",
+			mock: mock{
+				coordinator: func(c actor.Context) {
+					if _, ok := c.Message().(*command.RebalanceWorkers); ok {
+						c.Respond(&command.RebalanceWorkersAck{MovedPartitions: 2})
+					}
+				},
+			},
+			args: args{
+				method: http.MethodPost,
+				path:   APIPathRebalanceWorkers,
+				req:    &command.RebalanceWorkers{},
+			},
+			wantRes: func(r *http.Response) {
+				if r.StatusCode != http.StatusOK {
+					t.Fatal("not ok")
+				}
+				var ack command.RebalanceWorkersAck
+				if err := json.NewDecoder(r.Body).Decode(&ack); err != nil {
+					t.Fatal(err)
+				}
+				if ack.MovedPartitions != 2 {
+					t.Fatalf("unexpected ack: %#v", ack)
+				}
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
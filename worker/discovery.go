@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Resolver looks up DNS records of workers. *net.Resolver satisfies it
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+const (
+	defaultResolveInterval = 2 * time.Second
+	resolveTimeout         = 5 * time.Second
+)
+
+// resolvedWorkers is local message notifying result of DNS lookup
+type resolvedWorkers struct {
+	addresses []string
+	err       error
+}
+
+// resolveWorkers returns sorted host:port of workers
+func resolveWorkers(ctx context.Context, r Resolver, name string, srv bool) ([]string, error) {
+	var addrs []string
+	if srv {
+		_, records, err := r.LookupSRV(ctx, "", "", name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to look up SRV %s", name)
+		}
+		for _, rec := range records {
+			addrs = append(addrs, net.JoinHostPort(strings.TrimSuffix(rec.Target, "."), strconv.Itoa(int(rec.Port))))
+		}
+	} else {
+		host, port, err := net.SplitHostPort(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "worker DNS name must be host:port: %s", name)
+		}
+		hosts, err := r.LookupHost(ctx, host)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to look up %s", host)
+		}
+		for _, h := range hosts {
+			addrs = append(addrs, net.JoinHostPort(h, port))
+		}
+	}
+	sort.Strings(addrs)
+	return addrs, nil
+}
@@ -0,0 +1,122 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+type fakeResolver struct {
+	mux     sync.Mutex
+	hosts   map[string][]string
+	srv     map[string][]*net.SRV
+	lookups int
+}
+
+func (r *fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.lookups++
+	addrs, ok := r.hosts[host]
+	if !ok {
+		return nil, errors.New("no such host")
+	}
+	return addrs, nil
+}
+
+func (r *fakeResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.lookups++
+	records, ok := r.srv[name]
+	if !ok {
+		return "", nil, errors.New("no such host")
+	}
+	return name, records, nil
+}
+
+func (r *fakeResolver) count() int {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	return r.lookups
+}
+
+func Test_resolveWorkers(t *testing.T) {
+	r := &fakeResolver{
+		hosts: map[string][]string{"workers.local": {"10.0.0.2", "10.0.0.1", "fd00::1"}},
+		srv: map[string][]*net.SRV{"_worker._tcp.local": {
+			{Target: "w2.local.", Port: 8802},
+			{Target: "w1.local.", Port: 8803},
+		}},
+	}
+	ctx := context.Background()
+
+	addrs, err := resolveWorkers(ctx, r, "workers.local:8802", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.1:8802", "10.0.0.2:8802", "[fd00::1]:8802"}, addrs); diff != "" {
+		t.Errorf("unexpected addresses: %s", diff)
+	}
+
+	addrs, err = resolveWorkers(ctx, r, "_worker._tcp.local", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"w1.local:8803", "w2.local:8802"}, addrs); diff != "" {
+		t.Errorf("unexpected addresses: %s", diff)
+	}
+
+	if _, err := resolveWorkers(ctx, r, "workers.local", false); err == nil {
+		t.Error("name without port should be rejected")
+	}
+	if _, err := resolveWorkers(ctx, r, "unknown:8802", false); err == nil {
+		t.Error("lookup error should be returned")
+	}
+}
+
+func TestNewCoordinatorActor_discovery(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	plg := &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return []plugin.Aggregator{vertexStatsAggregatorInstance}
+		},
+	}
+	r := &fakeResolver{
+		hosts: map[string][]string{"workers.local": {"10.0.0.1"}},
+	}
+	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(plg, nil, nil, logger, WithResolver(r, 10*time.Millisecond))
+	})
+	context := actor.EmptyRootContext
+	coordinator := context.Spawn(coordinatorProps)
+	defer context.Stop(coordinator)
+
+	// only one of two workers is discovered so coordinator keeps resolving
+	context.Send(coordinator, &command.NewCluster{
+		NrOfPartitions:       2,
+		MinRegisteredWorkers: 2,
+		WorkerDns:            "workers.local:8802",
+	})
+	time.Sleep(100 * time.Millisecond)
+
+	if c := r.count(); c < 2 {
+		t.Fatalf("workers should be resolved repeatedly: %d", c)
+	}
+	stats, err := context.RequestFuture(coordinator, &command.CoordinatorStats{}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := stats.(*command.CoordinatorStatsAck).State; s != CoordinatorStateWaitingWorkers {
+		t.Fatalf("unexpected state: %s", s)
+	}
+}
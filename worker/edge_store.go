@@ -0,0 +1,372 @@
+package worker
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// edgeStore keeps outgoing edges of vertices of a partition in compressed sparse row format.
+// Destinations of a row are sorted and encoded as uvarint deltas, string IDs are interned in names.
+// Rows are appended while vertices are loaded or imported and read by vertices while they compute,
+// appending never modifies bytes of existing rows so iterators don't hold the lock.
+type edgeStore struct {
+	mu         sync.RWMutex
+	valueType  plugin.EdgeValueType
+	numericIDs bool
+	rows       map[plugin.VertexID]int
+	// row i spans edges [edgeStart[i], edgeStart[i+1]), dests [destStart[i], destStart[i+1]) and blob [blobStart[i], blobStart[i+1])
+	edgeStart []uint64
+	destStart []uint64
+	blobStart []uint64
+	dests     []byte
+	uint32s   []uint32
+	float64s  []float64
+	// blob is uvarint length prefixed bytes values
+	blob      []byte
+	names     []plugin.VertexID
+	nameIndex map[plugin.VertexID]uint64
+	// garbage is the number of edges of removed or replaced rows, they are dropped by compact()
+	garbage uint64
+}
+
+// edgePluginOf returns the plugin as EdgePlugin if the active plugin implements it
+func edgePluginOf(plg plugin.Plugin) (plugin.EdgePlugin, bool) {
+	if pp, ok := plg.(*pluginProxy); ok {
+		plg = pp.plugin()
+	}
+	if r, ok := plg.(*loadRecorder); ok {
+		plg = r.Plugin
+	}
+	ep, ok := plg.(plugin.EdgePlugin)
+	return ep, ok
+}
+
+func newEdgeStore(valueType plugin.EdgeValueType, numericIDs bool) *edgeStore {
+	return &edgeStore{
+		valueType:  valueType,
+		numericIDs: numericIDs,
+		rows:       make(map[plugin.VertexID]int),
+		edgeStart:  []uint64{0},
+		destStart:  []uint64{0},
+		blobStart:  []uint64{0},
+		nameIndex:  make(map[plugin.VertexID]uint64),
+	}
+}
+
+// take stores edges handed over by the vertex
+func (s *edgeStore) take(id plugin.VertexID, src plugin.EdgeSource) error {
+	var edges []plugin.OutEdge
+	if err := src.TakeOutEdges(func(e plugin.OutEdge) {
+		edges = append(edges, e)
+	}); err != nil {
+		return errors.Wrapf(err, "failed to take edges: id=%v", id)
+	}
+	return s.add(id, edges)
+}
+
+// add stores edges of the vertex as a new row, the previous row of the vertex becomes garbage
+func (s *edgeStore) add(id plugin.VertexID, edges []plugin.OutEdge) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]uint64, len(edges))
+	for i, e := range edges {
+		if !s.numericIDs {
+			keys[i] = s.intern(e.Dest)
+			continue
+		}
+		n, err := plugin.ParseNumericID(e.Dest)
+		if err != nil {
+			return err
+		}
+		keys[i] = n
+	}
+	order := make([]int, len(edges))
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool { return keys[order[i]] < keys[order[j]] })
+
+	s.removeRow(id)
+	var prev uint64
+	var tmp [binary.MaxVarintLen64]byte
+	for _, i := range order {
+		n := binary.PutUvarint(tmp[:], keys[i]-prev)
+		s.dests = append(s.dests, tmp[:n]...)
+		prev = keys[i]
+		switch s.valueType {
+		case plugin.EdgeValueUint32:
+			s.uint32s = append(s.uint32s, edges[i].Uint32)
+		case plugin.EdgeValueFloat64:
+			s.float64s = append(s.float64s, edges[i].Float64)
+		case plugin.EdgeValueBytes:
+			n := binary.PutUvarint(tmp[:], uint64(len(edges[i].Bytes)))
+			s.blob = append(s.blob, tmp[:n]...)
+			s.blob = append(s.blob, edges[i].Bytes...)
+		}
+	}
+	s.rows[id] = len(s.edgeStart) - 1
+	s.edgeStart = append(s.edgeStart, s.edgeStart[len(s.edgeStart)-1]+uint64(len(edges)))
+	s.destStart = append(s.destStart, uint64(len(s.dests)))
+	s.blobStart = append(s.blobStart, uint64(len(s.blob)))
+	return nil
+}
+
+func (s *edgeStore) intern(dest plugin.VertexID) uint64 {
+	if i, ok := s.nameIndex[dest]; ok {
+		return i
+	}
+	i := uint64(len(s.names))
+	s.names = append(s.names, dest)
+	s.nameIndex[dest] = i
+	return i
+}
+
+// remove forgets edges of the vertex moved to another partition
+func (s *edgeStore) remove(id plugin.VertexID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeRow(id)
+}
+
+func (s *edgeStore) removeRow(id plugin.VertexID) {
+	if r, ok := s.rows[id]; ok {
+		s.garbage += s.edgeStart[r+1] - s.edgeStart[r]
+		delete(s.rows, id)
+	}
+}
+
+// iterator returns edges of the vertex, it's empty if the vertex has no row
+func (s *edgeStore) iterator(id plugin.VertexID) plugin.EdgeIterator {
+	if s == nil {
+		return plugin.NoEdges
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.rows[id]
+	if !ok {
+		return plugin.NoEdges
+	}
+	it := &edgeIterator{
+		valueType:  s.valueType,
+		numericIDs: s.numericIDs,
+		names:      s.names,
+		dests:      s.dests[s.destStart[r]:s.destStart[r+1]],
+		i:          -1,
+	}
+	switch s.valueType {
+	case plugin.EdgeValueUint32:
+		it.uint32s = s.uint32s[s.edgeStart[r]:s.edgeStart[r+1]]
+	case plugin.EdgeValueFloat64:
+		it.float64s = s.float64s[s.edgeStart[r]:s.edgeStart[r+1]]
+	case plugin.EdgeValueBytes:
+		it.blob = s.blob[s.blobStart[r]:s.blobStart[r+1]]
+	}
+	return it
+}
+
+// numOfEdges returns the number of edges of the vertex
+func (s *edgeStore) numOfEdges(id plugin.VertexID) int {
+	if s == nil {
+		return 0
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	r, ok := s.rows[id]
+	if !ok {
+		return 0
+	}
+	return int(s.edgeStart[r+1] - s.edgeStart[r])
+}
+
+// has returns if edges of the vertex are stored
+func (s *edgeStore) has(id plugin.VertexID) bool {
+	if s == nil {
+		return false
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.rows[id]
+	return ok
+}
+
+// export returns edges of the vertex to be moved with it, nil if the vertex has no row
+func (s *edgeStore) export(id plugin.VertexID) *command.OutEdges {
+	if !s.has(id) {
+		return nil
+	}
+	pb := &command.OutEdges{}
+	for it := s.iterator(id); it.Next(); {
+		pb.Dests = append(pb.Dests, string(it.Dest()))
+		switch s.valueType {
+		case plugin.EdgeValueUint32:
+			pb.Uint32Values = append(pb.Uint32Values, it.Uint32())
+		case plugin.EdgeValueFloat64:
+			pb.Float64Values = append(pb.Float64Values, it.Float64())
+		case plugin.EdgeValueBytes:
+			pb.BytesValues = append(pb.BytesValues, append([]byte(nil), it.Bytes()...))
+		}
+	}
+	return pb
+}
+
+// restore stores edges of the vertex moved from another partition
+func (s *edgeStore) restore(id plugin.VertexID, pb *command.OutEdges) error {
+	edges := make([]plugin.OutEdge, len(pb.Dests))
+	for i, dest := range pb.Dests {
+		edges[i].Dest = plugin.VertexID(dest)
+		var ok bool
+		switch s.valueType {
+		case plugin.EdgeValueNone:
+			ok = true
+		case plugin.EdgeValueUint32:
+			if ok = i < len(pb.Uint32Values); ok {
+				edges[i].Uint32 = pb.Uint32Values[i]
+			}
+		case plugin.EdgeValueFloat64:
+			if ok = i < len(pb.Float64Values); ok {
+				edges[i].Float64 = pb.Float64Values[i]
+			}
+		case plugin.EdgeValueBytes:
+			if ok = i < len(pb.BytesValues); ok {
+				edges[i].Bytes = pb.BytesValues[i]
+			}
+		}
+		if !ok {
+			return fmt.Errorf("edge values are missing: id=%v", id)
+		}
+	}
+	return s.add(id, edges)
+}
+
+// bytes returns approximate memory used by the store, overhead of maps is not counted
+func (s *edgeStore) bytes() uint64 {
+	if s == nil {
+		return 0
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	n := 8*(cap(s.edgeStart)+cap(s.destStart)+cap(s.blobStart)) +
+		cap(s.dests) + 4*cap(s.uint32s) + 8*cap(s.float64s) + cap(s.blob) +
+		16*cap(s.names)
+	for _, name := range s.names {
+		n += len(name)
+	}
+	return uint64(n)
+}
+
+// compact copies live rows to new arrays if garbage exceeds them. Iterators keep reading the old arrays
+func (s *edgeStore) compact() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	live := s.edgeStart[len(s.edgeStart)-1] - s.garbage
+	if s.garbage == 0 || s.garbage <= live {
+		return
+	}
+
+	ids := make([]plugin.VertexID, 0, len(s.rows))
+	for id := range s.rows {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return s.rows[ids[i]] < s.rows[ids[j]] })
+
+	c := newEdgeStore(s.valueType, s.numericIDs)
+	for _, id := range ids {
+		r := s.rows[id]
+		c.rows[id] = len(c.edgeStart) - 1
+		c.dests = append(c.dests, s.dests[s.destStart[r]:s.destStart[r+1]]...)
+		switch s.valueType {
+		case plugin.EdgeValueUint32:
+			c.uint32s = append(c.uint32s, s.uint32s[s.edgeStart[r]:s.edgeStart[r+1]]...)
+		case plugin.EdgeValueFloat64:
+			c.float64s = append(c.float64s, s.float64s[s.edgeStart[r]:s.edgeStart[r+1]]...)
+		}
+		c.blob = append(c.blob, s.blob[s.blobStart[r]:s.blobStart[r+1]]...)
+		c.edgeStart = append(c.edgeStart, c.edgeStart[len(c.edgeStart)-1]+s.edgeStart[r+1]-s.edgeStart[r])
+		c.destStart = append(c.destStart, uint64(len(c.dests)))
+		c.blobStart = append(c.blobStart, uint64(len(c.blob)))
+	}
+	// interned names are kept as they are referred by rows
+	c.names, c.nameIndex = s.names, s.nameIndex
+	s.rows, s.edgeStart, s.destStart, s.blobStart = c.rows, c.edgeStart, c.destStart, c.blobStart
+	s.dests, s.uint32s, s.float64s, s.blob = c.dests, c.uint32s, c.float64s, c.blob
+	s.garbage = 0
+}
+
+// edgeIterator decodes a row of edgeStore
+type edgeIterator struct {
+	valueType  plugin.EdgeValueType
+	numericIDs bool
+	names      []plugin.VertexID
+	dests      []byte
+	uint32s    []uint32
+	float64s   []float64
+	blob       []byte
+	i          int
+	key        uint64
+	value      []byte
+}
+
+func (it *edgeIterator) Next() bool {
+	if len(it.dests) == 0 {
+		return false
+	}
+	d, n := binary.Uvarint(it.dests)
+	it.dests = it.dests[n:]
+	it.key += d
+	it.i++
+	if it.valueType == plugin.EdgeValueBytes {
+		l, n := binary.Uvarint(it.blob)
+		it.value = nil
+		if l > 0 {
+			it.value = it.blob[n : n+int(l)]
+		}
+		it.blob = it.blob[n+int(l):]
+	}
+	return true
+}
+
+func (it *edgeIterator) Dest() plugin.VertexID {
+	if it.numericIDs {
+		return plugin.VertexIDOf(it.key)
+	}
+	return it.names[it.key]
+}
+
+func (it *edgeIterator) DestNumeric() uint64 {
+	if it.numericIDs {
+		return it.key
+	}
+	n, _ := plugin.ParseNumericID(it.names[it.key])
+	return n
+}
+
+func (it *edgeIterator) Uint32() uint32 {
+	if it.uint32s == nil {
+		return 0
+	}
+	return it.uint32s[it.i]
+}
+
+func (it *edgeIterator) Float64() float64 {
+	if it.float64s == nil {
+		return 0
+	}
+	return it.float64s[it.i]
+}
+
+func (it *edgeIterator) Bytes() []byte {
+	return it.value
+}
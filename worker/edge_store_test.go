@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func edgesOfIterator(it plugin.EdgeIterator, valueType plugin.EdgeValueType) []plugin.OutEdge {
+	var edges []plugin.OutEdge
+	for it.Next() {
+		e := plugin.OutEdge{Dest: it.Dest()}
+		switch valueType {
+		case plugin.EdgeValueUint32:
+			e.Uint32 = it.Uint32()
+		case plugin.EdgeValueFloat64:
+			e.Float64 = it.Float64()
+		case plugin.EdgeValueBytes:
+			e.Bytes = it.Bytes()
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+func Test_edgeStore(t *testing.T) {
+	tests := []struct {
+		name       string
+		valueType  plugin.EdgeValueType
+		numericIDs bool
+		edges      []plugin.OutEdge
+		// expected is edges in order of destinations
+		expected []plugin.OutEdge
+	}{
+		{
+			name:      "none",
+			valueType: plugin.EdgeValueNone,
+			edges:     []plugin.OutEdge{{Dest: "c"}, {Dest: "a"}, {Dest: "b"}, {Dest: "a"}},
+			// string IDs are ordered by interned index
+			expected: []plugin.OutEdge{{Dest: "c"}, {Dest: "a"}, {Dest: "a"}, {Dest: "b"}},
+		},
+		{
+			name:       "uint32",
+			valueType:  plugin.EdgeValueUint32,
+			numericIDs: true,
+			edges:      []plugin.OutEdge{{Dest: "1000000", Uint32: 1}, {Dest: "3", Uint32: 2}, {Dest: "70", Uint32: 3}},
+			expected:   []plugin.OutEdge{{Dest: "3", Uint32: 2}, {Dest: "70", Uint32: 3}, {Dest: "1000000", Uint32: 1}},
+		},
+		{
+			name:      "float64",
+			valueType: plugin.EdgeValueFloat64,
+			edges:     []plugin.OutEdge{{Dest: "a", Float64: 0.5}, {Dest: "b", Float64: -1}},
+			expected:  []plugin.OutEdge{{Dest: "a", Float64: 0.5}, {Dest: "b", Float64: -1}},
+		},
+		{
+			name:       "bytes",
+			valueType:  plugin.EdgeValueBytes,
+			numericIDs: true,
+			edges:      []plugin.OutEdge{{Dest: "2", Bytes: []byte("xy")}, {Dest: "1"}, {Dest: "5", Bytes: []byte("z")}},
+			expected:   []plugin.OutEdge{{Dest: "1"}, {Dest: "2", Bytes: []byte("xy")}, {Dest: "5", Bytes: []byte("z")}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newEdgeStore(tt.valueType, tt.numericIDs)
+			if err := s.add("src", tt.edges); err != nil {
+				t.Fatal(err)
+			}
+			// another row must not be affected by the row
+			if err := s.add("other", []plugin.OutEdge{{Dest: "9", Bytes: []byte("o"), Uint32: 9, Float64: 9}}); err != nil {
+				t.Fatal(err)
+			}
+			if s.numOfEdges("src") != len(tt.edges) {
+				t.Fatalf("unexpected number of edges: %d", s.numOfEdges("src"))
+			}
+			if diff := cmp.Diff(tt.expected, edgesOfIterator(s.iterator("src"), tt.valueType)); diff != "" {
+				t.Fatalf("unexpected edges: %s", diff)
+			}
+
+			// edges are moved to another store
+			moved := newEdgeStore(tt.valueType, tt.numericIDs)
+			if err := moved.restore("src", s.export("src")); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.expected, edgesOfIterator(moved.iterator("src"), tt.valueType)); diff != "" {
+				t.Fatalf("unexpected moved edges: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_edgeStore_compact(t *testing.T) {
+	s := newEdgeStore(plugin.EdgeValueUint32, true)
+	for i := uint32(0); i < 10; i++ {
+		if err := s.add(plugin.VertexIDOf(uint64(i)), []plugin.OutEdge{{Dest: "1", Uint32: i}, {Dest: "2", Uint32: i}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// replaced rows become garbage
+	if err := s.add("0", []plugin.OutEdge{{Dest: "3", Uint32: 100}}); err != nil {
+		t.Fatal(err)
+	}
+	it := s.iterator("1")
+	before := s.bytes()
+	for i := 2; i < 10; i++ {
+		s.remove(plugin.VertexIDOf(uint64(i)))
+	}
+	if s.export("5") != nil || s.numOfEdges("5") != 0 {
+		t.Fatal("removed edges should not be found")
+	}
+
+	s.compact()
+	if s.garbage != 0 || len(s.uint32s) != 3 {
+		t.Fatalf("garbage should be dropped: garbage=%d edges=%d", s.garbage, len(s.uint32s))
+	}
+	if after := s.bytes(); after >= before {
+		t.Fatalf("memory should be reduced: before=%d after=%d", before, after)
+	}
+	// the iterator created before compaction keeps reading the old row
+	if diff := cmp.Diff([]plugin.OutEdge{{Dest: "1", Uint32: 1}, {Dest: "2", Uint32: 1}}, edgesOfIterator(it, plugin.EdgeValueUint32)); diff != "" {
+		t.Fatalf("unexpected edges: %s", diff)
+	}
+	if diff := cmp.Diff([]plugin.OutEdge{{Dest: "3", Uint32: 100}}, edgesOfIterator(s.iterator("0"), plugin.EdgeValueUint32)); diff != "" {
+		t.Fatalf("unexpected edges: %s", diff)
+	}
+	if diff := cmp.Diff([]plugin.OutEdge{{Dest: "1", Uint32: 1}, {Dest: "2", Uint32: 1}}, edgesOfIterator(s.iterator("1"), plugin.EdgeValueUint32)); diff != "" {
+		t.Fatalf("unexpected edges: %s", diff)
+	}
+}
+
+func Test_edgeStore_nonNumericDest(t *testing.T) {
+	s := newEdgeStore(plugin.EdgeValueNone, true)
+	if err := s.add("1", []plugin.OutEdge{{Dest: "a"}}); err == nil {
+		t.Fatal("destination should be numeric")
+	}
+}
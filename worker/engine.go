@@ -0,0 +1,290 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/sirupsen/logrus"
+)
+
+// JobOptions is options to run a job in-process
+type JobOptions struct {
+	// NumOfWorkers is number of local workers, 1 by default
+	NumOfWorkers int
+	// NumOfPartitions is number of partitions, same as NumOfWorkers by default
+	NumOfPartitions uint64
+	// Stages runs the job as a pipeline if specified
+	Stages []*command.PipelineStage
+	// PollInterval is interval of checking progress of the job, 10ms by default
+	PollInterval time.Duration
+	// Logger is logger for actors, logs are discarded by default
+	Logger *logrus.Logger
+	// RebalanceThreshold moves partitions between supersteps when the most loaded worker exceeds the average by this ratio.
+	// The plugin has to implement plugin.VertexMarshaler.
+	RebalanceThreshold float64
+	// MaxVertexMoves moves up to this number of vertices to partitions they send most messages to between supersteps.
+	// The plugin has to implement plugin.VertexMarshaler.
+	MaxVertexMoves uint32
+	// VertexCacheSize caches partitions of up to this number of vertices in each worker, 0 disables it
+	VertexCacheSize uint32
+	// NumericVertexIDs identifies vertices by integer IDs in messages and partitions. Every vertex ID has to be an integer formatted by plugin.VertexIDOf()
+	NumericVertexIDs bool
+	// MessageBatch sends messages to other workers by a batch per destination worker in compact encoding
+	MessageBatch bool
+	// MessageCompression compresses message batches, "gzip" or "none"
+	MessageCompression string
+	// FlowControl limits messages in flight per vertex, partition and pair of workers, no limit by default
+	FlowControl *command.FlowControl
+	// MessageSpill spills messages queued by vertices and workers to local disk past its memory limit, all in memory by default
+	MessageSpill *command.MessageSpill
+	// PartitionStore keeps vertices on local disk and pages them in to compute, all in memory by default.
+	// The plugin has to implement plugin.VertexMarshaler.
+	PartitionStore *command.PartitionStore
+	// SuperStepBarrier sends the barrier before every superstep and waits for it, which costs another round trip per superstep.
+	// Otherwise Compute implies the barrier
+	SuperStepBarrier bool
+}
+
+// SuperStepStats is stats of a superstep
+type SuperStepStats struct {
+	Stage          string
+	SuperStep      uint64
+	ActiveVertices uint64
+	TotalVertices  uint64
+	MessagesSent   uint64
+	// MessageBatches is size of batches sent between workers, nil unless JobOptions.MessageBatch is set
+	MessageBatches *command.MessageBatchStats
+	// FlowControl is messages held by JobOptions.FlowControl and depth of mailboxes
+	FlowControl *command.FlowControlStats
+}
+
+// JobResult is result of job run in-process
+type JobResult struct {
+	// Vertices are final states of vertices, they are loaded ones and stale if partitions or vertices have been moved.
+	// It's nil with JobOptions.PartitionStore since loaded vertices are dropped once they are stored
+	Vertices map[plugin.VertexID]plugin.Vertex
+	// VertexValues are final values of vertices got by GetValueAsString()
+	VertexValues map[plugin.VertexID]string
+	// AggregatedValues are values aggregated in the last superstep
+	AggregatedValues map[string]plugin.AggregatableValue
+	// SuperSteps are stats of each superstep
+	SuperSteps []*SuperStepStats
+}
+
+// getJobResultLocal is local message to take results of job from coordinator
+type getJobResultLocal struct{}
+
+type getJobResultLocalAck struct {
+	aggregated map[string]plugin.AggregatableValue
+	history    []*SuperStepStats
+}
+
+// loadRecorder records vertices and errors of NewPartitionVertices(). Only IDs are recorded if idsOnly is set, vertices are nil then
+type loadRecorder struct {
+	plugin.Plugin
+	mux      sync.Mutex
+	vertices map[plugin.VertexID]plugin.Vertex
+	idsOnly  bool
+	err      error
+}
+
+func (r *loadRecorder) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	err := r.Plugin.NewPartitionVertices(partitionID, numOfPartitions, func(v plugin.Vertex) {
+		r.mux.Lock()
+		if r.idsOnly {
+			r.vertices[v.GetID()] = nil
+		} else {
+			r.vertices[v.GetID()] = v
+		}
+		r.mux.Unlock()
+		register(v)
+	})
+	if err != nil {
+		r.mux.Lock()
+		r.err = errors.Wrapf(err, "failed to load partition %d", partitionID)
+		r.mux.Unlock()
+	}
+	return err
+}
+
+func (r *loadRecorder) GetStages() []plugin.Stage {
+	if p, ok := r.Plugin.(plugin.PipelinePlugin); ok {
+		return p.GetStages()
+	}
+	return nil
+}
+
+// RunJob builds coordinator and workers in-process, loads vertices via plugin then runs supersteps until the job terminates.
+// The job is stopped when ctx is canceled or Compute() of a vertex returns an error, which is returned then.
+func RunJob(ctx context.Context, plg plugin.Plugin, opts *JobOptions) (*JobResult, error) {
+	if opts == nil {
+		opts = &JobOptions{}
+	}
+	nrOfWorkers := opts.NumOfWorkers
+	if nrOfWorkers <= 0 {
+		nrOfWorkers = 1
+	}
+	nrOfPartitions := opts.NumOfPartitions
+	if nrOfPartitions == 0 {
+		nrOfPartitions = uint64(nrOfWorkers)
+	}
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = 10 * time.Millisecond
+	}
+	logger := opts.Logger
+	if logger == nil {
+		logger = logrus.New()
+		logger.SetOutput(ioutil.Discard)
+	}
+
+	compression, err := parseBatchCompression(opts.MessageCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := plg.(plugin.VertexMarshaler); opts.PartitionStore.GetEnabled() && !ok {
+		return nil, errors.New("partition store requires the plugin implementing VertexMarshaler")
+	}
+
+	recorder := &loadRecorder{
+		Plugin:   plg,
+		vertices: make(map[plugin.VertexID]plugin.Vertex),
+		// stored vertices are paged in as copies, so loaded ones must not be kept alive nor read
+		idsOnly: opts.PartitionStore.GetEnabled(),
+	}
+	proxy := newPluginProxy(recorder)
+	if err := validatePlugin(proxy, nrOfPartitions, opts.NumericVertexIDs); err != nil {
+		return nil, err
+	}
+	proxy.appendAggregators(systemAggregator)
+
+	root := actor.EmptyRootContext
+	coordinator := root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(proxy, workerProps(proxy, logger, nil), func() {}, logger)
+	}))
+	defer func() {
+		_ = root.StopFuture(coordinator).Wait()
+	}()
+
+	request := func(msg interface{}) (interface{}, error) {
+		return root.RequestFuture(coordinator, msg, 30*time.Second).Result()
+	}
+	waitUntilIdle := func() error {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+			}
+			res, err := request(&command.CoordinatorStats{})
+			if err != nil {
+				return err
+			}
+			if ack := res.(*command.CoordinatorStatsAck); ack.State == CoordinatorStateIdle {
+				if ack.Error != "" {
+					return errors.New(ack.Error)
+				}
+				return nil
+			}
+		}
+	}
+
+	workers := make([]*command.NewCluster_WorkerReq, nrOfWorkers)
+	for i := range workers {
+		workers[i] = &command.NewCluster_WorkerReq{Remote: false}
+	}
+	res, err := request(&command.NewCluster{
+		Workers:            workers,
+		NrOfPartitions:     nrOfPartitions,
+		RebalanceThreshold: opts.RebalanceThreshold,
+		MaxVertexMoves:     opts.MaxVertexMoves,
+		VertexCacheSize:    opts.VertexCacheSize,
+		NumericVertexIds:   opts.NumericVertexIDs,
+		MessageBatch:       opts.MessageBatch,
+		MessageCompression: compression,
+		FlowControl:        opts.FlowControl,
+		MessageSpill:       opts.MessageSpill,
+		PartitionStore:     opts.PartitionStore,
+		SuperstepBarrier:   opts.SuperStepBarrier,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize cluster")
+	}
+	if ack, ok := res.(*command.NewClusterAck); !ok || ack.Error != "" {
+		return nil, fmt.Errorf("failed to initialize cluster: %#v", res)
+	}
+
+	// load
+	root.Send(coordinator, &command.LoadPartitionVertices{})
+	if err := waitUntilIdle(); err != nil {
+		return nil, err
+	}
+	recorder.mux.Lock()
+	err = recorder.err
+	recorder.mux.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// compute
+	if len(opts.Stages) > 0 {
+		res, err := request(&command.StartPipeline{Stages: opts.Stages})
+		if err != nil {
+			return nil, err
+		}
+		if ack := res.(*command.StartPipelineAck); ack.Error != "" {
+			return nil, errors.New(ack.Error)
+		}
+	} else {
+		root.Send(coordinator, &command.StartSuperStep{})
+	}
+	if err := waitUntilIdle(); err != nil {
+		return nil, err
+	}
+
+	// collect results
+	res, err = request(&getJobResultLocal{})
+	if err != nil {
+		return nil, err
+	}
+	ack := res.(*getJobResultLocalAck)
+	recorder.mux.Lock()
+	defer recorder.mux.Unlock()
+	result := &JobResult{
+		VertexValues:     make(map[plugin.VertexID]string, len(recorder.vertices)),
+		AggregatedValues: make(map[string]plugin.AggregatableValue),
+		SuperSteps:       ack.history,
+	}
+	if !recorder.idsOnly {
+		result.Vertices = recorder.vertices
+	}
+	for id, v := range recorder.vertices {
+		if !recorder.idsOnly && opts.RebalanceThreshold <= 0 && opts.MaxVertexMoves == 0 {
+			result.VertexValues[id] = v.GetValueAsString()
+			continue
+		}
+		// the vertex may have been moved to another worker or stored
+		res, err := request(&command.GetVertexValue{VertexId: string(id)})
+		if err != nil {
+			return nil, err
+		}
+		result.VertexValues[id] = res.(*command.GetVertexValueAck).Value
+	}
+	for name, v := range ack.aggregated {
+		if isSystemAggregator(name) {
+			continue
+		}
+		result.AggregatedValues[name] = v
+	}
+
+	return result, nil
+}
@@ -0,0 +1,731 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/rerorero/prerogel/plugin/partitioner"
+)
+
+// maxVertex propagates the maximum value in the graph
+type maxVertex struct {
+	id    plugin.VertexID
+	value uint32
+	edges []plugin.VertexID
+	// err is returned by Compute() in superstep 1
+	err error
+	// sums are "sum" aggregated in the previous supersteps
+	sums []uint32
+}
+
+func (v *maxVertex) Compute(ctx plugin.ComputeContext) error {
+	if v.err != nil && ctx.SuperStep() == 1 {
+		return v.err
+	}
+	sum, ok, err := ctx.GetAggregated("sum")
+	if err != nil {
+		return err
+	}
+	if ok {
+		v.sums = append(v.sums, sum.(uint32))
+	}
+	if ctx.SuperStep() > 0 {
+		changed := false
+		for _, m := range ctx.ReceivedMessages() {
+			if n := m.(uint32); n > v.value {
+				v.value = n
+				changed = true
+			}
+		}
+		if !changed {
+			ctx.VoteToHalt()
+			return ctx.PutAggregatable("sum", v.value)
+		}
+	}
+	for _, e := range v.edges {
+		if err := ctx.SendMessageTo(e, v.value); err != nil {
+			return err
+		}
+	}
+	return ctx.PutAggregatable("sum", v.value)
+}
+
+func (v *maxVertex) GetID() plugin.VertexID { return v.id }
+
+func (v *maxVertex) GetValueAsString() string { return strconv.FormatUint(uint64(v.value), 10) }
+
+func (v *maxVertex) OutEdges() []plugin.VertexID { return v.edges }
+
+// maxPlugin loads a ring graph where the value of vertex i is i
+type maxPlugin struct {
+	size    int
+	loadErr error
+	// computeErr is returned by Compute() of v0
+	computeErr error
+}
+
+func (p *maxPlugin) NewVertex(id plugin.VertexID) (plugin.Vertex, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (p *maxPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	if p.loadErr != nil {
+		return p.loadErr
+	}
+	for i := 0; i < p.size; i++ {
+		id := plugin.VertexID(fmt.Sprintf("v%d", i))
+		if part, _ := p.Partition(id, numOfPartitions); part != partitionID {
+			continue
+		}
+		v := &maxVertex{
+			id:    id,
+			value: uint32(i),
+			edges: []plugin.VertexID{plugin.VertexID(fmt.Sprintf("v%d", (i+1)%p.size))},
+		}
+		if i == 0 {
+			v.err = p.computeErr
+		}
+		register(v)
+	}
+	return nil
+}
+
+func (p *maxPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	return plugin.HashPartition(vertex, numOfPartitions)
+}
+
+func (p *maxPlugin) MarshalMessage(msg plugin.Message) (*types.Any, error) {
+	return plugin.ConvertUint32ToAny(msg)
+}
+
+func (p *maxPlugin) UnmarshalMessage(pb *types.Any) (plugin.Message, error) {
+	return plugin.ConvertAnyToUint32(pb)
+}
+
+func (p *maxPlugin) GetCombiner() func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+	return nil
+}
+
+func (p *maxPlugin) GetAggregators() []plugin.Aggregator {
+	return []plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")}
+}
+
+// numericMaxVertex propagates the maximum value in a graph with integer IDs
+type numericMaxVertex struct {
+	id    uint64
+	value uint32
+	edges []uint64
+}
+
+func (v *numericMaxVertex) Compute(ctx plugin.ComputeContext) error {
+	if ctx.SuperStep() > 0 {
+		changed := false
+		for _, m := range ctx.ReceivedMessages() {
+			if n := m.(uint32); n > v.value {
+				v.value = n
+				changed = true
+			}
+		}
+		if !changed {
+			ctx.VoteToHalt()
+			return nil
+		}
+	}
+	for _, e := range v.edges {
+		if err := plugin.SendMessageToNumeric(ctx, e, v.value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *numericMaxVertex) GetID() plugin.VertexID { return plugin.VertexIDOf(v.id) }
+
+func (v *numericMaxVertex) GetNumericID() uint64 { return v.id }
+
+func (v *numericMaxVertex) GetValueAsString() string { return strconv.FormatUint(uint64(v.value), 10) }
+
+// numericMaxPlugin loads a graph of integer IDs where vertex i has value i and edges to i+1 and i+2, messages are combined to the maximum
+type numericMaxPlugin struct {
+	maxPlugin
+	mux      sync.Mutex
+	combined []plugin.VertexID
+}
+
+func (p *numericMaxPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	for i := 0; i < p.size; i++ {
+		if part, _ := p.PartitionNumeric(uint64(i), numOfPartitions); part != partitionID {
+			continue
+		}
+		register(&numericMaxVertex{
+			id:    uint64(i),
+			value: uint32(i),
+			edges: []uint64{uint64((i + 1) % p.size), uint64((i + 2) % p.size)},
+		})
+	}
+	return nil
+}
+
+func (p *numericMaxPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	id, err := plugin.ParseNumericID(vertex)
+	if err != nil {
+		return 0, err
+	}
+	return p.PartitionNumeric(id, numOfPartitions)
+}
+
+func (p *numericMaxPlugin) PartitionNumeric(vertex uint64, numOfPartitions uint64) (uint64, error) {
+	return plugin.HashPartitionNumeric(vertex, numOfPartitions)
+}
+
+func (p *numericMaxPlugin) GetCombiner() func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+	return func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
+		p.mux.Lock()
+		p.combined = append(p.combined, destination)
+		p.mux.Unlock()
+		max := messages[0].(uint32)
+		for _, m := range messages[1:] {
+			if n := m.(uint32); n > max {
+				max = n
+			}
+		}
+		return []plugin.Message{max}, nil
+	}
+}
+
+// rangeMaxPlugin is numericMaxPlugin partitioned by partitioner.Range, which fails for vertices out of the range
+type rangeMaxPlugin struct {
+	numericMaxPlugin
+	partitioner.Range
+}
+
+func (p *rangeMaxPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	for i := 0; i < p.size; i++ {
+		if part, _ := p.PartitionNumeric(uint64(i), numOfPartitions); part != partitionID {
+			continue
+		}
+		register(&numericMaxVertex{
+			id:    uint64(i),
+			value: uint32(i),
+			edges: []uint64{uint64((i + 1) % p.size), uint64((i + 2) % p.size)},
+		})
+	}
+	return nil
+}
+
+func (p *rangeMaxPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	return p.Range.Partition(vertex, numOfPartitions)
+}
+
+func (p *rangeMaxPlugin) PartitionNumeric(vertex uint64, numOfPartitions uint64) (uint64, error) {
+	return p.Range.PartitionNumeric(vertex, numOfPartitions)
+}
+
+func TestRunJob(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := RunJob(ctx, &maxPlugin{size: 5}, &JobOptions{
+		NumOfWorkers:    2,
+		NumOfPartitions: 3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[plugin.VertexID]string{"v0": "4", "v1": "4", "v2": "4", "v3": "4", "v4": "4"}
+	if diff := cmp.Diff(want, res.VertexValues); diff != "" {
+		t.Fatalf("unexpected values: %s", diff)
+	}
+	// only v4 is computed in the last step
+	if diff := cmp.Diff(map[string]plugin.AggregatableValue{"sum": uint32(4)}, res.AggregatedValues); diff != "" {
+		t.Fatalf("unexpected aggregated values: %s", diff)
+	}
+	// v0 is computed in step 1 with the sum of initial values
+	if diff := cmp.Diff([]uint32{10}, res.Vertices["v0"].(*maxVertex).sums); diff != "" {
+		t.Fatalf("unexpected aggregated values of the previous step: %s", diff)
+	}
+	// the maximum value reaches every vertex in 4 steps, then all vertices halt in the next step
+	if len(res.SuperSteps) != 6 {
+		t.Fatalf("unexpected number of supersteps: %d", len(res.SuperSteps))
+	}
+	if s := res.SuperSteps[0]; s.SuperStep != 0 || s.TotalVertices != 5 || s.MessagesSent != 5 {
+		t.Fatalf("unexpected stats of step 0: %#v", s)
+	}
+	if s := res.SuperSteps[5]; s.ActiveVertices != 0 || s.MessagesSent != 0 {
+		t.Fatalf("unexpected stats of the last step: %#v", s)
+	}
+}
+
+func TestRunJob_singlePartition(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// all messages are sent between vertices in the same partition
+	res, err := RunJob(ctx, &maxPlugin{size: 3}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(map[plugin.VertexID]string{"v0": "2", "v1": "2", "v2": "2"}, res.VertexValues); diff != "" {
+		t.Fatalf("unexpected values: %s", diff)
+	}
+}
+
+func TestRunJob_vertexCache(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the cache is smaller than the number of vertices so that it's dropped during the job
+	res, err := RunJob(ctx, &maxPlugin{size: 5}, &JobOptions{
+		NumOfWorkers:    2,
+		NumOfPartitions: 3,
+		VertexCacheSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[plugin.VertexID]string{"v0": "4", "v1": "4", "v2": "4", "v3": "4", "v4": "4"}
+	if diff := cmp.Diff(want, res.VertexValues); diff != "" {
+		t.Fatalf("unexpected values: %s", diff)
+	}
+}
+
+func TestRunJob_numericVertexIDs(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	plg := &numericMaxPlugin{maxPlugin: maxPlugin{size: 6}}
+	res, err := RunJob(ctx, plg, &JobOptions{
+		NumOfWorkers:     2,
+		NumOfPartitions:  4,
+		NumericVertexIDs: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[plugin.VertexID]string{"0": "5", "1": "5", "2": "5", "3": "5", "4": "5", "5": "5"}
+	if diff := cmp.Diff(want, res.VertexValues); diff != "" {
+		t.Fatalf("unexpected values: %s", diff)
+	}
+	if len(plg.combined) == 0 {
+		t.Fatal("messages to other workers should be combined")
+	}
+	for _, dest := range plg.combined {
+		if _, err := plugin.ParseNumericID(dest); err != nil {
+			t.Fatalf("combiner should receive formatted ID: %v", err)
+		}
+	}
+}
+
+func TestRunJob_rangePartitioner(t *testing.T) {
+	for _, numeric := range []bool{false, true} {
+		t.Run(fmt.Sprintf("numeric=%v", numeric), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			plg := &rangeMaxPlugin{numericMaxPlugin: numericMaxPlugin{maxPlugin: maxPlugin{size: 10}}, Range: partitioner.Range{Min: 0, Max: 9}}
+			res, err := RunJob(ctx, plg, &JobOptions{
+				NumOfWorkers:     2,
+				NumOfPartitions:  3,
+				NumericVertexIDs: numeric,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(res.VertexValues) != 10 {
+				t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+			}
+			for id, v := range res.VertexValues {
+				if v != "9" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+		})
+	}
+}
+
+func TestRunJob_messageBatch(t *testing.T) {
+	tests := []struct {
+		name string
+		plg  plugin.Plugin
+		opts *JobOptions
+	}{
+		{
+			name: "vertex ids",
+			plg:  &maxPlugin{size: 10},
+			opts: &JobOptions{NumOfWorkers: 3, NumOfPartitions: 5, MessageBatch: true},
+		},
+		{
+			name: "numeric vertex ids with gzip",
+			plg:  &numericMaxPlugin{maxPlugin: maxPlugin{size: 10}},
+			opts: &JobOptions{NumOfWorkers: 3, NumOfPartitions: 5, MessageBatch: true, MessageCompression: "gzip", NumericVertexIDs: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			res, err := RunJob(ctx, tt.plg, tt.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, v := range res.VertexValues {
+				if v != "9" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+			if len(res.VertexValues) != 10 {
+				t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+			}
+			var batches uint64
+			for _, s := range res.SuperSteps {
+				if s.MessageBatches == nil {
+					continue
+				}
+				batches += s.MessageBatches.Batches
+				if s.MessageBatches.EncodedBytes == 0 || s.MessageBatches.RawBytes == 0 {
+					t.Fatalf("size of batches should be reported: %+v", s.MessageBatches)
+				}
+				if b := s.MessageBatches; b.MaxBatchMessages == 0 || b.MaxBatchBytes < b.AvgBatchBytes() || b.MaxBatchBytes > b.EncodedBytes {
+					t.Fatalf("the largest batch should be reported: %+v", b)
+				}
+			}
+			if batches == 0 {
+				t.Fatal("messages to other workers should be batched")
+			}
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := RunJob(ctx, &maxPlugin{size: 3}, &JobOptions{MessageBatch: true, MessageCompression: "unknown"}); err == nil {
+		t.Fatal("unknown compression should fail")
+	}
+}
+
+func TestRunJob_flowControl(t *testing.T) {
+	for _, batch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("batch=%v", batch), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			res, err := RunJob(ctx, &numericMaxPlugin{maxPlugin: maxPlugin{size: 10}}, &JobOptions{
+				NumOfWorkers:     3,
+				NumOfPartitions:  5,
+				NumericVertexIDs: true,
+				MessageBatch:     batch,
+				FlowControl:      &command.FlowControl{VertexInflight: 1, PartitionInflight: 1, WorkerInflight: 1},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, v := range res.VertexValues {
+				if v != "9" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+			stats := &command.FlowControlStats{}
+			for _, s := range res.SuperSteps {
+				stats.Add(s.FlowControl)
+			}
+			if stats.StalledMessages == 0 {
+				t.Fatalf("messages should be held by the limit: %+v", stats)
+			}
+			if stats.MaxVertexMailbox == 0 || stats.MaxPartitionMailbox == 0 || stats.MaxWorkerMailbox == 0 {
+				t.Fatalf("depth of mailboxes should be reported: %+v", stats)
+			}
+		})
+	}
+}
+
+func TestRunJob_messageSpill(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prerogel-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, batch := range []bool{false, true} {
+		t.Run(fmt.Sprintf("batch=%v", batch), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			res, err := RunJob(ctx, &numericMaxPlugin{maxPlugin: maxPlugin{size: 10}}, &JobOptions{
+				NumOfWorkers:     3,
+				NumOfPartitions:  5,
+				NumericVertexIDs: true,
+				MessageBatch:     batch,
+				// every queued message is spilled, and messages held for other workers stay on disk
+				MessageSpill: &command.MessageSpill{MemoryLimit: 1, Dir: dir},
+				FlowControl:  &command.FlowControl{WorkerInflight: 1},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, v := range res.VertexValues {
+				if v != "9" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+			if len(res.VertexValues) != 10 {
+				t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+			}
+			files, err := ioutil.ReadDir(dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) != 0 {
+				t.Fatalf("spill files should be removed: %d", len(files))
+			}
+		})
+	}
+}
+
+func TestRunJob_partitionStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prerogel-store-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, limit := range []uint64{0, 1} {
+		t.Run(fmt.Sprintf("limit=%v", limit), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			res, err := RunJob(ctx, &movableMaxPlugin{maxPlugin{size: 10}}, &JobOptions{
+				NumOfWorkers:    2,
+				NumOfPartitions: 5,
+				// the limit 1 computes one partition of a worker at a time
+				PartitionStore: &command.PartitionStore{Enabled: true, Dir: dir, MemoryLimit: limit},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, v := range res.VertexValues {
+				if v != "9" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+			if len(res.VertexValues) != 10 {
+				t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+			}
+			if res.Vertices != nil {
+				t.Fatal("loaded vertices should not be kept")
+			}
+			if filesIn(t, dir) != 0 {
+				t.Fatal("store files should not be left")
+			}
+		})
+	}
+}
+
+func TestRunJob_partitionStoreWithoutVertexMarshaler(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := RunJob(ctx, &maxPlugin{size: 10}, &JobOptions{
+		NumOfWorkers:    2,
+		NumOfPartitions: 3,
+		PartitionStore:  &command.PartitionStore{Enabled: true},
+	}); err == nil {
+		t.Fatal("partition store requires VertexMarshaler")
+	}
+}
+
+// edgeMaxVertex is maxVertex whose edges are stored by the partition
+type edgeMaxVertex struct {
+	*maxVertex
+	size int
+}
+
+func (v edgeMaxVertex) TakeOutEdges(add func(e plugin.OutEdge)) error {
+	for _, e := range v.edges {
+		add(plugin.OutEdge{Dest: e, Uint32: v.value})
+	}
+	v.edges = nil
+	return nil
+}
+
+func (v edgeMaxVertex) Compute(ctx plugin.ComputeContext) error {
+	defer func() { v.edges = nil }()
+	for it := plugin.OutEdgesOf(ctx); it.Next(); {
+		// values are the number of the source vertex, which is one less than the destination in the ring
+		if dest := plugin.VertexID(fmt.Sprintf("v%d", (it.Uint32()+1)%uint32(v.size))); it.Dest() != dest {
+			return fmt.Errorf("unexpected edge value: %v->%v", it.Uint32(), it.Dest())
+		}
+		v.edges = append(v.edges, it.Dest())
+	}
+	return v.maxVertex.Compute(ctx)
+}
+
+// edgeMaxPlugin is movableMaxPlugin storing edges of vertices with the number of the source vertex as values
+type edgeMaxPlugin struct {
+	movableMaxPlugin
+}
+
+func (p *edgeMaxPlugin) EdgeValueType() plugin.EdgeValueType {
+	return plugin.EdgeValueUint32
+}
+
+func (p *edgeMaxPlugin) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
+	return p.movableMaxPlugin.NewPartitionVertices(partitionID, numOfPartitions, func(v plugin.Vertex) {
+		register(edgeMaxVertex{v.(*maxVertex), p.size})
+	})
+}
+
+func (p *edgeMaxPlugin) MarshalVertex(v plugin.Vertex) (*types.Any, error) {
+	return p.movableMaxPlugin.MarshalVertex(v.(edgeMaxVertex).maxVertex)
+}
+
+func (p *edgeMaxPlugin) UnmarshalVertex(pb *types.Any) (plugin.Vertex, error) {
+	v, err := p.movableMaxPlugin.UnmarshalVertex(pb)
+	if err != nil {
+		return nil, err
+	}
+	return edgeMaxVertex{v.(*maxVertex), p.size}, nil
+}
+
+func TestRunJob_storedEdges(t *testing.T) {
+	for _, store := range []bool{false, true} {
+		t.Run(fmt.Sprintf("store=%v", store), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// edges are moved with vertices
+			res, err := RunJob(ctx, &edgeMaxPlugin{movableMaxPlugin{maxPlugin{size: 10}}}, &JobOptions{
+				NumOfWorkers:       3,
+				NumOfPartitions:    7,
+				RebalanceThreshold: 0.01,
+				PartitionStore:     &command.PartitionStore{Enabled: store},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, v := range res.VertexValues {
+				if v != "9" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+			if len(res.VertexValues) != 10 {
+				t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+			}
+		})
+	}
+}
+
+func TestRunJob_superStepBarrier(t *testing.T) {
+	for _, barrier := range []bool{false, true} {
+		t.Run(fmt.Sprintf("barrier=%v", barrier), func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			// flow control holds messages so that vertices computing the next superstep send messages to ones which haven't started it
+			res, err := RunJob(ctx, &numericMaxPlugin{maxPlugin: maxPlugin{size: 20}}, &JobOptions{
+				NumOfWorkers:     3,
+				NumOfPartitions:  5,
+				NumericVertexIDs: true,
+				FlowControl:      &command.FlowControl{VertexInflight: 1},
+				SuperStepBarrier: barrier,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for id, v := range res.VertexValues {
+				if v != "19" {
+					t.Fatalf("unexpected value of %v: %s", id, v)
+				}
+			}
+			// the maximum value goes two vertices forward per superstep, then a superstep finds nothing changed
+			if len(res.SuperSteps) != 12 {
+				t.Fatalf("unexpected number of supersteps: %d", len(res.SuperSteps))
+			}
+		})
+	}
+}
+
+// BenchmarkRunJob_superStepBarrier compares jobs of many cheap supersteps with and without the barrier before every superstep
+func BenchmarkRunJob_superStepBarrier(b *testing.B) {
+	for _, barrier := range []bool{true, false} {
+		b.Run(fmt.Sprintf("barrier=%v", barrier), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				// 200 vertices in the ring take 201 supersteps
+				res, err := RunJob(context.Background(), &maxPlugin{size: 200}, &JobOptions{
+					NumOfWorkers:     3,
+					NumOfPartitions:  6,
+					PollInterval:     time.Millisecond,
+					SuperStepBarrier: barrier,
+				})
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(res.SuperSteps) != 201 {
+					b.Fatalf("unexpected number of supersteps: %d", len(res.SuperSteps))
+				}
+			}
+		})
+	}
+}
+
+func TestRunJob_rebalance(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// vertices are moved between supersteps whenever workers are slightly imbalanced
+	res, err := RunJob(ctx, &movableMaxPlugin{maxPlugin{size: 10}}, &JobOptions{
+		NumOfWorkers:       3,
+		NumOfPartitions:    7,
+		RebalanceThreshold: 0.01,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, v := range res.VertexValues {
+		if v != "9" {
+			t.Fatalf("unexpected value of %v: %s", id, v)
+		}
+	}
+	if len(res.VertexValues) != 10 {
+		t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+	}
+}
+
+func TestRunJob_errors(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := RunJob(ctx, &maxPlugin{size: 3, loadErr: errors.New("broken loader")}, nil); err == nil {
+		t.Fatal("load error should be returned")
+	}
+
+	if _, err := RunJob(ctx, &maxPlugin{size: 3}, &JobOptions{
+		Stages: []*command.PipelineStage{{Name: "unknown"}},
+	}); err == nil {
+		t.Fatal("unknown stage should be rejected")
+	}
+
+	// the job stops without waiting for ctx
+	if _, err := RunJob(ctx, &maxPlugin{size: 3, computeErr: errors.New("boom")}, &JobOptions{NumOfWorkers: 2}); err == nil || !strings.Contains(err.Error(), "boom") {
+		t.Fatalf("compute error should be returned: %v", err)
+	}
+
+	canceled, cancel2 := context.WithCancel(context.Background())
+	cancel2()
+	if _, err := RunJob(canceled, &maxPlugin{size: 3}, nil); err != context.Canceled {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
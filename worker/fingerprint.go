@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// fingerprintOf returns fingerprint of the plugin which has to be same between master and workers
+func fingerprintOf(plg plugin.Plugin) *command.PluginFingerprint {
+	fp := &command.PluginFingerprint{
+		ProtocolVersion: ProtocolVersion,
+	}
+	for _, agg := range plg.GetAggregators() {
+		fp.Aggregators = append(fp.Aggregators, agg.Name())
+	}
+	sort.Strings(fp.Aggregators)
+
+	if pp, ok := plg.(*pluginProxy); ok {
+		plg = pp.plugin()
+	}
+	if v, ok := plg.(plugin.VersionedPlugin); ok {
+		fp.Version = v.Version()
+		fp.MessageTypes = append(fp.MessageTypes, v.MessageTypes()...)
+		sort.Strings(fp.MessageTypes)
+	}
+	return fp
+}
+
+// compareFingerprint returns descriptive error if fingerprints are different
+func compareFingerprint(expected, actual *command.PluginFingerprint) error {
+	if actual == nil {
+		return fmt.Errorf("no fingerprint, protocol version %d is expected", expected.ProtocolVersion)
+	}
+	if expected.ProtocolVersion != actual.ProtocolVersion {
+		return fmt.Errorf("different protocol version: expected=%d actual=%d", expected.ProtocolVersion, actual.ProtocolVersion)
+	}
+	if expected.Version != actual.Version {
+		return fmt.Errorf("different plugin version: expected=%q actual=%q", expected.Version, actual.Version)
+	}
+	if !equalStrings(expected.Aggregators, actual.Aggregators) {
+		return fmt.Errorf("different aggregators: expected=%v actual=%v", expected.Aggregators, actual.Aggregators)
+	}
+	if !equalStrings(expected.MessageTypes, actual.MessageTypes) {
+		return fmt.Errorf("different message types: expected=%v actual=%v", expected.MessageTypes, actual.MessageTypes)
+	}
+	return nil
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
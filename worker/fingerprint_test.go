@@ -0,0 +1,99 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+type versionedPluginMock struct {
+	*MockedPlugin
+	version string
+}
+
+func (p *versionedPluginMock) Version() string {
+	return p.version
+}
+
+func (p *versionedPluginMock) MessageTypes() []string {
+	return []string{"sssp.SSSPMessage", "pagerank.Rank"}
+}
+
+func Test_fingerprintOf(t *testing.T) {
+	plg := &versionedPluginMock{
+		MockedPlugin: &MockedPlugin{
+			GetAggregatorsMock: func() []plugin.Aggregator {
+				return []plugin.Aggregator{aggregator.NewSumUint32Aggregator("sum")}
+			},
+		},
+		version: "v1.2.0",
+	}
+	pp := newPluginProxy(plg).appendAggregators(systemAggregator)
+
+	want := &command.PluginFingerprint{
+		ProtocolVersion: ProtocolVersion,
+		Version:         "v1.2.0",
+		Aggregators:     []string{VertexStatsName, "sum"},
+		MessageTypes:    []string{"pagerank.Rank", "sssp.SSSPMessage"},
+	}
+	if diff := cmp.Diff(want, fingerprintOf(pp)); diff != "" {
+		t.Fatalf("unexpected fingerprint: %s", diff)
+	}
+}
+
+func Test_compareFingerprint(t *testing.T) {
+	expected := &command.PluginFingerprint{
+		ProtocolVersion: ProtocolVersion,
+		Version:         "v1",
+		Aggregators:     []string{"sum"},
+		MessageTypes:    []string{"msg"},
+	}
+	tests := []struct {
+		name    string
+		actual  *command.PluginFingerprint
+		wantErr string
+	}{
+		{
+			name:   "same",
+			actual: &command.PluginFingerprint{ProtocolVersion: ProtocolVersion, Version: "v1", Aggregators: []string{"sum"}, MessageTypes: []string{"msg"}},
+		},
+		{
+			name:    "no fingerprint",
+			actual:  nil,
+			wantErr: "no fingerprint",
+		},
+		{
+			name:    "protocol",
+			actual:  &command.PluginFingerprint{ProtocolVersion: ProtocolVersion + 1, Version: "v1", Aggregators: []string{"sum"}, MessageTypes: []string{"msg"}},
+			wantErr: "different protocol version",
+		},
+		{
+			name:    "version",
+			actual:  &command.PluginFingerprint{ProtocolVersion: ProtocolVersion, Version: "v0", Aggregators: []string{"sum"}, MessageTypes: []string{"msg"}},
+			wantErr: "different plugin version",
+		},
+		{
+			name:    "message types",
+			actual:  &command.PluginFingerprint{ProtocolVersion: ProtocolVersion, Version: "v1", Aggregators: []string{"sum"}},
+			wantErr: "different message types",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := compareFingerprint(expected, tt.actual)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package worker
+
+import (
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/util"
+)
+
+// requestFunc sends a message expecting an ack, actor.Context.Request
+type requestFunc func(pid *actor.PID, message interface{})
+
+// outbox limits messages in flight to a destination.
+// Compute() can't block as acks come to the same actor, so messages beyond the limit are held until others are acked.
+type outbox struct {
+	// limit is the number of messages in flight, 0 means no limit
+	limit    uint64
+	inflight uint64
+	held     []heldMessage
+	head     int
+	// messages held by sendProto are kept on disk past the memory limit of spill
+	spill  *messageSpill
+	onDisk *heldSpill
+	// stats of held messages
+	stalled    uint64
+	stallStart time.Time
+	stall      time.Duration
+}
+
+type heldMessage struct {
+	to  *actor.PID
+	msg interface{}
+}
+
+// reset forgets messages and stats, then sets the limit
+func (o *outbox) reset(limit uint32) {
+	o.release()
+	*o = outbox{limit: uint64(limit), held: o.held[:0]}
+}
+
+// release removes messages held on disk
+func (o *outbox) release() {
+	if o.onDisk != nil {
+		o.onDisk.close()
+		o.onDisk = nil
+	}
+}
+
+// send requests the message unless the limit is reached, otherwise holds it
+func (o *outbox) send(request requestFunc, to *actor.PID, msg interface{}) {
+	if !o.holds() {
+		o.inflight++
+		request(to, msg)
+		return
+	}
+	o.startStall()
+	o.held = append(o.held, heldMessage{to: to, msg: msg})
+	o.stalled++
+}
+
+// sendProto is send which holds messages on disk if spill is enabled, so that messages read back from spilled runs don't gather in memory
+func (o *outbox) sendProto(request requestFunc, to *actor.PID, msg proto.Message) error {
+	if !o.holds() || !o.spill.enabled() {
+		o.send(request, to, msg)
+		return nil
+	}
+	if o.onDisk == nil {
+		h, err := newHeldSpill(o.spill)
+		if err != nil {
+			return err
+		}
+		o.onDisk = h
+	}
+	o.startStall()
+	if err := o.onDisk.push(to, msg); err != nil {
+		return errors.Wrap(err, "failed to hold message")
+	}
+	o.stalled++
+	return nil
+}
+
+// ack counts an ack of a message then sends held messages up to the limit
+func (o *outbox) ack(request requestFunc) error {
+	if o.inflight > 0 {
+		o.inflight--
+	}
+	if o.numOfHeld() == 0 {
+		return nil
+	}
+	for o.inflight < o.limit && o.numOfHeld() > 0 {
+		to, msg, err := o.next()
+		if err != nil {
+			return errors.Wrap(err, "failed to read held message")
+		}
+		o.inflight++
+		request(to, msg)
+	}
+	if o.numOfHeld() == 0 {
+		o.held = o.held[:0]
+		o.head = 0
+		o.stall += time.Since(o.stallStart)
+	}
+	return nil
+}
+
+// next takes the oldest held message, messages held in memory precede ones on disk
+func (o *outbox) next() (*actor.PID, interface{}, error) {
+	if o.head < len(o.held) {
+		h := o.held[o.head]
+		o.held[o.head] = heldMessage{}
+		o.head++
+		return h.to, h.msg, nil
+	}
+	return o.onDisk.pop()
+}
+
+// holds returns true if a message sent now has to be held
+func (o *outbox) holds() bool {
+	return o.limit > 0 && (o.inflight >= o.limit || o.numOfHeld() > 0)
+}
+
+func (o *outbox) startStall() {
+	if o.numOfHeld() == 0 {
+		o.stallStart = time.Now()
+	}
+}
+
+func (o *outbox) numOfHeld() int {
+	return len(o.held) - o.head + o.onDisk.numOfMessages()
+}
+
+// addStats adds stats of held messages to s, s is allocated if it is nil and messages have been held
+func (o *outbox) addStats(s *command.FlowControlStats) *command.FlowControlStats {
+	if o.stalled == 0 {
+		return s
+	}
+	if s == nil {
+		s = &command.FlowControlStats{}
+	}
+	s.StalledMessages += o.stalled
+	s.StallNanos += o.stall.Nanoseconds()
+	return s
+}
+
+// mailboxGauges measures mailboxes of vertices, partitions and workers spawned by the props of a worker process
+type mailboxGauges struct {
+	vertex    util.MailboxGauge
+	partition util.MailboxGauge
+	worker    util.MailboxGauge
+}
+
+// withGauge measures mailboxes of actors spawned by the props with the gauge
+func withGauge(props *actor.Props, gauge *util.MailboxGauge) *actor.Props {
+	return props.WithMailbox(gauge.Unbounded())
+}
+
+// addStats adds maximum depths since the last call to s
+func (g *mailboxGauges) addStats(s *command.FlowControlStats) *command.FlowControlStats {
+	if s == nil {
+		s = &command.FlowControlStats{}
+	}
+	s.Add(&command.FlowControlStats{
+		MaxVertexMailbox:    g.vertex.TakeMax(),
+		MaxPartitionMailbox: g.partition.TakeMax(),
+		MaxWorkerMailbox:    g.worker.TakeMax(),
+	})
+	return s
+}
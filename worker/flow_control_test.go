@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+)
+
+func Test_outbox(t *testing.T) {
+	var sent []interface{}
+	request := func(pid *actor.PID, msg interface{}) {
+		sent = append(sent, msg)
+	}
+
+	var o outbox
+	o.reset(2)
+	for i := 0; i < 5; i++ {
+		o.send(request, nil, i)
+	}
+	if len(sent) != 2 || o.numOfHeld() != 3 {
+		t.Fatalf("messages should be held over the limit: sent=%v held=%d", sent, o.numOfHeld())
+	}
+
+	o.ack(request)
+	if len(sent) != 3 || sent[2] != 2 {
+		t.Fatalf("a held message should be sent by an ack: %v", sent)
+	}
+	// messages keep their order while others are held
+	o.send(request, nil, 5)
+	o.ack(request)
+	o.ack(request)
+	o.ack(request)
+	if len(sent) != 6 || sent[3] != 3 || sent[4] != 4 || sent[5] != 5 {
+		t.Fatalf("held messages should be sent in order: %v", sent)
+	}
+	if o.numOfHeld() != 0 {
+		t.Fatalf("no message should be held: %d", o.numOfHeld())
+	}
+	stats := o.addStats(nil)
+	if stats == nil || stats.StalledMessages != 4 {
+		t.Fatalf("unexpected stats: %+v", stats)
+	}
+
+	o.reset(0)
+	for i := 0; i < 10; i++ {
+		o.send(request, nil, i)
+	}
+	if len(sent) != 16 || o.addStats(nil) != nil {
+		t.Fatalf("no message should be held without limit: sent=%d", len(sent))
+	}
+}
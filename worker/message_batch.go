@@ -0,0 +1,337 @@
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+)
+
+// Payload of SuperStepMessageBatch is encoded as below, all integers are uvarint.
+//   number of type URLs, then length and bytes of each
+//   number of vertex IDs, then length and bytes of each (none with numeric vertex IDs)
+//   for each message:
+//     index of the type URL
+//     source and destination vertex, index+1 of the vertex ID (0 for no vertex) or the integer ID with numeric vertex IDs
+//     length and bytes of the message value
+// The whole payload is compressed by the compression of the batch.
+
+// inboundSeqFlag marks numbers of messages delivered from batches by the worker, to tell their acks from acks of messages the worker sends to other workers
+const inboundSeqFlag = uint64(1) << 63
+
+func inboundSeq(seq uint64) uint64 {
+	return seq | inboundSeqFlag
+}
+
+func isInboundSeq(seq uint64) bool {
+	return seq&inboundSeqFlag != 0
+}
+
+// inboundBatch is a batch from another worker whose messages are being delivered to vertices.
+// Its messages are numbered up to last by the worker.
+type inboundBatch struct {
+	sender  *actor.PID
+	seq     uint64
+	last    uint64
+	pending int
+}
+
+// parseBatchCompression parses name of compression, empty means no compression
+func parseBatchCompression(name string) (command.BatchCompression, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return command.BATCH_COMPRESSION_NONE, nil
+	case "gzip":
+		return command.BATCH_COMPRESSION_GZIP, nil
+	default:
+		return command.BATCH_COMPRESSION_NONE, fmt.Errorf("unknown message compression: %s", name)
+	}
+}
+
+type batchEncoder struct {
+	numericIDs bool
+	typeURLs   map[string]uint64
+	vertexIDs  map[string]uint64
+	dict       []byte
+	entries    []byte
+	tmp        [binary.MaxVarintLen64]byte
+}
+
+func (e *batchEncoder) putUvarint(b []byte, n uint64) []byte {
+	l := binary.PutUvarint(e.tmp[:], n)
+	return append(b, e.tmp[:l]...)
+}
+
+func (e *batchEncoder) putBytes(b []byte, v []byte) []byte {
+	return append(e.putUvarint(b, uint64(len(v))), v...)
+}
+
+// vertexRef returns index+1 of the vertex ID in the dictionary, the ID is added if it's not found
+func (e *batchEncoder) vertexRef(id string, num uint64) uint64 {
+	if e.numericIDs {
+		return num
+	}
+	if id == "" {
+		return 0
+	}
+	ref, ok := e.vertexIDs[id]
+	if !ok {
+		ref = uint64(len(e.vertexIDs)) + 1
+		e.vertexIDs[id] = ref
+	}
+	return ref
+}
+
+func (e *batchEncoder) typeRef(typeURL string) uint64 {
+	ref, ok := e.typeURLs[typeURL]
+	if !ok {
+		ref = uint64(len(e.typeURLs))
+		e.typeURLs[typeURL] = ref
+	}
+	return ref
+}
+
+// messageBatcher groups messages into a batch for each destination worker as they come.
+// A batch is flushed once it has size messages or maxBytes of messages, 0 means no limit.
+type messageBatcher struct {
+	size     int
+	maxBytes int
+	flush    func(to *actor.PID, msgs []*command.SuperStepMessage) error
+	pending  map[string]*pendingBatch
+	// buffered is the number of messages in pending batches, maxBuffered is its peak
+	buffered    int
+	maxBuffered int
+}
+
+type pendingBatch struct {
+	to    *actor.PID
+	msgs  []*command.SuperStepMessage
+	bytes int
+}
+
+// add appends messages to the batch of the destination, full batches are flushed
+func (b *messageBatcher) add(to *actor.PID, msgs []*command.SuperStepMessage) error {
+	if b.pending == nil {
+		b.pending = make(map[string]*pendingBatch)
+	}
+	p, ok := b.pending[to.GetId()]
+	if !ok {
+		p = &pendingBatch{to: to}
+		b.pending[to.GetId()] = p
+	}
+	for _, m := range msgs {
+		p.msgs = append(p.msgs, m)
+		if b.maxBytes > 0 {
+			p.bytes += m.Size()
+		}
+		b.buffered++
+		if b.buffered > b.maxBuffered {
+			b.maxBuffered = b.buffered
+		}
+		if (b.size > 0 && len(p.msgs) >= b.size) || (b.maxBytes > 0 && p.bytes >= b.maxBytes) {
+			if err := b.flushBatch(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// close flushes the rest of messages
+func (b *messageBatcher) close() error {
+	for _, p := range b.pending {
+		if len(p.msgs) > 0 {
+			if err := b.flushBatch(p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *messageBatcher) flushBatch(p *pendingBatch) error {
+	msgs := p.msgs
+	b.buffered -= len(msgs)
+	p.msgs = nil
+	p.bytes = 0
+	return b.flush(p.to, msgs)
+}
+
+// encodeMessageBatch encodes messages sent to the same worker in the superstep
+func encodeMessageBatch(msgs []*command.SuperStepMessage, numericIDs bool, compression command.BatchCompression) (*command.SuperStepMessageBatch, error) {
+	e := &batchEncoder{
+		numericIDs: numericIDs,
+		typeURLs:   make(map[string]uint64),
+		vertexIDs:  make(map[string]uint64),
+	}
+	batch := &command.SuperStepMessageBatch{
+		Count:            uint32(len(msgs)),
+		NumericVertexIds: numericIDs,
+		Compression:      compression,
+	}
+	for _, m := range msgs {
+		batch.SuperStep = m.SuperStep
+		e.entries = e.putUvarint(e.entries, e.typeRef(m.GetMessage().GetTypeUrl()))
+		e.entries = e.putUvarint(e.entries, e.vertexRef(m.SrcVertexId, m.SrcNumericId))
+		e.entries = e.putUvarint(e.entries, e.vertexRef(m.DestVertexId, m.DestNumericId))
+		e.entries = e.putBytes(e.entries, m.GetMessage().GetValue())
+	}
+
+	e.dict = e.putUvarint(e.dict, uint64(len(e.typeURLs)))
+	for _, s := range sortedDict(e.typeURLs, 0) {
+		e.dict = e.putBytes(e.dict, []byte(s))
+	}
+	e.dict = e.putUvarint(e.dict, uint64(len(e.vertexIDs)))
+	for _, s := range sortedDict(e.vertexIDs, 1) {
+		e.dict = e.putBytes(e.dict, []byte(s))
+	}
+	payload := append(e.dict, e.entries...)
+
+	switch compression {
+	case command.BATCH_COMPRESSION_NONE:
+		batch.Payload = payload
+	case command.BATCH_COMPRESSION_GZIP:
+		var buf bytes.Buffer
+		// batches are sent every superstep, speed matters more than size
+		w, err := gzip.NewWriterLevel(&buf, gzip.BestSpeed)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(payload); err != nil {
+			return nil, errors.Wrap(err, "failed to compress message batch")
+		}
+		if err := w.Close(); err != nil {
+			return nil, errors.Wrap(err, "failed to compress message batch")
+		}
+		batch.Payload = buf.Bytes()
+	default:
+		return nil, fmt.Errorf("unknown message compression: %v", compression)
+	}
+	return batch, nil
+}
+
+// sortedDict returns keys of the dictionary ordered by their indexes starting from base
+func sortedDict(dict map[string]uint64, base uint64) []string {
+	keys := make([]string, len(dict))
+	for k, i := range dict {
+		keys[i-base] = k
+	}
+	return keys
+}
+
+type batchDecoder struct {
+	payload []byte
+	err     error
+}
+
+func (d *batchDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	n, l := binary.Uvarint(d.payload)
+	if l <= 0 {
+		d.err = errors.New("malformed message batch: invalid varint")
+		return 0
+	}
+	d.payload = d.payload[l:]
+	return n
+}
+
+func (d *batchDecoder) bytes() []byte {
+	l := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if uint64(len(d.payload)) < l {
+		d.err = errors.New("malformed message batch: truncated")
+		return nil
+	}
+	b := d.payload[:l:l]
+	d.payload = d.payload[l:]
+	return b
+}
+
+func (d *batchDecoder) strings() []string {
+	n := d.uvarint()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint64(len(d.payload)) {
+		d.err = errors.New("malformed message batch: too many strings")
+		return nil
+	}
+	s := make([]string, 0, n)
+	for i := uint64(0); i < n && d.err == nil; i++ {
+		s = append(s, string(d.bytes()))
+	}
+	return s
+}
+
+// decodeMessageBatch decodes messages in the batch, they are numbered by the worker delivering them
+func decodeMessageBatch(batch *command.SuperStepMessageBatch) ([]*command.SuperStepMessage, error) {
+	d := &batchDecoder{}
+	switch batch.Compression {
+	case command.BATCH_COMPRESSION_NONE:
+		d.payload = batch.Payload
+	case command.BATCH_COMPRESSION_GZIP:
+		r, err := gzip.NewReader(bytes.NewReader(batch.Payload))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decompress message batch")
+		}
+		if d.payload, err = ioutil.ReadAll(r); err != nil {
+			return nil, errors.Wrap(err, "failed to decompress message batch")
+		}
+	default:
+		return nil, fmt.Errorf("unknown message compression: %v", batch.Compression)
+	}
+
+	typeURLs := d.strings()
+	vertexIDs := d.strings()
+	vertexID := func(ref uint64) string {
+		if ref == 0 || d.err != nil {
+			return ""
+		}
+		if ref > uint64(len(vertexIDs)) {
+			d.err = fmt.Errorf("malformed message batch: vertex %d is not in dictionary", ref)
+			return ""
+		}
+		return vertexIDs[ref-1]
+	}
+
+	msgs := make([]*command.SuperStepMessage, 0, batch.Count)
+	for i := 0; i < int(batch.Count) && d.err == nil; i++ {
+		typeRef := d.uvarint()
+		src := d.uvarint()
+		dest := d.uvarint()
+		value := d.bytes()
+		if d.err != nil {
+			break
+		}
+		if typeRef >= uint64(len(typeURLs)) {
+			return nil, fmt.Errorf("malformed message batch: type %d is not in dictionary", typeRef)
+		}
+		m := &command.SuperStepMessage{
+			SuperStep: batch.SuperStep,
+			Message:   &types.Any{TypeUrl: typeURLs[typeRef], Value: value},
+		}
+		if batch.NumericVertexIds {
+			m.SrcNumericId = src
+			m.DestNumericId = dest
+		} else {
+			m.SrcVertexId = vertexID(src)
+			m.DestVertexId = vertexID(dest)
+		}
+		msgs = append(msgs, m)
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return msgs, nil
+}
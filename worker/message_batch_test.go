@@ -0,0 +1,118 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/rerorero/prerogel/command"
+)
+
+func Test_encodeMessageBatch(t *testing.T) {
+	any := func(typeURL string, value string) *types.Any {
+		return &types.Any{TypeUrl: typeURL, Value: []byte(value)}
+	}
+	tests := []struct {
+		name        string
+		msgs        []*command.SuperStepMessage
+		numericIDs  bool
+		compression command.BatchCompression
+	}{
+		{
+			name: "vertex ids",
+			msgs: []*command.SuperStepMessage{
+				{Seq: 1, SuperStep: 3, SrcVertexId: "v1", DestVertexId: "v2", Message: any("type.a", "m1")},
+				{Seq: 1, SuperStep: 3, SrcVertexId: "v2", DestVertexId: "v1", Message: any("type.b", "")},
+				{Seq: 1, SuperStep: 3, SrcVertexId: "", DestVertexId: "v3", Message: any("type.a", "combined")},
+			},
+		},
+		{
+			name: "numeric vertex ids",
+			msgs: []*command.SuperStepMessage{
+				{Seq: 1, SuperStep: 1, SrcNumericId: 1 << 40, DestNumericId: 2, Message: any("type.a", "m1")},
+				{Seq: 1, SuperStep: 1, SrcNumericId: 0, DestNumericId: 1, Message: any("type.a", "m2")},
+			},
+			numericIDs: true,
+		},
+		{
+			name: "gzip",
+			msgs: []*command.SuperStepMessage{
+				{Seq: 1, SuperStep: 2, SrcVertexId: "v1", DestVertexId: "v2", Message: any("type.a", "m1")},
+				{Seq: 1, SuperStep: 2, SrcVertexId: "v1", DestVertexId: "v3", Message: any("type.a", "m1")},
+			},
+			compression: command.BATCH_COMPRESSION_GZIP,
+		},
+		{
+			name: "empty",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch, err := encodeMessageBatch(tt.msgs, tt.numericIDs, tt.compression)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := decodeMessageBatch(batch)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want []*command.SuperStepMessage
+			for _, m := range tt.msgs {
+				w := *m
+				// numbers are given by the worker delivering messages
+				w.Seq = 0
+				want = append(want, &w)
+			}
+			if diff := cmp.Diff(want, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("unexpected messages: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_decodeMessageBatch_malformed(t *testing.T) {
+	batch, err := encodeMessageBatch([]*command.SuperStepMessage{
+		{SrcVertexId: "v1", DestVertexId: "v2", Message: &types.Any{TypeUrl: "type.a", Value: []byte("m1")}},
+	}, false, command.BATCH_COMPRESSION_NONE)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	truncated := *batch
+	truncated.Payload = batch.Payload[:len(batch.Payload)-1]
+	if _, err := decodeMessageBatch(&truncated); err == nil {
+		t.Fatal("truncated batch should fail")
+	}
+
+	tooMany := *batch
+	tooMany.Count = 2
+	if _, err := decodeMessageBatch(&tooMany); err == nil {
+		t.Fatal("batch with less messages than count should fail")
+	}
+
+	notGzip := *batch
+	notGzip.Compression = command.BATCH_COMPRESSION_GZIP
+	if _, err := decodeMessageBatch(&notGzip); err == nil {
+		t.Fatal("uncompressed batch should fail to decompress")
+	}
+}
+
+func Test_parseBatchCompression(t *testing.T) {
+	for name, want := range map[string]command.BatchCompression{
+		"":     command.BATCH_COMPRESSION_NONE,
+		"none": command.BATCH_COMPRESSION_NONE,
+		"GZIP": command.BATCH_COMPRESSION_GZIP,
+	} {
+		got, err := parseBatchCompression(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatalf("%s: got %v, want %v", name, got, want)
+		}
+	}
+	if _, err := parseBatchCompression("snappy"); err == nil {
+		t.Fatal("unknown compression should fail")
+	}
+}
@@ -0,0 +1,247 @@
+package worker
+
+import (
+	"sort"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// partitionMove is a partition to be moved between workers
+type partitionMove struct {
+	partition uint64
+	from      *actor.PID
+	to        *actor.PID
+}
+
+// migration is a pending change of cluster membership
+type migration struct {
+	workers []*command.ClusterInfo_WorkerInfo
+	moves   []*partitionMove
+	next    int
+	added   *actor.PID
+	drained *actor.PID
+	// resume continues supersteps after partitions are moved
+	resume bool
+}
+
+// ack returns response to the request which started the migration
+func (m *migration) ack(err string) interface{} {
+	switch {
+	case m.added != nil:
+		return &command.AddWorkerAck{WorkerPid: m.added, Error: err}
+	case m.drained != nil:
+		return &command.DrainWorkerAck{Error: err}
+	default:
+		return &command.RebalanceWorkersAck{MovedPartitions: uint32(m.next), Error: err}
+	}
+}
+
+// vertexMarshalerOf returns the plugin as VertexMarshaler if it can serialize vertices
+func vertexMarshalerOf(plg plugin.Plugin) (plugin.VertexMarshaler, bool) {
+	if pp, ok := plg.(*pluginProxy); ok {
+		plg = pp.plugin()
+	}
+	if r, ok := plg.(*loadRecorder); ok {
+		plg = r.Plugin
+	}
+	m, ok := plg.(plugin.VertexMarshaler)
+	return m, ok
+}
+
+// numericPartitionerOf returns the plugin as NumericPartitioner if the active plugin implements it
+func numericPartitionerOf(plg plugin.Plugin) (plugin.NumericPartitioner, bool) {
+	if pp, ok := plg.(*pluginProxy); ok {
+		plg = pp.plugin()
+	}
+	if r, ok := plg.(*loadRecorder); ok {
+		plg = r.Plugin
+	}
+	np, ok := plg.(plugin.NumericPartitioner)
+	return np, ok
+}
+
+// workerName returns name of worker shown to users
+func workerName(pid *actor.PID) string {
+	if pid.GetAddress() == "" {
+		return pid.GetId()
+	}
+	return pid.GetAddress() + "/" + pid.GetId()
+}
+
+// findWorker returns the worker whose name, address or id equals to name
+func findWorker(workers []*command.ClusterInfo_WorkerInfo, name string) *command.ClusterInfo_WorkerInfo {
+	for _, wi := range workers {
+		if workerName(wi.WorkerPid) == name || wi.WorkerPid.GetAddress() == name || wi.WorkerPid.GetId() == name {
+			return wi
+		}
+	}
+	return nil
+}
+
+func samePID(a, b *actor.PID) bool {
+	return a.GetAddress() == b.GetAddress() && a.GetId() == b.GetId()
+}
+
+// partitionWeight returns load of the partition. Partitions without stats weigh 1 so that they are balanced by count
+func partitionWeight(stats map[uint64]*command.PartitionStats, partition uint64) uint64 {
+	s, ok := stats[partition]
+	if !ok {
+		return 1
+	}
+	return 1 + s.Vertices + s.Edges + s.MessagesSent
+}
+
+// workerLoads returns sum of weights of partitions owned by each worker
+func workerLoads(workers []*command.ClusterInfo_WorkerInfo, stats map[uint64]*command.PartitionStats) []uint64 {
+	loads := make([]uint64, len(workers))
+	for i, wi := range workers {
+		for _, p := range wi.Partitions {
+			loads[i] += partitionWeight(stats, p)
+		}
+	}
+	return loads
+}
+
+// imbalance returns how much the most loaded worker exceeds the average, 0 means balanced
+func imbalance(workers []*command.ClusterInfo_WorkerInfo, stats map[uint64]*command.PartitionStats) float64 {
+	loads := workerLoads(workers, stats)
+	var total, max uint64
+	for _, l := range loads {
+		total += l
+		if l > max {
+			max = l
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(max)*float64(len(loads))/float64(total) - 1
+}
+
+// planMigration returns moves which balance loads of workers except drained one.
+// Partitions of the drained worker go to the least loaded workers, then partitions are moved from the most loaded worker
+// to the least loaded one as long as it narrows the gap. Partitions stay where they are as much as possible.
+func planMigration(workers []*command.ClusterInfo_WorkerInfo, drained *actor.PID, stats map[uint64]*command.PartitionStats) ([]*partitionMove, error) {
+	var targets []*command.ClusterInfo_WorkerInfo
+	var orphans []*partitionMove
+	var nrOfPartitions int
+	for _, wi := range workers {
+		nrOfPartitions += len(wi.Partitions)
+		if drained != nil && samePID(wi.WorkerPid, drained) {
+			for _, p := range wi.Partitions {
+				orphans = append(orphans, &partitionMove{partition: p, from: wi.WorkerPid})
+			}
+			continue
+		}
+		targets = append(targets, &command.ClusterInfo_WorkerInfo{WorkerPid: wi.WorkerPid, Partitions: sortedPartitions(wi.Partitions)})
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("no workers left")
+	}
+	loads := workerLoads(targets, stats)
+	moves := make(map[uint64]*partitionMove)
+	moveTo := func(m *partitionMove, to int) {
+		m.to = targets[to].WorkerPid
+		moves[m.partition] = m
+		targets[to].Partitions = append(targets[to].Partitions, m.partition)
+		loads[to] += partitionWeight(stats, m.partition)
+	}
+
+	// heavier partitions first
+	sort.SliceStable(orphans, func(i, j int) bool {
+		return partitionWeight(stats, orphans[i].partition) > partitionWeight(stats, orphans[j].partition)
+	})
+	for _, m := range orphans {
+		moveTo(m, lightest(loads))
+	}
+
+	for n := 0; n < nrOfPartitions*len(targets); n++ {
+		from, to := heaviest(loads), lightest(loads)
+		gap := loads[from] - loads[to]
+		// the heaviest partition narrowing the gap, the last one wins a tie
+		candidate := -1
+		for i, p := range targets[from].Partitions {
+			w := partitionWeight(stats, p)
+			if w < gap && (candidate < 0 || w >= partitionWeight(stats, targets[from].Partitions[candidate])) {
+				candidate = i
+			}
+		}
+		if candidate < 0 {
+			break
+		}
+		p := targets[from].Partitions[candidate]
+		targets[from].Partitions = append(targets[from].Partitions[:candidate], targets[from].Partitions[candidate+1:]...)
+		loads[from] -= partitionWeight(stats, p)
+		m, ok := moves[p]
+		if !ok {
+			m = &partitionMove{partition: p, from: targets[from].WorkerPid}
+		}
+		moveTo(m, to)
+	}
+
+	var result []*partitionMove
+	for _, m := range moves {
+		if !samePID(m.from, m.to) {
+			result = append(result, m)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].partition < result[j].partition })
+	return result, nil
+}
+
+func heaviest(loads []uint64) int {
+	idx := 0
+	for i, l := range loads {
+		if l > loads[idx] {
+			idx = i
+		}
+	}
+	return idx
+}
+
+func lightest(loads []uint64) int {
+	idx := 0
+	for i, l := range loads {
+		if l < loads[idx] {
+			idx = i
+		}
+	}
+	return idx
+}
+
+// applyMoves returns a copy of workers whose partitions are moved
+func applyMoves(workers []*command.ClusterInfo_WorkerInfo, moves []*partitionMove) []*command.ClusterInfo_WorkerInfo {
+	moved := make(map[uint64]*actor.PID, len(moves))
+	for _, m := range moves {
+		moved[m.partition] = m.to
+	}
+	result := make([]*command.ClusterInfo_WorkerInfo, len(workers))
+	for i, wi := range workers {
+		result[i] = &command.ClusterInfo_WorkerInfo{WorkerPid: wi.WorkerPid}
+		for _, p := range wi.Partitions {
+			if _, ok := moved[p]; !ok {
+				result[i].Partitions = append(result[i].Partitions, p)
+			}
+		}
+	}
+	for _, m := range moves {
+		for _, wi := range result {
+			if samePID(wi.WorkerPid, m.to) {
+				wi.Partitions = append(wi.Partitions, m.partition)
+			}
+		}
+	}
+	for _, wi := range result {
+		wi.Partitions = sortedPartitions(wi.Partitions)
+	}
+	return result
+}
+
+func sortedPartitions(parts []uint64) []uint64 {
+	sorted := append([]uint64{}, parts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return sorted
+}
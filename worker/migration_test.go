@@ -0,0 +1,316 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/sirupsen/logrus"
+)
+
+// movableMaxPlugin is maxPlugin whose vertices can be moved between workers
+type movableMaxPlugin struct {
+	maxPlugin
+}
+
+type maxVertexJSON struct {
+	ID    plugin.VertexID   `json:"id"`
+	Value uint32            `json:"value"`
+	Edges []plugin.VertexID `json:"edges"`
+}
+
+func (p *movableMaxPlugin) MarshalVertex(v plugin.Vertex) (*types.Any, error) {
+	mv := v.(*maxVertex)
+	b, err := json.Marshal(&maxVertexJSON{ID: mv.id, Value: mv.value, Edges: mv.edges})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Any{TypeUrl: "maxVertex", Value: b}, nil
+}
+
+func (p *movableMaxPlugin) UnmarshalVertex(pb *types.Any) (plugin.Vertex, error) {
+	var v maxVertexJSON
+	if err := json.Unmarshal(pb.Value, &v); err != nil {
+		return nil, err
+	}
+	return &maxVertex{id: v.ID, value: v.Value, edges: v.Edges}, nil
+}
+
+// failingImportPlugin fails to unmarshal the n-th vertex imported
+type failingImportPlugin struct {
+	movableMaxPlugin
+	failAt   int32
+	imported int32
+}
+
+func (p *failingImportPlugin) UnmarshalVertex(pb *types.Any) (plugin.Vertex, error) {
+	if atomic.AddInt32(&p.imported, 1) == p.failAt {
+		return nil, errors.New("unmarshal failure")
+	}
+	return p.movableMaxPlugin.UnmarshalVertex(pb)
+}
+
+func pids(ids ...string) []*actor.PID {
+	var ps []*actor.PID
+	for _, id := range ids {
+		ps = append(ps, actor.NewLocalPID(id))
+	}
+	return ps
+}
+
+func Test_planMigration(t *testing.T) {
+	w := pids("w0", "w1", "w2")
+	tests := []struct {
+		name    string
+		workers []*command.ClusterInfo_WorkerInfo
+		drained *actor.PID
+		stats   map[uint64]*command.PartitionStats
+		want    [][]uint64
+		moves   int
+		wantErr bool
+	}{
+		{
+			name: "add worker",
+			workers: []*command.ClusterInfo_WorkerInfo{
+				{WorkerPid: w[0], Partitions: []uint64{0, 1, 2}},
+				{WorkerPid: w[1], Partitions: []uint64{3, 4, 5}},
+				{WorkerPid: w[2]},
+			},
+			want:  [][]uint64{{0, 1}, {3, 4}, {2, 5}},
+			moves: 2,
+		},
+		{
+			name: "drain worker",
+			workers: []*command.ClusterInfo_WorkerInfo{
+				{WorkerPid: w[0], Partitions: []uint64{0, 1}},
+				{WorkerPid: w[1], Partitions: []uint64{2, 3}},
+				{WorkerPid: w[2], Partitions: []uint64{4}},
+			},
+			drained: w[0],
+			want:    [][]uint64{{}, {1, 2, 3}, {0, 4}},
+			moves:   2,
+		},
+		{
+			name: "heavy partition",
+			workers: []*command.ClusterInfo_WorkerInfo{
+				{WorkerPid: w[0], Partitions: []uint64{0, 1}},
+				{WorkerPid: w[1], Partitions: []uint64{2, 3}},
+			},
+			stats: map[uint64]*command.PartitionStats{
+				0: {PartitionId: 0, Vertices: 100, Edges: 1000},
+				1: {PartitionId: 1, Vertices: 10, Edges: 10},
+				2: {PartitionId: 2, Vertices: 10, Edges: 10},
+				3: {PartitionId: 3, Vertices: 10, Edges: 10},
+			},
+			want:  [][]uint64{{0}, {1, 2, 3}},
+			moves: 1,
+		},
+		{
+			name: "balanced",
+			workers: []*command.ClusterInfo_WorkerInfo{
+				{WorkerPid: w[0], Partitions: []uint64{0, 1}},
+				{WorkerPid: w[1], Partitions: []uint64{2}},
+			},
+			want:  [][]uint64{{0, 1}, {2}},
+			moves: 0,
+		},
+		{
+			name: "no workers left",
+			workers: []*command.ClusterInfo_WorkerInfo{
+				{WorkerPid: w[0], Partitions: []uint64{0, 1}},
+			},
+			drained: w[0],
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moves, err := planMigration(tt.workers, tt.drained, tt.stats)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("planMigration() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(moves) != tt.moves {
+				t.Fatalf("unexpected number of moves: %d", len(moves))
+			}
+			var got [][]uint64
+			for _, wi := range applyMoves(tt.workers, moves) {
+				got = append(got, wi.Partitions)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Fatalf("unexpected assignment: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_imbalance(t *testing.T) {
+	w := pids("w0", "w1")
+	workers := []*command.ClusterInfo_WorkerInfo{
+		{WorkerPid: w[0], Partitions: []uint64{0}},
+		{WorkerPid: w[1], Partitions: []uint64{1}},
+	}
+	if r := imbalance(workers, nil); r != 0 {
+		t.Fatalf("partitions without stats should be balanced: %v", r)
+	}
+	stats := map[uint64]*command.PartitionStats{
+		0: {PartitionId: 0, Vertices: 2},
+		1: {PartitionId: 1, Vertices: 0},
+	}
+	// loads are 3 and 1
+	if r := imbalance(workers, stats); r != 0.5 {
+		t.Fatalf("unexpected imbalance: %v", r)
+	}
+}
+
+// startLocalCluster runs coordinator with local workers then loads vertices
+func startLocalCluster(t *testing.T, plg plugin.Plugin, nrOfWorkers int, nrOfPartitions uint64, opts ...func(*command.NewCluster)) (coordinator *actor.PID, request func(msg interface{}) interface{}, waitUntilIdle func() *command.CoordinatorStatsAck) {
+	logger := logrus.New()
+	logger.SetOutput(ioutil.Discard)
+	proxy := newPluginProxy(plg).appendAggregators(systemAggregator)
+
+	root := actor.EmptyRootContext
+	coordinator = root.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return NewCoordinatorActor(proxy, workerProps(proxy, logger, nil), func() {}, logger)
+	}))
+
+	request = func(msg interface{}) interface{} {
+		res, err := root.RequestFuture(coordinator, msg, 10*time.Second).Result()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+	waitUntilIdle = func() *command.CoordinatorStatsAck {
+		for i := 0; i < 500; i++ {
+			stats := request(&command.CoordinatorStats{}).(*command.CoordinatorStatsAck)
+			if stats.State == CoordinatorStateIdle {
+				return stats
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("coordinator doesn't become idle")
+		return nil
+	}
+
+	workers := make([]*command.NewCluster_WorkerReq, nrOfWorkers)
+	for i := range workers {
+		workers[i] = &command.NewCluster_WorkerReq{}
+	}
+	newCluster := &command.NewCluster{
+		Workers:        workers,
+		NrOfPartitions: nrOfPartitions,
+	}
+	for _, opt := range opts {
+		opt(newCluster)
+	}
+	if ack := request(newCluster).(*command.NewClusterAck); ack.Error != "" {
+		t.Fatal(ack.Error)
+	}
+	root.Send(coordinator, &command.LoadPartitionVertices{})
+	waitUntilIdle()
+	return coordinator, request, waitUntilIdle
+}
+
+func TestCoordinator_addAndDrainWorker(t *testing.T) {
+	coordinator, request, waitUntilIdle := startLocalCluster(t, &movableMaxPlugin{maxPlugin{size: 8}}, 2, 4)
+	defer actor.EmptyRootContext.Stop(coordinator)
+
+	added := request(&command.AddWorker{}).(*command.AddWorkerAck)
+	if added.Error != "" {
+		t.Fatal(added.Error)
+	}
+	if stats := waitUntilIdle(); len(stats.Workers) != 3 {
+		t.Fatalf("worker is not added: %v", stats.Workers)
+	}
+
+	if ack := request(&command.DrainWorker{Worker: "unknown"}).(*command.DrainWorkerAck); ack.Error == "" {
+		t.Fatal("unknown worker should be rejected")
+	}
+	stats := waitUntilIdle()
+	if ack := request(&command.DrainWorker{Worker: stats.Workers[0]}).(*command.DrainWorkerAck); ack.Error != "" {
+		t.Fatal(ack.Error)
+	}
+	stats = waitUntilIdle()
+	if len(stats.Workers) != 2 {
+		t.Fatalf("worker is not drained: %v", stats.Workers)
+	}
+
+	// vertices moved to other workers compute as usual
+	actor.EmptyRootContext.Send(coordinator, &command.StartSuperStep{})
+	waitUntilIdle()
+	for i := 0; i < 8; i++ {
+		id := fmt.Sprintf("v%d", i)
+		ack := request(&command.GetVertexValue{VertexId: id}).(*command.GetVertexValueAck)
+		if ack.Value != "7" {
+			t.Fatalf("unexpected value of %s: %q", id, ack.Value)
+		}
+	}
+}
+
+func TestCoordinator_drainWorkerWithoutVertexMarshaler(t *testing.T) {
+	coordinator, request, waitUntilIdle := startLocalCluster(t, &maxPlugin{size: 4}, 2, 2)
+	defer actor.EmptyRootContext.Stop(coordinator)
+
+	stats := waitUntilIdle()
+	if ack := request(&command.DrainWorker{Worker: stats.Workers[0]}).(*command.DrainWorkerAck); ack.Error == "" {
+		t.Fatal("vertices can't be moved without VertexMarshaler")
+	}
+	stats = waitUntilIdle()
+	if len(stats.Workers) != 2 {
+		t.Fatalf("worker should not be drained: %v", stats.Workers)
+	}
+}
+
+func TestCoordinator_drainWorkerImportFailed(t *testing.T) {
+	plg := &failingImportPlugin{movableMaxPlugin: movableMaxPlugin{maxPlugin{size: 8}}, failAt: 2}
+	coordinator, request, waitUntilIdle := startLocalCluster(t, plg, 2, 4)
+	defer actor.EmptyRootContext.Stop(coordinator)
+
+	stats := waitUntilIdle()
+	if ack := request(&command.DrainWorker{Worker: stats.Workers[0]}).(*command.DrainWorkerAck); ack.Error == "" {
+		t.Fatal("drain should fail when the partition can't be imported")
+	}
+	if stats = waitUntilIdle(); len(stats.Workers) != 2 {
+		t.Fatalf("worker should not be drained: %v", stats.Workers)
+	}
+
+	// the partition which failed to be imported is kept by the exporting worker
+	actor.EmptyRootContext.Send(coordinator, &command.StartSuperStep{})
+	waitUntilIdle()
+	for i := 0; i < 8; i++ {
+		id := fmt.Sprintf("v%d", i)
+		ack := request(&command.GetVertexValue{VertexId: id}).(*command.GetVertexValueAck)
+		if ack.Value != "7" {
+			t.Fatalf("unexpected value of %s: %q", id, ack.Value)
+		}
+	}
+
+	// partial partitions are removed from the importing worker so that they can be moved again
+	if ack := request(&command.DrainWorker{Worker: stats.Workers[0]}).(*command.DrainWorkerAck); ack.Error != "" {
+		t.Fatal(ack.Error)
+	}
+	if stats = waitUntilIdle(); len(stats.Workers) != 1 {
+		t.Fatalf("worker is not drained: %v", stats.Workers)
+	}
+	actor.EmptyRootContext.Send(coordinator, &command.StartSuperStep{})
+	waitUntilIdle()
+	for i := 0; i < 8; i++ {
+		id := fmt.Sprintf("v%d", i)
+		ack := request(&command.GetVertexValue{VertexId: id}).(*command.GetVertexValueAck)
+		if ack.Value != "7" {
+			t.Fatalf("unexpected value of %s: %q", id, ack.Value)
+		}
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
-	"github.com/gogo/protobuf/types"
 	"github.com/rerorero/prerogel/command"
 	"github.com/rerorero/prerogel/plugin"
 	"github.com/rerorero/prerogel/util"
@@ -19,7 +18,58 @@ type partitionActor struct {
 	vertices              map[plugin.VertexID]*actor.PID
 	vertexProps           *actor.Props
 	ackRecorder           *util.AckRecorder
-	aggregatedCurrentStep map[string]*types.Any
+	aggregatedCurrentStep map[string]plugin.AggregatableValue
+	respondTo             *actor.PID
+	exported              []*command.VertexState
+	migrationError        string
+	numOfPartitions       uint64
+	edges                 uint64
+	cutEdges              uint64
+	edgeCounts            map[plugin.VertexID]edgeCount
+	messagesSent          uint64
+	trackVertexTraffic    bool
+	internalMessages      map[string]uint64
+	// localMessages counts messages between vertices of the partition in the current superstep
+	localMessages uint64
+	// computeErr is the first error reported by vertices in the current superstep
+	computeErr string
+	// exporting is vertices being exported by ExportVertices, nil while the whole partition is exported
+	exporting []plugin.VertexID
+	// importing is true while vertices are imported by ImportVertices
+	importing bool
+	// numericVertices indexes vertices by integer IDs to deliver messages with numeric vertex IDs
+	numericVertices map[uint64]*actor.PID
+	numericIDs      bool
+	// outbox limits messages of vertices in flight, they are relayed by the partition under the limit
+	outbox    outbox
+	relaySeq  uint64
+	relayed   map[uint64]relayedMessage
+	flowStats *command.FlowControlStats
+	// store keeps vertices on local disk if storeConfig enables it, it's opened when vertices are loaded or imported
+	storeConfig *command.PartitionStore
+	store       *vertexStore
+	// outEdges stores outgoing edges of vertices if the plugin implements EdgePlugin
+	outEdges *edgeStore
+}
+
+// relayedMessage is the original sender and number of a message relayed by the partition
+type relayedMessage struct {
+	sender *actor.PID
+	seq    uint64
+}
+
+// edgeCount is edges of a vertex counted in stats of the partition
+type edgeCount struct {
+	edges uint64
+	cut   uint64
+}
+
+// computePartitionAckLocal is ComputePartitionAck carrying aggregated values without marshaling them, partitions always run in the process of their worker
+type computePartitionAckLocal struct {
+	*command.ComputePartitionAck
+	aggregated map[string]plugin.AggregatableValue
+	// flow is nil unless messages have been held by the flow control
+	flow *command.FlowControlStats
 }
 
 // NewPartitionActor returns an actor instance
@@ -33,6 +83,7 @@ func NewPartitionActor(plg plugin.Plugin, vertexProps *actor.Props, logger *logr
 		},
 		vertexProps: vertexProps,
 		vertices:    make(map[plugin.VertexID]*actor.PID),
+		edgeCounts:  make(map[plugin.VertexID]edgeCount),
 		ackRecorder: ar,
 	}
 	a.behavior.Become(a.waitInit)
@@ -41,6 +92,10 @@ func NewPartitionActor(plg plugin.Plugin, vertexProps *actor.Props, logger *logr
 
 // Receive is message handler
 func (state *partitionActor) Receive(context actor.Context) {
+	if _, ok := context.Message().(*actor.Stopped); ok {
+		state.closeStore()
+		return
+	}
 	if state.ActorUtil.IsSystemMessage(context.Message()) {
 		// ignore
 		return
@@ -48,7 +103,7 @@ func (state *partitionActor) Receive(context actor.Context) {
 
 	switch cmd := context.Message().(type) {
 	case *command.ClusterInfo:
-		state.broadcastToVertices(context, cmd)
+		// vertices send messages via the partition, they don't need to know the cluster
 		return
 
 	case *command.GetVertexValue:
@@ -70,6 +125,8 @@ func (state *partitionActor) waitInit(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.InitPartition: // sent from parent
 		state.partitionID = cmd.PartitionId
+		state.setNumericIDs(cmd.NumericVertexIds)
+		state.storeConfig = cmd.Store
 
 		context.Respond(&command.InitPartitionAck{
 			PartitionId: state.partitionID,
@@ -79,6 +136,14 @@ func (state *partitionActor) waitInit(context actor.Context) {
 		state.ActorUtil.LogInfo(context, "initialization partition has completed")
 		return
 
+	case *command.ImportPartition: // sent from coordinator via parent
+		state.partitionID = cmd.PartitionId
+		state.numOfPartitions = cmd.NumOfPartitions
+		state.setNumericIDs(cmd.NumericVertexIds)
+		state.storeConfig = cmd.Store
+		state.importVertices(context, cmd.Vertices)
+		return
+
 	default:
 		state.ActorUtil.Fail(context, fmt.Errorf("[waitInit] unhandled partition command: command=%#v", cmd))
 		return
@@ -95,6 +160,22 @@ func (state *partitionActor) idle(context actor.Context) {
 			context.Respond(&command.LoadVertexAck{VertexId: string(cmd.VertexId), Error: err})
 			return
 		}
+		var num uint64
+		if state.numericIDs {
+			n, err := plugin.ParseNumericID(vid)
+			if err != nil {
+				state.ActorUtil.LogError(context, err.Error())
+				context.Respond(&command.LoadVertexAck{VertexId: string(cmd.VertexId), Error: err.Error()})
+				return
+			}
+			num = n
+		}
+		if err := state.openStore(); err != nil {
+			state.ActorUtil.LogError(context, err.Error())
+			context.Respond(&command.LoadVertexAck{VertexId: string(cmd.VertexId), Error: err.Error()})
+			return
+		}
+		state.openEdges()
 		pid, err := context.SpawnNamed(state.vertexProps, fmt.Sprintf("v%v", vid))
 		if err != nil {
 			err := fmt.Sprintf("failed to spawn actor: id=%s", cmd.VertexId)
@@ -102,24 +183,48 @@ func (state *partitionActor) idle(context actor.Context) {
 			context.Respond(&command.LoadVertexAck{VertexId: string(cmd.VertexId), Error: err})
 			return
 		}
-		state.vertices[vid] = pid
+		state.addVertex(vid, num, pid)
 		context.Forward(pid)
+		if state.store != nil || state.outEdges != nil {
+			// the vertex hands its edges over and pages itself out after it's loaded
+			context.Send(pid, &useStoresLocal{store: state.store, edges: state.outEdges})
+		}
 		return
 
 	case *command.LoadPartitionVertices:
 		state.resetAckRecorder()
+		state.numOfPartitions = cmd.NumOfPartitions
+		if err := state.openStore(); err != nil {
+			state.ackRecorder.Clear()
+			state.ActorUtil.LogError(context, err.Error())
+			context.Respond(&command.LoadPartitionVerticesAck{PartitionId: state.partitionID, Error: err.Error()})
+			return
+		}
+		state.openEdges()
 		var loadErr string
 		if err := state.plugin.NewPartitionVertices(state.partitionID, cmd.NumOfPartitions, func(v plugin.Vertex) {
 			// TODO: concurrency unsafe
 			vid := v.GetID()
+			num, err := state.numericIDOf(v)
+			if err != nil {
+				loadErr = err.Error()
+				state.ActorUtil.LogError(context, loadErr)
+				return
+			}
 			pid, err := context.SpawnNamed(state.vertexProps, fmt.Sprintf("v%v", vid))
 			if err != nil {
 				loadErr = fmt.Sprintf("failed to spawn actor: id=%s", vid)
 				state.ActorUtil.LogError(context, loadErr)
 				return
 			}
-			state.vertices[vid] = pid
-			context.Request(pid, &loadVertexLocal{vertex: v})
+			state.addVertex(vid, num, pid)
+			if err := state.takeEdges(v); err != nil {
+				loadErr = err.Error()
+				state.ActorUtil.LogError(context, loadErr)
+				return
+			}
+			state.countEdges(v)
+			context.Request(pid, &loadVertexLocal{vertex: v, store: state.store, edges: state.outEdges})
 			state.ackRecorder.AddToWaitList(string(vid))
 		}); err != nil {
 			state.ackRecorder.Clear()
@@ -132,25 +237,82 @@ func (state *partitionActor) idle(context actor.Context) {
 			context.Respond(&command.LoadPartitionVerticesAck{PartitionId: state.partitionID, Error: loadErr})
 			return
 		}
+		if state.ackRecorder.HasCompleted() {
+			// the plugin registered no vertex in the partition
+			state.finishLoadPartitionVertices(context)
+			return
+		}
 		state.ActorUtil.LogDebug(context, fmt.Sprintf("start waiting for loading partition vertices"))
 		state.behavior.Become(state.waitLoadPartitionVertices)
 		return
 
 	case *command.SuperStepBarrier:
+		state.aggregatedCurrentStep = make(map[string]plugin.AggregatableValue)
 		if len(state.vertices) == 0 {
+			// vertices may have been moved to other partitions
 			state.ActorUtil.LogInfo(context, fmt.Sprintf("[idle] no vertex is assigned"))
+			context.Send(context.Parent(), &command.SuperStepBarrierPartitionAck{PartitionId: state.partitionID})
+			state.behavior.Become(state.superstep)
 			return
 		}
 		state.resetAckRecorder()
 		state.broadcastToVertices(context, cmd)
 		state.behavior.Become(state.waitSuperStepBarrierAck)
-		state.aggregatedCurrentStep = make(map[string]*types.Any)
+		return
+
+	case *computeLocal:
+		// the barrier is implied by Compute unless the coordinator sent it
+		state.aggregatedCurrentStep = make(map[string]plugin.AggregatableValue)
+		state.behavior.Become(state.superstep)
+		state.superstep(context)
 		return
 
 	case *command.SuperStepMessage:
 		state.handleMessage(context, cmd)
 		return
 
+	case *command.ExportPartition: // sent from coordinator via parent
+		state.respondTo = context.Sender()
+		state.exported = nil
+		state.exporting = nil
+		state.migrationError = ""
+		if len(state.vertices) == 0 {
+			state.finishExport(context)
+			return
+		}
+		state.resetAckRecorder()
+		state.broadcastToVertices(context, &command.ExportVertex{})
+		state.behavior.Become(state.waitExportVertices)
+		state.ActorUtil.LogDebug(context, "become waitExportVertices")
+		return
+
+	case *command.ExportVertices: // sent from coordinator via parent
+		state.respondTo = context.Sender()
+		state.exported = nil
+		state.exporting = make([]plugin.VertexID, 0, len(cmd.VertexIds))
+		state.migrationError = ""
+		state.ackRecorder.Clear()
+		for _, id := range cmd.VertexIds {
+			pid, ok := state.vertices[plugin.VertexID(id)]
+			if !ok {
+				state.migrationError = fmt.Sprintf("vertex %s is not found in partition %v", id, state.partitionID)
+				break
+			}
+			state.exporting = append(state.exporting, plugin.VertexID(id))
+			state.ackRecorder.AddToWaitList(id)
+			context.Request(pid, &command.ExportVertex{})
+		}
+		state.behavior.Become(state.waitExportVertices)
+		if state.ackRecorder.HasCompleted() {
+			state.finishExport(context)
+		}
+		return
+
+	case *command.ImportVertices: // sent from coordinator via parent
+		state.importing = true
+		state.importVertices(context, cmd.Vertices)
+		return
+
 	default:
 		state.ActorUtil.Fail(context, fmt.Errorf("[idle] unhandled partition command: command=%#v", cmd))
 		return
@@ -164,12 +326,7 @@ func (state *partitionActor) waitLoadPartitionVertices(context actor.Context) {
 			state.ActorUtil.LogWarn(context, fmt.Sprintf("LoadVertexAck(partition) duplicated: id=%v", cmd.VertexId))
 		}
 		if state.ackRecorder.HasCompleted() {
-			context.Send(context.Parent(), &command.LoadPartitionVerticesAck{
-				PartitionId: state.partitionID,
-			})
-			state.resetAckRecorder()
-			state.behavior.Become(state.idle)
-			state.ActorUtil.LogDebug(context, "loading partition finished")
+			state.finishLoadPartitionVertices(context)
 		}
 		return
 	default:
@@ -178,6 +335,198 @@ func (state *partitionActor) waitLoadPartitionVertices(context actor.Context) {
 	}
 }
 
+func (state *partitionActor) finishLoadPartitionVertices(context actor.Context) {
+	context.Send(context.Parent(), &command.LoadPartitionVerticesAck{
+		PartitionId: state.partitionID,
+		Stats:       state.stats(),
+	})
+	state.resetAckRecorder()
+	state.behavior.Become(state.idle)
+	state.ActorUtil.LogDebug(context, "loading partition finished")
+}
+
+func (state *partitionActor) waitExportVertices(context actor.Context) {
+	switch cmd := context.Message().(type) {
+	case *command.ExportVertexAck:
+		if !state.ackRecorder.Ack(cmd.VertexId) {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("ExportVertexAck duplicated: id=%v", cmd.VertexId))
+		}
+		if cmd.Error != "" {
+			state.migrationError = cmd.Error
+		} else {
+			state.exported = append(state.exported, cmd.State)
+		}
+		if state.ackRecorder.HasCompleted() {
+			state.finishExport(context)
+		}
+		return
+	default:
+		state.ActorUtil.Fail(context, fmt.Errorf("[waitExportVertices] unhandled partition command: command=%#v", cmd))
+		return
+	}
+}
+
+// finishExport responds exported vertices. The partition keeps them until the worker releases it
+func (state *partitionActor) finishExport(context actor.Context) {
+	if state.exporting != nil {
+		state.finishExportVertices(context)
+		return
+	}
+	ack := &command.ExportPartitionAck{
+		PartitionId: state.partitionID,
+		Vertices:    state.exported,
+		Error:       state.migrationError,
+	}
+	if ack.Error != "" {
+		ack.Vertices = nil
+	}
+	context.Send(state.respondTo, ack)
+	state.respondTo = nil
+	state.exported = nil
+	state.resetAckRecorder()
+	state.behavior.Become(state.idle)
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("partition %v has been exported", state.partitionID))
+}
+
+// finishExportVertices responds exported vertices then stops them. Vertices are kept if any of them failed
+func (state *partitionActor) finishExportVertices(context actor.Context) {
+	ack := &command.ExportVerticesAck{
+		PartitionId: state.partitionID,
+		Vertices:    state.exported,
+		Error:       state.migrationError,
+	}
+	if ack.Error != "" {
+		ack.Vertices = nil
+	} else {
+		for _, id := range state.exporting {
+			state.removeVertex(context, id)
+			ec := state.edgeCounts[id]
+			state.edges -= ec.edges
+			state.cutEdges -= ec.cut
+			delete(state.edgeCounts, id)
+		}
+		state.outEdges.compact()
+	}
+	context.Send(state.respondTo, ack)
+	state.respondTo = nil
+	state.exported = nil
+	state.exporting = nil
+	state.resetAckRecorder()
+	state.behavior.Become(state.idle)
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("%d vertices have been exported from partition %v", len(ack.Vertices), state.partitionID))
+}
+
+// importVertices spawns vertices exported by another worker
+func (state *partitionActor) importVertices(context actor.Context, vertices []*command.VertexState) {
+	state.respondTo = context.Sender()
+	state.migrationError = ""
+	state.ackRecorder.Clear()
+
+	marshaler, ok := vertexMarshalerOf(state.plugin)
+	if !ok && len(vertices) > 0 {
+		state.migrationError = "plugin doesn't implement VertexMarshaler"
+	} else if err := state.openStore(); err != nil {
+		state.migrationError = err.Error()
+	}
+	state.openEdges()
+	for _, vs := range vertices {
+		if state.migrationError != "" {
+			break
+		}
+		v, err := marshaler.UnmarshalVertex(vs.Vertex)
+		if err != nil {
+			state.migrationError = fmt.Sprintf("failed to unmarshal vertex: id=%s err=%v", vs.VertexId, err)
+			break
+		}
+		imported := &importVertexLocal{vertex: v, halted: vs.Halted, store: state.store, edges: state.outEdges}
+		for _, pb := range vs.Messages {
+			m, err := state.plugin.UnmarshalMessage(pb)
+			if err != nil {
+				state.migrationError = fmt.Sprintf("failed to unmarshal message: id=%s err=%v", vs.VertexId, err)
+				break
+			}
+			imported.messages = append(imported.messages, m)
+		}
+		if state.migrationError != "" {
+			break
+		}
+		if _, ok := state.vertices[v.GetID()]; ok {
+			state.migrationError = fmt.Sprintf("vertex has already existed: id=%s", vs.VertexId)
+			break
+		}
+		num, err := state.numericIDOf(v)
+		if err != nil {
+			state.migrationError = err.Error()
+			break
+		}
+		if vs.OutEdges != nil {
+			if state.outEdges == nil {
+				state.migrationError = fmt.Sprintf("plugin doesn't store edges: id=%s", vs.VertexId)
+				break
+			}
+			if err := state.outEdges.restore(v.GetID(), vs.OutEdges); err != nil {
+				state.migrationError = err.Error()
+				break
+			}
+		}
+		pid, err := context.SpawnNamed(state.vertexProps, fmt.Sprintf("v%v", v.GetID()))
+		if err != nil {
+			state.migrationError = fmt.Sprintf("failed to spawn actor: id=%s", vs.VertexId)
+			break
+		}
+		state.addVertex(v.GetID(), num, pid)
+		state.countEdges(v)
+		context.Request(pid, imported)
+		state.ackRecorder.AddToWaitList(string(v.GetID()))
+	}
+
+	if state.migrationError != "" {
+		state.ActorUtil.LogError(context, state.migrationError)
+	}
+	state.behavior.Become(state.waitImportVertices)
+	if state.ackRecorder.HasCompleted() {
+		state.finishImport(context)
+	}
+}
+
+func (state *partitionActor) waitImportVertices(context actor.Context) {
+	switch cmd := context.Message().(type) {
+	case *command.LoadVertexAck:
+		if !state.ackRecorder.Ack(cmd.VertexId) {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("LoadVertexAck(import) duplicated: id=%v", cmd.VertexId))
+		}
+		if cmd.Error != "" {
+			state.migrationError = cmd.Error
+		}
+		if state.ackRecorder.HasCompleted() {
+			state.finishImport(context)
+		}
+		return
+	default:
+		state.ActorUtil.Fail(context, fmt.Errorf("[waitImportVertices] unhandled partition command: command=%#v", cmd))
+		return
+	}
+}
+
+func (state *partitionActor) finishImport(context actor.Context) {
+	if state.importing {
+		context.Send(state.respondTo, &command.ImportVerticesAck{
+			PartitionId: state.partitionID,
+			Error:       state.migrationError,
+		})
+	} else {
+		context.Send(state.respondTo, &command.ImportPartitionAck{
+			PartitionId: state.partitionID,
+			Error:       state.migrationError,
+		})
+	}
+	state.respondTo = nil
+	state.importing = false
+	state.resetAckRecorder()
+	state.behavior.Become(state.idle)
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("partition %v has been imported with %d vertices", state.partitionID, len(state.vertices)))
+}
+
 func (state *partitionActor) waitSuperStepBarrierAck(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.SuperStepBarrierAck: // sent from parent
@@ -201,32 +550,45 @@ func (state *partitionActor) waitSuperStepBarrierAck(context actor.Context) {
 
 func (state *partitionActor) superstep(context actor.Context) {
 	switch cmd := context.Message().(type) {
-	case *command.Compute: // sent from parent
+	case *computeLocal: // sent from parent
 		state.resetAckRecorder()
+		state.messagesSent = 0
+		state.computeErr = ""
+		state.localMessages = 0
+		state.trackVertexTraffic = cmd.TrackVertexTraffic
+		state.internalMessages = nil
+		state.outbox.reset(cmd.FlowControl.GetPartitionInflight())
+		state.relayed = nil
+		state.flowStats = nil
+		if len(state.vertices) == 0 {
+			state.computeAckAndBecomeIdle(context)
+			return
+		}
 		state.broadcastToVertices(context, cmd)
 		return
 
-	case *command.ComputeAck: // sent from vertices
+	case *computeAckLocal: // sent from vertices
 		// TODO: aggregate halted status
-		if cmd.AggregatedValues != nil {
-			if err := aggregateValueMap(state.plugin.GetAggregators(), state.aggregatedCurrentStep, cmd.AggregatedValues); err != nil {
-				state.ActorUtil.Fail(context, err)
-				return
+		state.messagesSent += cmd.MessagesSent
+		if cmd.Error != "" && state.computeErr == "" {
+			state.computeErr = cmd.Error
+		}
+		if cmd.flow != nil {
+			if state.flowStats == nil {
+				state.flowStats = &command.FlowControlStats{}
 			}
+			state.flowStats.Add(cmd.flow)
+		}
+		if err := aggregateValues(state.plugin.GetAggregators(), state.aggregatedCurrentStep, cmd.aggregated); err != nil {
+			state.ActorUtil.Fail(context, err)
+			return
 		}
 
 		if !state.ackRecorder.Ack(cmd.VertexId) {
 			state.ActorUtil.LogWarn(context, fmt.Sprintf("ComputeAck duplicated: id=%v", cmd.VertexId))
 		}
 		if state.ackRecorder.HasCompleted() {
-			context.Send(context.Parent(), &command.ComputePartitionAck{
-				PartitionId:      state.partitionID,
-				AggregatedValues: state.aggregatedCurrentStep,
-			})
-			state.resetAckRecorder()
-			state.aggregatedCurrentStep = nil
-			state.behavior.Become(state.idle)
-			state.ActorUtil.LogInfo(context, "partition: compute has completed")
+			state.computeAckAndBecomeIdle(context)
 		}
 		return
 
@@ -234,21 +596,132 @@ func (state *partitionActor) superstep(context actor.Context) {
 		state.handleMessage(context, cmd)
 		return
 
+	case *command.SuperStepMessageAck: // acks of relayed messages
+		r, ok := state.relayed[cmd.Seq]
+		if !ok {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("unknown message ack: seq=%v", cmd.Seq))
+			return
+		}
+		delete(state.relayed, cmd.Seq)
+		context.Send(r.sender, &command.SuperStepMessageAck{Seq: r.seq})
+		if err := state.outbox.ack(context.Request); err != nil {
+			state.ActorUtil.Fail(context, err)
+		}
+		return
+
 	default:
 		state.ActorUtil.Fail(context, fmt.Errorf("[superstep] unhandled partition command: command=%#v", cmd))
 		return
 	}
 }
+func (state *partitionActor) computeAckAndBecomeIdle(context actor.Context) {
+	// vertices have paged themselves out
+	if err := state.store.compact(); err != nil {
+		state.ActorUtil.LogError(context, fmt.Sprintf("failed to compact partition store: %v", err))
+	}
+	context.Send(context.Parent(), &computePartitionAckLocal{
+		ComputePartitionAck: &command.ComputePartitionAck{
+			PartitionId:      state.partitionID,
+			Stats:            state.stats(),
+			InternalMessages: state.internalMessages,
+			Error:            state.computeErr,
+			LocalMessages:    state.localMessages,
+		},
+		aggregated: state.aggregatedCurrentStep,
+		flow:       state.outbox.addStats(state.flowStats),
+	})
+	state.resetAckRecorder()
+	state.aggregatedCurrentStep = nil
+	state.internalMessages = nil
+	state.flowStats = nil
+	state.behavior.Become(state.idle)
+	state.ActorUtil.LogInfo(context, "partition: compute has completed")
+}
+
 func (state *partitionActor) handleMessage(context actor.Context, cmd *command.SuperStepMessage) {
-	if _, ok := state.vertices[plugin.VertexID(cmd.SrcVertexId)]; ok {
-		context.Forward(context.Parent())
-	} else if pid, ok := state.vertices[plugin.VertexID(cmd.DestVertexId)]; ok {
-		context.Forward(pid)
+	src := srcKeyOf(cmd)
+	_, fromLocal := state.vertexOf(src)
+	// deliver to the local vertex first, otherwise a message between vertices of the same partition goes back and forth with the worker
+	if pid, ok := state.vertexOf(destKeyOf(cmd)); ok {
+		if fromLocal {
+			state.localMessages++
+		}
+		if state.trackVertexTraffic && fromLocal {
+			if state.internalMessages == nil {
+				state.internalMessages = make(map[string]uint64)
+			}
+			state.internalMessages[string(src.vertexID(state.numericIDs))]++
+		}
+		state.relay(context, pid, cmd, fromLocal)
+	} else if fromLocal {
+		state.relay(context, context.Parent(), cmd, fromLocal)
 	} else {
 		state.ActorUtil.LogError(context, fmt.Sprintf("[superstep] unknown destination message: msg=%#v", cmd))
 	}
 }
 
+// relay forwards the message. Messages of local vertices are sent by the partition instead under the flow control
+func (state *partitionActor) relay(context actor.Context, to *actor.PID, cmd *command.SuperStepMessage, fromLocal bool) {
+	if !fromLocal || state.outbox.limit == 0 {
+		context.Forward(to)
+		return
+	}
+	if state.relayed == nil {
+		state.relayed = make(map[uint64]relayedMessage)
+	}
+	state.relaySeq++
+	state.relayed[state.relaySeq] = relayedMessage{sender: context.Sender(), seq: cmd.Seq}
+	relayed := *cmd
+	relayed.Seq = state.relaySeq
+	state.outbox.send(context.Request, to, &relayed)
+}
+
+func (state *partitionActor) setNumericIDs(numericIDs bool) {
+	state.numericIDs = numericIDs
+	if numericIDs {
+		state.numericVertices = make(map[uint64]*actor.PID)
+	}
+}
+
+// numericIDOf returns integer ID of the vertex with numeric vertex IDs, otherwise 0
+func (state *partitionActor) numericIDOf(v plugin.Vertex) (uint64, error) {
+	if !state.numericIDs {
+		return 0, nil
+	}
+	return plugin.NumericIDOf(v)
+}
+
+// addVertex indexes a spawned vertex
+func (state *partitionActor) addVertex(vid plugin.VertexID, num uint64, pid *actor.PID) {
+	state.vertices[vid] = pid
+	if state.numericIDs {
+		state.numericVertices[num] = pid
+	}
+}
+
+// removeVertex stops the vertex and removes it from indexes
+func (state *partitionActor) removeVertex(context actor.Context, vid plugin.VertexID) {
+	context.Stop(state.vertices[vid])
+	delete(state.vertices, vid)
+	state.store.delete(vid)
+	state.outEdges.remove(vid)
+	if state.numericIDs {
+		if num, err := plugin.ParseNumericID(vid); err == nil {
+			delete(state.numericVertices, num)
+		}
+	}
+}
+
+// vertexOf finds the local vertex of a message
+func (state *partitionActor) vertexOf(k vertexKey) (*actor.PID, bool) {
+	if state.numericIDs {
+		pid, ok := state.numericVertices[k.num]
+		return pid, ok
+	}
+	pid, ok := state.vertices[k.id]
+	return pid, ok
+}
+
 func (state *partitionActor) broadcastToVertices(context actor.Context, msg interface{}) {
 	for _, pid := range state.vertices {
 		context.Request(pid, msg)
@@ -261,3 +734,101 @@ func (state *partitionActor) resetAckRecorder() {
 		state.ackRecorder.AddToWaitList(string(id))
 	}
 }
+
+// stats returns load of the partition
+func (state *partitionActor) stats() *command.PartitionStats {
+	return &command.PartitionStats{
+		PartitionId:  state.partitionID,
+		Vertices:     uint64(len(state.vertices)),
+		Edges:        state.edges,
+		MessagesSent: state.messagesSent,
+		CutEdges:     state.cutEdges,
+		VertexBytes:  state.store.bytes(),
+		EdgeBytes:    state.outEdges.bytes(),
+	}
+}
+
+// openStore opens the partition store if it's enabled
+func (state *partitionActor) openStore() error {
+	if !state.storeConfig.GetEnabled() || state.store != nil {
+		return nil
+	}
+	s, err := newVertexStore(state.storeConfig, state.plugin)
+	if err != nil {
+		return err
+	}
+	state.store = s
+	return nil
+}
+
+// openEdges creates the edge store if the plugin implements EdgePlugin
+func (state *partitionActor) openEdges() {
+	if state.outEdges != nil {
+		return
+	}
+	if ep, ok := edgePluginOf(state.plugin); ok {
+		state.outEdges = newEdgeStore(ep.EdgeValueType(), state.numericIDs)
+	}
+}
+
+// takeEdges stores edges of the vertex if it hands them over
+func (state *partitionActor) takeEdges(v plugin.Vertex) error {
+	src, ok := v.(plugin.EdgeSource)
+	if !ok || state.outEdges == nil {
+		return nil
+	}
+	return state.outEdges.take(v.GetID(), src)
+}
+
+func (state *partitionActor) closeStore() {
+	state.store.close()
+	state.store = nil
+}
+
+// countEdges adds edges of the vertex to stats
+func (state *partitionActor) countEdges(v plugin.Vertex) {
+	ec := state.edgesOf(v)
+	state.edges += ec.edges
+	state.cutEdges += ec.cut
+	if ec.edges > 0 {
+		state.edgeCounts[v.GetID()] = ec
+	}
+}
+
+// edgesOf counts edges of the vertex. Cut edges are counted only if the vertex lists its edges or they are stored by the partition
+func (state *partitionActor) edgesOf(v plugin.Vertex) edgeCount {
+	var ec edgeCount
+	if state.outEdges.has(v.GetID()) {
+		for it := state.outEdges.iterator(v.GetID()); it.Next(); {
+			ec.edges++
+			if state.numOfPartitions == 0 {
+				continue
+			}
+			if p, err := state.plugin.Partition(it.Dest(), state.numOfPartitions); err == nil && p != state.partitionID {
+				ec.cut++
+			}
+		}
+		return ec
+	}
+	if c, ok := v.(plugin.EdgeCounter); ok {
+		ec.edges = uint64(c.NumOfEdges())
+	}
+	l, ok := v.(plugin.EdgeLister)
+	if !ok {
+		return ec
+	}
+	edges := l.OutEdges()
+	if _, ok := v.(plugin.EdgeCounter); !ok {
+		ec.edges = uint64(len(edges))
+	}
+	if state.numOfPartitions == 0 {
+		return ec
+	}
+	for _, dest := range edges {
+		p, err := state.plugin.Partition(dest, state.numOfPartitions)
+		if err == nil && p != state.partitionID {
+			ec.cut++
+		}
+	}
+	return ec
+}
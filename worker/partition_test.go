@@ -1,15 +1,14 @@
 package worker
 
 import (
-	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
-	"github.com/gogo/protobuf/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/rerorero/prerogel/command"
@@ -93,8 +92,8 @@ func Test_partitionActor_Receive_init(t *testing.T) {
 					case *command.SuperStepBarrier:
 						c.Send(c.Parent(), &command.SuperStepBarrierAck{VertexId: initializedVertes[barrierAckCount]})
 						barrierAckCount++
-					case *command.Compute:
-						c.Send(c.Parent(), &command.ComputeAck{VertexId: initializedVertes[computeAckCount]})
+					case *computeLocal:
+						c.Send(c.Parent(), &computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: initializedVertes[computeAckCount]}})
 						computeAckCount++
 					}
 				}),
@@ -107,7 +106,7 @@ func Test_partitionActor_Receive_init(t *testing.T) {
 				&command.LoadVertex{VertexId: "test2"},
 				&command.LoadVertex{VertexId: "test3"},
 				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 0},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
 			},
 			wantRespond: []proto.Message{
 				&command.InitPartitionAck{PartitionId: 123},
@@ -115,13 +114,51 @@ func Test_partitionActor_Receive_init(t *testing.T) {
 				&command.LoadVertexAck{VertexId: "test2"},
 				&command.LoadVertexAck{VertexId: "test3"},
 				&command.SuperStepBarrierPartitionAck{PartitionId: 123},
-				&command.ComputePartitionAck{
-					PartitionId:      123,
-					AggregatedValues: make(map[string]*types.Any),
+				&computePartitionAckLocal{
+					ComputePartitionAck: &command.ComputePartitionAck{
+						PartitionId: 123,
+						Stats:       &command.PartitionStats{PartitionId: 123, Vertices: 3},
+					},
+					aggregated: make(map[string]plugin.AggregatableValue),
 				},
 			},
 			wantInitializedVertex: []string{"test1", "test2", "test3"},
 		},
+		{
+			name: "numeric vertex ids",
+			fields: fields{
+				plugin: &MockedPlugin{
+					GetAggregatorsMock: func() []plugin.Aggregator {
+						return nil
+					},
+				},
+				vertexProps: actor.PropsFromFunc(func(c actor.Context) {
+					mockMux.Lock()
+					defer mockMux.Unlock()
+					switch cmd := c.Message().(type) {
+					case *command.LoadVertex:
+						initializedVertes = append(initializedVertes, cmd.VertexId)
+						c.Respond(&command.LoadVertexAck{VertexId: cmd.VertexId})
+					case *command.SuperStepBarrier:
+						c.Send(c.Parent(), &command.SuperStepBarrierAck{VertexId: initializedVertes[barrierAckCount]})
+						barrierAckCount++
+					}
+				}),
+			},
+			cmd: []proto.Message{
+				&command.InitPartition{PartitionId: 123, NumericVertexIds: true},
+				&command.LoadVertex{VertexId: "1"},
+				&command.LoadVertex{VertexId: "test"},
+				&command.SuperStepBarrier{},
+			},
+			wantRespond: []proto.Message{
+				&command.InitPartitionAck{PartitionId: 123},
+				&command.LoadVertexAck{VertexId: "1"},
+				&command.LoadVertexAck{VertexId: "test", Error: `vertex id is not numeric: "test"`},
+				&command.SuperStepBarrierPartitionAck{PartitionId: 123},
+			},
+			wantInitializedVertex: []string{"1"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -140,7 +177,7 @@ func Test_partitionActor_Receive_init(t *testing.T) {
 				if (err != nil) != (tt.wantRespond[i] == nil) {
 					t.Fatalf("i=%d: %v Ack %d", i, err, barrierAckCount)
 				}
-				if diff := cmp.Diff(tt.wantRespond[i], res); diff != "" {
+				if diff := cmp.Diff(tt.wantRespond[i], res, localAckOpts); diff != "" {
 					t.Errorf("i=%d: unexpected respond: %s", i, diff)
 				}
 			}
@@ -173,10 +210,10 @@ func Test_partitionActor_Receive_superstep(t *testing.T) {
 		case *command.SuperStepBarrier:
 			i := atomic.AddInt32(&called, 1)
 			c.Send(c.Parent(), &command.SuperStepBarrierAck{VertexId: string(vid[i-1])})
-		case *command.Compute:
+		case *computeLocal:
 			i := atomic.AddInt32(&called, 1)
 			c.Request(c.Parent(), &command.SuperStepMessage{
-				Uuid:         fmt.Sprintf("uuid-%d", i),
+				Seq:          uint64(i),
 				SuperStep:    cmd.SuperStep,
 				SrcVertexId:  string(vid[i-1]),
 				DestVertexId: "dummy",
@@ -184,7 +221,7 @@ func Test_partitionActor_Receive_superstep(t *testing.T) {
 			})
 		case *command.SuperStepMessageAck:
 			i := atomic.AddInt32(&messageAckCount, 1)
-			c.Send(c.Parent(), &command.ComputeAck{VertexId: string(vid[i-1]), Halted: false})
+			c.Send(c.Parent(), &computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string(vid[i-1]), Halted: false}})
 		}
 	})
 
@@ -200,9 +237,9 @@ func Test_partitionActor_Receive_superstep(t *testing.T) {
 		switch cmd := ctx.Message().(type) {
 		case *command.SuperStepMessage:
 			atomic.AddInt32(&receivedMessage, 1)
-			ctx.Respond(&command.SuperStepMessageAck{Uuid: cmd.Uuid})
-		case *command.ComputePartitionAck:
-			computeAckCh <- cmd
+			ctx.Respond(&command.SuperStepMessageAck{Seq: cmd.Seq})
+		case *computePartitionAckLocal:
+			computeAckCh <- cmd.ComputePartitionAck
 		}
 	})
 
@@ -228,7 +265,7 @@ func Test_partitionActor_Receive_superstep(t *testing.T) {
 
 	called = 0
 	messageAckCount = 0
-	proxy.Send(context, &command.Compute{SuperStep: 0})
+	proxy.Send(context, &computeLocal{Compute: &command.Compute{SuperStep: 0}})
 	if ack := <-computeAckCh; ack.PartitionId != 123 {
 		t.Fatal("unexpected partition id")
 	}
@@ -241,8 +278,150 @@ func Test_partitionActor_Receive_superstep(t *testing.T) {
 
 	called = 0
 	messageAckCount = 0
-	proxy.Send(context, &command.Compute{SuperStep: 1})
+	proxy.Send(context, &computeLocal{Compute: &command.Compute{SuperStep: 1}})
 	if ack := <-computeAckCh; ack.PartitionId != 123 {
 		t.Fatal("unexpected partition id")
 	}
 }
+
+func Test_partitionActor_Receive_localRouting(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	plg := &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return nil
+		},
+	}
+	// vertices are spawned as "v<id>"
+	idOf := func(c actor.Context) string {
+		if strings.HasSuffix(c.Self().Id, "vtest-1") {
+			return "test-1"
+		}
+		return "test-2"
+	}
+	// test-1 sends a message to test-2 of the same partition
+	receivedCh := make(chan *command.SuperStepMessage, 1)
+	vertexProps := actor.PropsFromFunc(func(c actor.Context) {
+		switch cmd := c.Message().(type) {
+		case *command.LoadVertex:
+			c.Respond(&command.LoadVertexAck{VertexId: cmd.VertexId})
+		case *command.SuperStepBarrier:
+			c.Send(c.Parent(), &command.SuperStepBarrierAck{VertexId: idOf(c)})
+		case *computeLocal:
+			if idOf(c) == "test-1" {
+				c.Request(c.Parent(), &command.SuperStepMessage{SuperStep: cmd.SuperStep, SrcVertexId: "test-1", DestVertexId: "test-2"})
+			}
+		case *command.SuperStepMessage:
+			receivedCh <- cmd
+		}
+	})
+
+	partitionProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewPartitionActor(plg, vertexProps, logger)
+	})
+	forwardedCh := make(chan *command.SuperStepMessage, 1)
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, partitionProps, func(ctx actor.Context) {
+		if cmd, ok := ctx.Message().(*command.SuperStepMessage); ok {
+			forwardedCh <- cmd
+		}
+	})
+
+	if _, err := proxy.SendAndAwait(context, &command.InitPartition{PartitionId: 1}, &command.InitPartitionAck{}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"test-1", "test-2"} {
+		if _, err := proxy.SendAndAwait(context, &command.LoadVertex{VertexId: id}, &command.LoadVertexAck{}, time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := proxy.SendAndAwait(context, &command.SuperStepBarrier{}, &command.SuperStepBarrierPartitionAck{}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+
+	proxy.Send(context, &computeLocal{Compute: &command.Compute{SuperStep: 0}})
+	select {
+	case msg := <-receivedCh:
+		if msg.DestVertexId != "test-2" {
+			t.Fatalf("unexpected message: %#v", msg)
+		}
+	case msg := <-forwardedCh:
+		t.Fatalf("message between vertices of the partition should not be forwarded to the worker: %#v", msg)
+	case <-time.After(time.Second):
+		t.Fatal("message hasn't been delivered")
+	}
+}
+
+func Test_partitionActor_Receive_localMessages(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vid := []plugin.VertexID{"test-1", "test-2"}
+	plg := &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return nil
+		},
+	}
+	// vertices are spawned as "v<id>", pairOf returns the vertex and the other vertex of the partition
+	pairOf := func(c actor.Context) (plugin.VertexID, plugin.VertexID) {
+		if strings.HasSuffix(c.Self().Id, "v"+string(vid[1])) {
+			return vid[1], vid[0]
+		}
+		return vid[0], vid[1]
+	}
+	// each vertex sends a message to the other vertex of the partition and one to another partition
+	vertexProps := actor.PropsFromFunc(func(c actor.Context) {
+		switch cmd := c.Message().(type) {
+		case *command.LoadVertex:
+			c.Respond(&command.LoadVertexAck{VertexId: cmd.VertexId})
+		case *computeLocal:
+			src, dest := pairOf(c)
+			for i, to := range []plugin.VertexID{dest, "external"} {
+				c.Request(c.Parent(), &command.SuperStepMessage{
+					Seq:          uint64(i + 1),
+					SuperStep:    cmd.SuperStep,
+					SrcVertexId:  string(src),
+					DestVertexId: string(to),
+				})
+			}
+		case *command.SuperStepMessage:
+			c.Respond(&command.SuperStepMessageAck{Seq: cmd.Seq})
+		case *command.SuperStepMessageAck:
+			if cmd.Seq == 2 {
+				id, _ := pairOf(c)
+				c.Send(c.Parent(), &computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string(id)}})
+			}
+		}
+	})
+
+	partitionProps := actor.PropsFromProducer(func() actor.Actor {
+		return NewPartitionActor(plg, vertexProps, logger)
+	})
+	computeAckCh := make(chan *command.ComputePartitionAck, 1)
+	context := actor.EmptyRootContext
+	proxy := util.NewActorProxy(context, partitionProps, func(ctx actor.Context) {
+		switch cmd := ctx.Message().(type) {
+		case *command.SuperStepMessage:
+			ctx.Respond(&command.SuperStepMessageAck{Seq: cmd.Seq})
+		case *computePartitionAckLocal:
+			computeAckCh <- cmd.ComputePartitionAck
+		}
+	})
+
+	if _, err := proxy.SendAndAwait(context, &command.InitPartition{PartitionId: 1}, &command.InitPartitionAck{}, time.Second); err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range vid {
+		if _, err := proxy.SendAndAwait(context, &command.LoadVertex{VertexId: string(id)}, &command.LoadVertexAck{}, time.Second); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Compute implies the barrier
+	proxy.Send(context, &computeLocal{Compute: &command.Compute{SuperStep: 0}})
+	select {
+	case ack := <-computeAckCh:
+		if ack.LocalMessages != 2 {
+			t.Fatalf("messages between vertices of the partition should be counted: %d", ack.LocalMessages)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("partition hasn't completed compute")
+	}
+}
@@ -0,0 +1,79 @@
+package worker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// pipeline holds progress of stages run by coordinator
+type pipeline struct {
+	stages    []*command.PipelineStage
+	stats     []*command.StageStats
+	current   int
+	startedAt time.Time
+}
+
+func newPipeline(plg plugin.Plugin, stages []*command.PipelineStage) (*pipeline, error) {
+	if len(stages) == 0 {
+		return nil, errors.New("no stages in pipeline")
+	}
+	stats := make([]*command.StageStats, len(stages))
+	for i, s := range stages {
+		if _, err := findStage(plg, s.Name); err != nil {
+			return nil, err
+		}
+		stats[i] = &command.StageStats{Name: s.Name}
+	}
+	return &pipeline{
+		stages: stages,
+		stats:  stats,
+	}, nil
+}
+
+func (p *pipeline) currentStage() *command.PipelineStage {
+	return p.stages[p.current]
+}
+
+func (p *pipeline) currentStats() *command.StageStats {
+	return p.stats[p.current]
+}
+
+// start marks the current stage as running
+func (p *pipeline) start() {
+	p.startedAt = time.Now()
+}
+
+// step records stats of a finished superstep of the current stage
+func (p *pipeline) step(sentMessages uint64) {
+	s := p.currentStats()
+	s.SuperSteps++
+	s.NrOfSentMessages += sentMessages
+	s.ElapsedMillis = int64(time.Since(p.startedAt) / time.Millisecond)
+}
+
+// next completes the current stage and returns false if there are no more stages
+func (p *pipeline) next() bool {
+	p.currentStats().Completed = true
+	if p.current+1 >= len(p.stages) {
+		return false
+	}
+	p.current++
+	return true
+}
+
+func findStage(plg plugin.Plugin, name string) (plugin.Stage, error) {
+	pp, ok := plg.(plugin.PipelinePlugin)
+	if !ok {
+		return nil, fmt.Errorf("%s: plugin doesn't provide stages", name)
+	}
+	for _, s := range pp.GetStages() {
+		if s.Name() == name {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: no such stage", name)
+}
@@ -0,0 +1,63 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rerorero/prerogel/plugin"
+	"github.com/rerorero/prerogel/plugin/validation"
+)
+
+// validatePlugin checks aggregators of all algorithms hosted by the proxy, and Partition() if numOfPartitions is known.
+// Partition() is given integer vertex IDs if numericIDs is true.
+// It has to be called before system aggregators are appended.
+func validatePlugin(pp *pluginProxy, numOfPartitions uint64, numericIDs bool) error {
+	plugins := map[string]plugin.Plugin{"": pp.plugin()}
+	if pp.registry != nil {
+		plugins = make(map[string]plugin.Plugin)
+		for _, name := range pp.registry.Names() {
+			p, _ := pp.registry.Get(name)
+			plugins[name] = p
+		}
+	}
+
+	var problems []string
+	for name, p := range plugins {
+		for _, problem := range checkPlugin(p, numOfPartitions, numericIDs) {
+			if name != "" {
+				problem = name + ": " + problem
+			}
+			problems = append(problems, problem)
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid plugin: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func checkPlugin(plg plugin.Plugin, numOfPartitions uint64, numericIDs bool) []string {
+	var problems []string
+	names := make(map[string]struct{})
+	for _, agg := range plg.GetAggregators() {
+		name := agg.Name()
+		if name == "" {
+			problems = append(problems, "aggregator has empty name")
+			continue
+		}
+		if strings.HasPrefix(name, ReservedAggregatorPrefix) {
+			problems = append(problems, fmt.Sprintf("aggregator %s uses reserved prefix %s", name, ReservedAggregatorPrefix))
+		}
+		if _, ok := names[name]; ok {
+			problems = append(problems, fmt.Sprintf("aggregator %s is duplicated", name))
+		}
+		names[name] = struct{}{}
+	}
+
+	if numOfPartitions > 0 {
+		for _, v := range validation.Validate(plg, &validation.Options{NumOfPartitions: []uint64{numOfPartitions}, NumericVertexIDs: numericIDs}) {
+			problems = append(problems, v.String())
+		}
+	}
+	return problems
+}
@@ -0,0 +1,94 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func Test_validatePlugin(t *testing.T) {
+	newPlugin := func(partition uint64, aggs ...plugin.Aggregator) plugin.Plugin {
+		return &MockedPlugin{
+			PartitionMock: func(id plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+				return partition, nil
+			},
+			GetAggregatorsMock: func() []plugin.Aggregator {
+				return aggs
+			},
+		}
+	}
+	sum := aggregator.NewSumUint32Aggregator("sum")
+
+	tests := []struct {
+		name            string
+		plugin          plugin.Plugin
+		numOfPartitions uint64
+		wantErr         []string
+	}{
+		{
+			name:            "valid",
+			plugin:          newPlugin(1, sum),
+			numOfPartitions: 2,
+		},
+		{
+			name:    "duplicate aggregators",
+			plugin:  newPlugin(0, sum, aggregator.NewSumUint32Aggregator("sum")),
+			wantErr: []string{"aggregator sum is duplicated"},
+		},
+		{
+			name:    "reserved name",
+			plugin:  newPlugin(0, aggregator.NewSumUint32Aggregator(VertexStatsName)),
+			wantErr: []string{"uses reserved prefix"},
+		},
+		{
+			name:            "partition out of range",
+			plugin:          newPlugin(2, sum),
+			numOfPartitions: 2,
+			wantErr:         []string{"partition-range"},
+		},
+		{
+			name:            "partition is not checked if number of partitions is unknown",
+			plugin:          newPlugin(2, sum),
+			numOfPartitions: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePlugin(newPluginProxy(tt.plugin), tt.numOfPartitions, false)
+			if (err != nil) != (len(tt.wantErr) > 0) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("%q is expected in %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func Test_validatePlugin_registry(t *testing.T) {
+	reg := plugin.NewRegistry()
+	if err := reg.Register("ok", &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator { return nil },
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.Register("ng", &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return []plugin.Aggregator{aggregator.NewSumUint32Aggregator("prerogel/sum")}
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	pp, err := newRegistryPluginProxy(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// inactive algorithm is also validated
+	if err := validatePlugin(pp, 0, false); err == nil || !strings.Contains(err.Error(), "ng: aggregator prerogel/sum") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
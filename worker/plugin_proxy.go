@@ -1,15 +1,33 @@
 package worker
 
 import (
+	"fmt"
+	"sync"
+
 	"github.com/gogo/protobuf/types"
 	"github.com/rerorero/prerogel/plugin"
 )
 
+// algorithmSelector is implemented by plugins hosting multiple algorithms
+type algorithmSelector interface {
+	algorithms() []string
+	activeAlgorithm() string
+	// checkAlgorithm returns an error if the algorithm can't be selected
+	checkAlgorithm(name string) error
+	selectAlgorithm(name string) error
+}
+
 type pluginProxy struct {
-	underlying  plugin.Plugin
-	aggregators []plugin.Aggregator
+	mux              sync.RWMutex
+	registry         *plugin.Registry
+	active           string
+	underlying       plugin.Plugin
+	aggregators      []plugin.Aggregator
+	extraAggregators []plugin.Aggregator
 }
 
+var _ = (algorithmSelector)(&pluginProxy{})
+
 func newPluginProxy(p plugin.Plugin) *pluginProxy {
 	return &pluginProxy{
 		underlying:  p,
@@ -17,35 +35,124 @@ func newPluginProxy(p plugin.Plugin) *pluginProxy {
 	}
 }
 
+// newRegistryPluginProxy returns a proxy that delegates to the active plugin in the registry
+func newRegistryPluginProxy(reg *plugin.Registry) (*pluginProxy, error) {
+	name := reg.Default()
+	p, ok := reg.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("no plugins are registered")
+	}
+	pp := newPluginProxy(p)
+	pp.registry = reg
+	pp.active = name
+	return pp, nil
+}
+
 func (pp *pluginProxy) appendAggregators(agg []plugin.Aggregator) *pluginProxy {
-	pp.aggregators = append(pp.aggregators, agg...)
+	pp.mux.Lock()
+	defer pp.mux.Unlock()
+	pp.extraAggregators = append(pp.extraAggregators, agg...)
+	pp.aggregators = joinAggregators(pp.aggregators, agg)
 	return pp
 }
 
+// joinAggregators returns a new slice so that a slice returned by the plugin is never written
+func joinAggregators(a, b []plugin.Aggregator) []plugin.Aggregator {
+	joined := make([]plugin.Aggregator, 0, len(a)+len(b))
+	joined = append(joined, a...)
+	return append(joined, b...)
+}
+
+func (pp *pluginProxy) algorithms() []string {
+	if pp.registry == nil {
+		return nil
+	}
+	return pp.registry.Names()
+}
+
+func (pp *pluginProxy) activeAlgorithm() string {
+	pp.mux.RLock()
+	defer pp.mux.RUnlock()
+	return pp.active
+}
+
+func (pp *pluginProxy) lookupAlgorithm(name string) (plugin.Plugin, error) {
+	if pp.registry == nil {
+		return nil, fmt.Errorf("%s: algorithm registry is not used", name)
+	}
+	p, ok := pp.registry.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("%s: no such algorithm", name)
+	}
+	return p, nil
+}
+
+func (pp *pluginProxy) checkAlgorithm(name string) error {
+	_, err := pp.lookupAlgorithm(name)
+	return err
+}
+
+func (pp *pluginProxy) selectAlgorithm(name string) error {
+	p, err := pp.lookupAlgorithm(name)
+	if err != nil {
+		return err
+	}
+
+	pp.mux.Lock()
+	defer pp.mux.Unlock()
+	pp.active = name
+	pp.underlying = p
+	pp.aggregators = joinAggregators(p.GetAggregators(), pp.extraAggregators)
+	return nil
+}
+
+func (pp *pluginProxy) plugin() plugin.Plugin {
+	pp.mux.RLock()
+	defer pp.mux.RUnlock()
+	return pp.underlying
+}
+
 func (pp *pluginProxy) NewVertex(id plugin.VertexID) (plugin.Vertex, error) {
-	return pp.underlying.NewVertex(id)
+	return pp.plugin().NewVertex(id)
 }
 
 func (pp *pluginProxy) NewPartitionVertices(partitionID uint64, numOfPartitions uint64, register func(v plugin.Vertex)) error {
-	return pp.underlying.NewPartitionVertices(partitionID, numOfPartitions, register)
+	return pp.plugin().NewPartitionVertices(partitionID, numOfPartitions, register)
 }
 
 func (pp *pluginProxy) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
-	return pp.underlying.Partition(vertex, numOfPartitions)
+	return pp.plugin().Partition(vertex, numOfPartitions)
 }
 
 func (pp *pluginProxy) MarshalMessage(msg plugin.Message) (*types.Any, error) {
-	return pp.underlying.MarshalMessage(msg)
+	return pp.plugin().MarshalMessage(msg)
 }
 
 func (pp *pluginProxy) UnmarshalMessage(pb *types.Any) (plugin.Message, error) {
-	return pp.underlying.UnmarshalMessage(pb)
+	return pp.plugin().UnmarshalMessage(pb)
 }
 
 func (pp *pluginProxy) GetCombiner() func(destination plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
-	return pp.underlying.GetCombiner()
+	return pp.plugin().GetCombiner()
 }
 
 func (pp *pluginProxy) GetAggregators() []plugin.Aggregator {
+	pp.mux.RLock()
+	defer pp.mux.RUnlock()
 	return pp.aggregators
 }
+
+func (pp *pluginProxy) GetStages() []plugin.Stage {
+	if p, ok := pp.plugin().(plugin.PipelinePlugin); ok {
+		return p.GetStages()
+	}
+	return nil
+}
+
+// algorithmsOf returns names of algorithms hosted by the plugin
+func algorithmsOf(plg plugin.Plugin) []string {
+	if s, ok := plg.(algorithmSelector); ok {
+		return s.algorithms()
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/aggregator"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func Test_pluginProxy_selectAlgorithm(t *testing.T) {
+	newPlugin := func(partition uint64, aggs ...plugin.Aggregator) plugin.Plugin {
+		return &MockedPlugin{
+			PartitionMock: func(id plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+				return partition, nil
+			},
+			GetAggregatorsMock: func() []plugin.Aggregator {
+				return aggs
+			},
+		}
+	}
+	reg := plugin.NewRegistry()
+	if err := reg.Register("sssp", newPlugin(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.Register("pagerank", newPlugin(2, aggregator.NewSumUint32Aggregator("sum"))); err != nil {
+		t.Fatal(err)
+	}
+	if err := reg.Register("sssp", newPlugin(3)); err == nil {
+		t.Fatal("duplicate name should be rejected")
+	}
+
+	pp, err := newRegistryPluginProxy(reg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pp.appendAggregators(systemAggregator)
+
+	if diff := cmp.Diff([]string{"pagerank", "sssp"}, algorithmsOf(pp)); diff != "" {
+		t.Fatalf("unexpected algorithms: %s", diff)
+	}
+	if pp.activeAlgorithm() != "sssp" {
+		t.Fatalf("unexpected default algorithm: %s", pp.activeAlgorithm())
+	}
+	if p, _ := pp.Partition("a", 10); p != 1 {
+		t.Fatalf("unexpected partition: %v", p)
+	}
+	if len(pp.GetAggregators()) != 1 {
+		t.Fatalf("unexpected aggregators: %#v", pp.GetAggregators())
+	}
+
+	if err := pp.selectAlgorithm("pagerank"); err != nil {
+		t.Fatal(err)
+	}
+	if p, _ := pp.Partition("a", 10); p != 2 {
+		t.Fatalf("unexpected partition: %v", p)
+	}
+	var names []string
+	for _, a := range pp.GetAggregators() {
+		names = append(names, a.Name())
+	}
+	if diff := cmp.Diff([]string{"sum", VertexStatsName}, names); diff != "" {
+		t.Fatalf("unexpected aggregators: %s", diff)
+	}
+
+	// the slice of the plugin is not written by aggregators of the proxy
+	aggs := make([]plugin.Aggregator, 1, 2)
+	aggs[0] = aggregator.NewSumUint32Aggregator("sum")
+	if err := reg.Register("shared", &MockedPlugin{
+		GetAggregatorsMock: func() []plugin.Aggregator { return aggs },
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := pp.selectAlgorithm("shared"); err != nil {
+		t.Fatal(err)
+	}
+	if extra := aggs[:2][1]; extra != nil {
+		t.Fatalf("aggregators of the plugin are written: %#v", extra)
+	}
+	if len(pp.GetAggregators()) != 2 {
+		t.Fatalf("unexpected aggregators: %#v", pp.GetAggregators())
+	}
+
+	if err := pp.checkAlgorithm("unknown"); err == nil {
+		t.Fatal("unknown algorithm should be rejected")
+	}
+	if err := pp.selectAlgorithm("unknown"); err == nil {
+		t.Fatal("unknown algorithm should be rejected")
+	}
+	if err := newPluginProxy(newPlugin(0)).selectAlgorithm("sssp"); err == nil {
+		t.Fatal("proxy without registry should reject selection")
+	}
+	if algorithmsOf(newPluginProxy(newPlugin(0))) != nil {
+		t.Fatal("proxy without registry should have no algorithms")
+	}
+}
@@ -89,3 +89,27 @@ func (m *MockedAggregator) UnmarshalValue(pb *types.Any) (plugin.AggregatableVal
 func (m *MockedAggregator) ToString(v plugin.AggregatableValue) string {
 	return m.ToStringMock(v)
 }
+
+// MockedPipelinePlugin is mocked PipelinePlugin struct
+type MockedPipelinePlugin struct {
+	MockedPlugin
+	GetStagesMock func() []plugin.Stage
+}
+
+func (m *MockedPipelinePlugin) GetStages() []plugin.Stage {
+	return m.GetStagesMock()
+}
+
+// MockedStage is mocked Stage struct
+type MockedStage struct {
+	NameMock    func() string
+	ComputeMock func(vertex plugin.Vertex, computeContext plugin.ComputeContext, params map[string]string) error
+}
+
+func (m *MockedStage) Name() string {
+	return m.NameMock()
+}
+
+func (m *MockedStage) Compute(vertex plugin.Vertex, computeContext plugin.ComputeContext, params map[string]string) error {
+	return m.ComputeMock(vertex, computeContext, params)
+}
@@ -0,0 +1,164 @@
+package worker
+
+import (
+	"strconv"
+
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// router finds partitions and workers of vertices.
+// It indexes workers by partition each time ClusterInfo is received and optionally caches partitions given by the plugin.
+type router struct {
+	plugin          plugin.Plugin
+	info            *command.ClusterInfo
+	numOfPartitions uint64
+	workers         map[uint64]*command.ClusterInfo_WorkerInfo
+	// cacheSize is max number of vertices whose partition is cached, 0 disables the cache
+	cacheSize    int
+	cache        map[plugin.VertexID]uint64
+	numericCache map[uint64]uint64
+	// numericIDs routes messages by integer IDs of vertices
+	numericIDs bool
+}
+
+// vertexKey identifies the source or destination vertex of a message, num is used instead of id with numeric vertex IDs
+type vertexKey struct {
+	id  plugin.VertexID
+	num uint64
+}
+
+func srcKeyOf(m *command.SuperStepMessage) vertexKey {
+	return vertexKey{id: plugin.VertexID(m.SrcVertexId), num: m.SrcNumericId}
+}
+
+func destKeyOf(m *command.SuperStepMessage) vertexKey {
+	return vertexKey{id: plugin.VertexID(m.DestVertexId), num: m.DestNumericId}
+}
+
+// vertexID returns ID of the vertex, the integer ID is formatted with numeric vertex IDs
+func (k vertexKey) vertexID(numericIDs bool) plugin.VertexID {
+	if numericIDs {
+		return plugin.VertexIDOf(k.num)
+	}
+	return k.id
+}
+
+func newRouter(plg plugin.Plugin, cacheSize int) *router {
+	return &router{
+		plugin:    plg,
+		cacheSize: cacheSize,
+	}
+}
+
+// update rebuilds the index of workers. Cached partitions are kept as long as the number of partitions doesn't change
+func (r *router) update(info *command.ClusterInfo) {
+	n := info.NumOfPartitions()
+	if n != r.numOfPartitions {
+		r.clearCache()
+	}
+	r.info = info
+	r.numOfPartitions = n
+	r.workers = make(map[uint64]*command.ClusterInfo_WorkerInfo, n)
+	for _, wi := range info.GetWorkerInfo() {
+		for _, p := range wi.Partitions {
+			r.workers[p] = wi
+		}
+	}
+}
+
+// setCacheSize changes max number of cached vertices
+func (r *router) setCacheSize(size int) {
+	r.cacheSize = size
+	r.clearCache()
+}
+
+// clearCache drops cached partitions, it's necessary when the plugin partitions vertices differently
+func (r *router) clearCache() {
+	r.cache = nil
+	r.numericCache = nil
+}
+
+// partitionOf returns partition of the vertex. Vertices moved between partitions are looked up before the plugin
+func (r *router) partitionOf(vid plugin.VertexID) (uint64, error) {
+	if p, ok := r.info.GetMovedVertices()[string(vid)]; ok {
+		return p, nil
+	}
+	if p, ok := r.cache[vid]; ok {
+		return p, nil
+	}
+	p, err := r.plugin.Partition(vid, r.numOfPartitions)
+	if err != nil || r.cacheSize <= 0 {
+		return p, err
+	}
+	if len(r.cache) >= r.cacheSize {
+		// start over rather than tracking recency, vertices sending messages are cached again soon
+		r.cache = nil
+	}
+	if r.cache == nil {
+		r.cache = make(map[plugin.VertexID]uint64)
+	}
+	r.cache[vid] = p
+	return p, nil
+}
+
+// partitionOfNumeric returns partition of the vertex identified by integer ID
+func (r *router) partitionOfNumeric(id uint64) (uint64, error) {
+	if moved := r.info.GetMovedVertices(); len(moved) > 0 {
+		if p, ok := moved[strconv.FormatUint(id, 10)]; ok {
+			return p, nil
+		}
+	}
+	if p, ok := r.numericCache[id]; ok {
+		return p, nil
+	}
+	var p uint64
+	var err error
+	if np, ok := numericPartitionerOf(r.plugin); ok {
+		p, err = np.PartitionNumeric(id, r.numOfPartitions)
+	} else {
+		p, err = r.plugin.Partition(plugin.VertexIDOf(id), r.numOfPartitions)
+	}
+	if err != nil || r.cacheSize <= 0 {
+		return p, err
+	}
+	if len(r.numericCache) >= r.cacheSize {
+		r.numericCache = nil
+	}
+	if r.numericCache == nil {
+		r.numericCache = make(map[uint64]uint64)
+	}
+	r.numericCache[id] = p
+	return p, nil
+}
+
+// partitionOfKey returns partition of the vertex of a message
+func (r *router) partitionOfKey(k vertexKey) (uint64, error) {
+	if r.numericIDs {
+		return r.partitionOfNumeric(k.num)
+	}
+	return r.partitionOf(k.id)
+}
+
+// workerOfPartition returns worker info that owns the partition, nil if not found
+func (r *router) workerOfPartition(partition uint64) *command.ClusterInfo_WorkerInfo {
+	return r.workers[partition]
+}
+
+// workerOf returns worker info that owns the vertex
+func (r *router) workerOf(vid plugin.VertexID) (*command.ClusterInfo_WorkerInfo, error) {
+	p, err := r.partitionOf(vid)
+	if err != nil {
+		return nil, err
+	}
+	return r.workers[p], nil
+}
+
+// workerOfKey returns worker info that owns the vertex of a message
+func (r *router) workerOfKey(k vertexKey) (*command.ClusterInfo_WorkerInfo, error) {
+	p, err := r.partitionOfKey(k)
+	if err != nil {
+		return nil, err
+	}
+	return r.workers[p], nil
+}
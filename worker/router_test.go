@@ -0,0 +1,136 @@
+package worker
+
+import (
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// countingPlugin counts calls of Partition()
+type countingPlugin struct {
+	maxPlugin
+	calls int
+}
+
+func (p *countingPlugin) Partition(vertex plugin.VertexID, numOfPartitions uint64) (uint64, error) {
+	p.calls++
+	return p.maxPlugin.Partition(vertex, numOfPartitions)
+}
+
+func Test_router_partitionOf(t *testing.T) {
+	plg := &maxPlugin{size: 4}
+	r := newRouter(plg, 0)
+	r.update(&command.ClusterInfo{
+		WorkerInfo:    []*command.ClusterInfo_WorkerInfo{{WorkerPid: actor.NewLocalPID("w0"), Partitions: []uint64{0, 1, 2}}},
+		MovedVertices: map[string]uint64{"v1": 2},
+	})
+	if p, err := r.partitionOf("v1"); err != nil || p != 2 {
+		t.Fatalf("moved vertex should be routed to the new partition: %v, %v", p, err)
+	}
+	want, _ := plg.Partition("v2", 3)
+	if p, err := r.partitionOf("v2"); err != nil || p != want {
+		t.Fatalf("unexpected partition: %v, %v", p, err)
+	}
+}
+
+func Test_router_workerOf(t *testing.T) {
+	w0 := &command.ClusterInfo_WorkerInfo{WorkerPid: actor.NewLocalPID("w0"), Partitions: []uint64{0, 2}}
+	w1 := &command.ClusterInfo_WorkerInfo{WorkerPid: actor.NewLocalPID("w1"), Partitions: []uint64{1}}
+	r := newRouter(&maxPlugin{}, 0)
+	r.update(&command.ClusterInfo{WorkerInfo: []*command.ClusterInfo_WorkerInfo{w0, w1}})
+
+	for p, want := range map[uint64]*command.ClusterInfo_WorkerInfo{0: w0, 1: w1, 2: w0, 3: nil} {
+		if got := r.workerOfPartition(p); got != want {
+			t.Fatalf("partition %v: unexpected worker %v", p, got)
+		}
+	}
+	p, _ := r.partitionOf("v0")
+	if w, err := r.workerOf("v0"); err != nil || w != r.workerOfPartition(p) {
+		t.Fatalf("unexpected worker: %v, %v", w, err)
+	}
+
+	// partitions moved to another worker
+	r.update(&command.ClusterInfo{WorkerInfo: []*command.ClusterInfo_WorkerInfo{
+		{WorkerPid: w0.WorkerPid, Partitions: []uint64{0}},
+		{WorkerPid: w1.WorkerPid, Partitions: []uint64{1, 2}},
+	}})
+	if got := r.workerOfPartition(2); got.WorkerPid.Id != "w1" {
+		t.Fatalf("index is not rebuilt: %v", got)
+	}
+}
+
+func Test_router_partitionOfNumeric(t *testing.T) {
+	plg := &numericMaxPlugin{}
+	r := newRouter(plg, 2)
+	r.numericIDs = true
+	r.update(&command.ClusterInfo{
+		WorkerInfo:    []*command.ClusterInfo_WorkerInfo{{WorkerPid: actor.NewLocalPID("w0"), Partitions: []uint64{0, 1, 2}}},
+		MovedVertices: map[string]uint64{"7": 1},
+	})
+	if p, err := r.partitionOfKey(vertexKey{num: 7}); err != nil || p != 1 {
+		t.Fatalf("moved vertex should be routed to the new partition: %v, %v", p, err)
+	}
+	for id := uint64(0); id < 5; id++ {
+		want, _ := plg.Partition(plugin.VertexIDOf(id), 3)
+		if p, err := r.partitionOfKey(vertexKey{num: id}); err != nil || p != want {
+			t.Fatalf("unexpected partition of %v: %v, %v", id, p, err)
+		}
+	}
+	if len(r.numericCache) == 0 || len(r.cache) != 0 {
+		t.Fatalf("integer IDs should be cached separately: %v, %v", r.numericCache, r.cache)
+	}
+
+	// plugins without NumericPartitioner partition formatted IDs
+	r = newRouter(&maxPlugin{}, 0)
+	r.numericIDs = true
+	r.update(&command.ClusterInfo{WorkerInfo: []*command.ClusterInfo_WorkerInfo{{WorkerPid: actor.NewLocalPID("w0"), Partitions: []uint64{0, 1, 2}}}})
+	want, _ := plugin.HashPartition("12", 3)
+	if p, err := r.partitionOfKey(vertexKey{num: 12}); err != nil || p != want {
+		t.Fatalf("unexpected partition: %v, %v", p, err)
+	}
+}
+
+func Test_router_cache(t *testing.T) {
+	plg := &countingPlugin{}
+	r := newRouter(plg, 2)
+	info := &command.ClusterInfo{WorkerInfo: []*command.ClusterInfo_WorkerInfo{{WorkerPid: actor.NewLocalPID("w0"), Partitions: []uint64{0, 1, 2}}}}
+	r.update(info)
+
+	for i := 0; i < 3; i++ {
+		if _, err := r.partitionOf("v0"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if plg.calls != 1 {
+		t.Fatalf("partition should be cached: calls=%v", plg.calls)
+	}
+
+	// the cache is kept as long as the number of partitions is the same
+	r.update(&command.ClusterInfo{WorkerInfo: info.WorkerInfo, MovedVertices: map[string]uint64{"v1": 0}})
+	r.partitionOf("v0")
+	if plg.calls != 1 {
+		t.Fatalf("cache should be kept: calls=%v", plg.calls)
+	}
+
+	// full cache starts over
+	r.partitionOf("v2")
+	r.partitionOf("v3")
+	if len(r.cache) != 1 {
+		t.Fatalf("unexpected cache: %v", r.cache)
+	}
+
+	r.update(&command.ClusterInfo{WorkerInfo: []*command.ClusterInfo_WorkerInfo{{WorkerPid: actor.NewLocalPID("w0"), Partitions: []uint64{0, 1}}}})
+	if len(r.cache) != 0 {
+		t.Fatalf("cache should be dropped when the number of partitions changes: %v", r.cache)
+	}
+
+	r.setCacheSize(0)
+	calls := plg.calls
+	r.partitionOf("v0")
+	r.partitionOf("v0")
+	if plg.calls != calls+2 || len(r.cache) != 0 {
+		t.Fatalf("cache should be disabled: calls=%v cache=%v", plg.calls-calls, r.cache)
+	}
+}
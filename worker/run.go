@@ -35,14 +35,47 @@ func Run(ctx context.Context, plg plugin.Plugin, envPrefix string) error {
 	}
 }
 
+// RunRegistry starts worker server hosting all algorithms in the registry
+func RunRegistry(ctx context.Context, reg *plugin.Registry, envPrefix string) error {
+	conf, err := config.LoadWorkerConfFromEnv(envPrefix)
+	if err != nil {
+		return err
+	}
+
+	proxy, err := newRegistryPluginProxy(reg)
+	if err != nil {
+		return err
+	}
+
+	switch c := conf.(type) {
+	case *config.MasterEnv:
+		return runMaster(ctx, proxy, c)
+	case *config.WorkerEnv:
+		return runWorker(ctx, proxy, c)
+	default:
+		return fmt.Errorf("invalid config: %#v", c)
+	}
+}
+
 // RunMaster starts running as master worker
 func RunMaster(ctx context.Context, plg plugin.Plugin, conf *config.MasterEnv) error {
+	return runMaster(ctx, newPluginProxy(plg), conf)
+}
+
+func runMaster(ctx context.Context, proxy *pluginProxy, conf *config.MasterEnv) error {
 	root := actor.EmptyRootContext
 	logger := conf.Logger()
 	wait := newWaiting(ctx)
 
+	if err := validatePlugin(proxy, conf.Partitions, conf.NumericVertexIDs); err != nil {
+		return err
+	}
+	compression, err := parseBatchCompression(conf.MessageCompression)
+	if err != nil {
+		return err
+	}
 	// injection aggregators used for internal
-	plg = newPluginProxy(plg).appendAggregators(systemAggregator)
+	plg := proxy.appendAggregators(systemAggregator)
 
 	workerForLocal := workerProps(plg, logger, nil)
 	coordinatorProps := actor.PropsFromProducer(func() actor.Actor {
@@ -65,9 +98,34 @@ func RunMaster(ctx context.Context, plg plugin.Plugin, conf *config.MasterEnv) e
 	}
 
 	f := root.RequestFuture(coordinator, &command.NewCluster{
-		Workers:        workers,
-		NrOfPartitions: conf.Partitions,
-	}, 120*time.Second)
+		Workers:                   workers,
+		NrOfPartitions:            conf.Partitions,
+		MinRegisteredWorkers:      uint32(conf.MinWorkers),
+		RegistrationTimeoutMillis: conf.RegistrationTimeout.Nanoseconds() / int64(time.Millisecond),
+		WorkerDns:                 conf.WorkerDNS,
+		WorkerDnsSrv:              conf.WorkerDNSSRV,
+		RebalanceThreshold:        conf.RebalanceThreshold,
+		MaxVertexMoves:            conf.MaxVertexMoves,
+		VertexCacheSize:           conf.VertexCacheSize,
+		NumericVertexIds:          conf.NumericVertexIDs,
+		MessageBatch:              conf.MessageBatch,
+		MessageCompression:        compression,
+		FlowControl: &command.FlowControl{
+			VertexInflight:    conf.VertexInflight,
+			PartitionInflight: conf.PartitionInflight,
+			WorkerInflight:    conf.WorkerInflight,
+		},
+		MessageSpill: &command.MessageSpill{
+			MemoryLimit: conf.MessageMemoryLimit,
+			Dir:         conf.SpillDir,
+		},
+		PartitionStore: &command.PartitionStore{
+			Enabled:     conf.PartitionStore,
+			Dir:         conf.PartitionStoreDir,
+			MemoryLimit: conf.PartitionMemoryLimit,
+		},
+		SuperstepBarrier: conf.SuperStepBarrier,
+	}, conf.RegistrationTimeout+120*time.Second)
 	if err := f.Wait(); err != nil {
 		return errors.Wrap(err, "failed to connect to worker: ")
 	}
@@ -75,9 +133,13 @@ func RunMaster(ctx context.Context, plg plugin.Plugin, conf *config.MasterEnv) e
 	if err != nil {
 		return errors.Wrap(err, "failed to initialize coordinator: ")
 	}
-	if _, ok := res.(*command.NewClusterAck); !ok {
+	ack, ok := res.(*command.NewClusterAck)
+	if !ok {
 		return fmt.Errorf("failed to initialize cooridnator: unknown ack %#v", res)
 	}
+	if ack.Error != "" {
+		return fmt.Errorf("failed to initialize cluster: %s", ack.Error)
+	}
 
 	// ctrl server
 	ln, err := net.Listen("tcp", fmt.Sprintf(":%d", conf.APIPort))
@@ -92,8 +154,8 @@ func RunMaster(ctx context.Context, plg plugin.Plugin, conf *config.MasterEnv) e
 	}()
 	defer srv.shutdown(ctx)
 
-	logger.Info(fmt.Sprintf("coordinator is running: addr=%s partitions=%v workers=%s log=%s api_port=%d",
-		conf.ListenAddress, conf.Partitions, conf.WorkerAddresses, logger.Level.String(), conf.APIPort))
+	logger.Info(fmt.Sprintf("coordinator is running: addr=%s partitions=%v workers=%s log=%s api_port=%d algorithms=%v",
+		conf.ListenAddress, conf.Partitions, conf.WorkerAddresses, logger.Level.String(), conf.APIPort, proxy.algorithms()))
 
 	wait.waitUntilDone()
 	return nil
@@ -101,30 +163,77 @@ func RunMaster(ctx context.Context, plg plugin.Plugin, conf *config.MasterEnv) e
 
 // RunWorker starts running as normal worker
 func RunWorker(ctx context.Context, plg plugin.Plugin, conf *config.WorkerEnv) error {
+	return runWorker(ctx, newPluginProxy(plg), conf)
+}
+
+func runWorker(ctx context.Context, proxy *pluginProxy, conf *config.WorkerEnv) error {
 	logger := conf.Logger()
 	wait := newWaiting(ctx)
+
+	// number of partitions is not known until the cluster is formed
+	if err := validatePlugin(proxy, 0, false); err != nil {
+		return err
+	}
 	// injection aggregators used for internal
-	plg = newPluginProxy(plg).appendAggregators(systemAggregator)
+	plg := proxy.appendAggregators(systemAggregator)
 
 	remote.Register(WorkerActorKind, workerProps(plg, logger, wait))
 	remote.Start(conf.ListenAddress)
 
 	logger.Info(fmt.Sprintf("worker is running: addr=%s log=%s", conf.ListenAddress, logger.Level.String()))
 
+	if conf.MasterAddress != "" {
+		go registerWorker(ctx, conf, logger)
+	}
+
 	wait.waitUntilDone()
 	return nil
 }
 
+// registerWorker registers the worker with the master, retrying until the master responds
+func registerWorker(ctx context.Context, conf *config.WorkerEnv, logger *logrus.Logger) {
+	coordinator := actor.NewPID(conf.MasterAddress, CoordinatorActorID)
+	for {
+		res, err := actor.EmptyRootContext.RequestFuture(coordinator, &command.RegisterWorker{
+			HostAndPort: conf.RegisterAddress(),
+		}, 5*time.Second).Result()
+		if err == nil {
+			if ack, ok := res.(*command.RegisterWorkerAck); ok && ack.Error != "" {
+				logger.Error(fmt.Sprintf("master refused registration: %s", ack.Error))
+			} else {
+				logger.Info(fmt.Sprintf("registered with master %s", conf.MasterAddress))
+			}
+			return
+		}
+		logger.WithError(err).Debug("failed to register with master, retrying")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
 func workerProps(plg plugin.Plugin, logger *logrus.Logger, w *waiting) *actor.Props {
-	vertexProps := actor.PropsFromProducer(func() actor.Actor {
-		return NewVertexActor(plg, logger)
-	})
-	partitionProps := actor.PropsFromProducer(func() actor.Actor {
+	// mailboxes are measured for the flow control stats
+	gauges := &mailboxGauges{}
+	// vertices and workers of the process spill messages under the same memory limit
+	spill := &messageSpill{}
+	vertexProps := withGauge(actor.PropsFromProducer(func() actor.Actor {
+		a := NewVertexActor(plg, logger).(*vertexActor)
+		a.spill = spill
+		return a
+	}), &gauges.vertex)
+	partitionProps := withGauge(actor.PropsFromProducer(func() actor.Actor {
 		return NewPartitionActor(plg, vertexProps, logger)
-	})
-	return actor.PropsFromProducer(func() actor.Actor {
-		return NewWorkerActor(plg, partitionProps, w.shutdownHandler, logger)
-	})
+	}), &gauges.partition)
+	return withGauge(actor.PropsFromProducer(func() actor.Actor {
+		a := NewWorkerActor(plg, partitionProps, w.shutdownHandler, logger).(*workerActor)
+		a.mailboxes = gauges
+		a.spill = spill
+		return a
+	}), &gauges.worker)
 }
 
 type waiting struct {
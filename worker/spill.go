@@ -0,0 +1,417 @@
+package worker
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// spillChunkBytes is the minimum size of a queue spilled at once so that small queues don't make tiny files
+const spillChunkBytes = 16 * 1024
+
+// messageSpill counts bytes of messages queued by vertices and the worker of a worker process.
+// Past the memory limit, queues are spilled to files on local disk. It's configured by InitWorker.
+type messageSpill struct {
+	used   int64
+	config atomic.Value
+	// readMu guards reading and waiters. Vertices read their spilled messages back in turns so that they fit the memory limit
+	readMu  sync.Mutex
+	reading int
+	waiters []func()
+}
+
+func (s *messageSpill) configure(c *command.MessageSpill) {
+	if c == nil {
+		c = &command.MessageSpill{}
+	}
+	s.config.Store(c)
+}
+
+func (s *messageSpill) getConfig() *command.MessageSpill {
+	if s == nil {
+		return nil
+	}
+	c, _ := s.config.Load().(*command.MessageSpill)
+	return c
+}
+
+// enabled returns if the memory limit is set
+func (s *messageSpill) enabled() bool {
+	return s.getConfig().GetMemoryLimit() > 0
+}
+
+// reserve counts bytes of a queued message, it returns true if queues exceed the memory limit
+func (s *messageSpill) reserve(n int) bool {
+	return atomic.AddInt64(&s.used, int64(n)) > int64(s.getConfig().GetMemoryLimit())
+}
+
+// chunk returns the minimum size of a queue to be spilled, which is smaller than the memory limit
+func (s *messageSpill) chunk() int {
+	if l := s.getConfig().GetMemoryLimit(); l < spillChunkBytes {
+		return int(l)
+	}
+	return spillChunkBytes
+}
+
+// release uncounts bytes of messages which have been spilled or consumed
+func (s *messageSpill) release(n int) {
+	atomic.AddInt64(&s.used, -int64(n))
+}
+
+// admitReadBack counts n bytes of spilled messages to be read back if they fit the memory limit or nothing else is being read back.
+// Otherwise it returns false and calls retry once another read back is released.
+func (s *messageSpill) admitReadBack(n int, retry func()) bool {
+	s.readMu.Lock()
+	defer s.readMu.Unlock()
+	if s.reading > 0 && atomic.LoadInt64(&s.used)+int64(n) > int64(s.getConfig().GetMemoryLimit()) {
+		s.waiters = append(s.waiters, retry)
+		return false
+	}
+	s.reading += n
+	atomic.AddInt64(&s.used, int64(n))
+	return true
+}
+
+// releaseReadBack uncounts messages read back and consumed, then lets waiting vertices retry
+func (s *messageSpill) releaseReadBack(n int) {
+	s.readMu.Lock()
+	s.reading -= n
+	atomic.AddInt64(&s.used, -int64(n))
+	waiters := s.waiters
+	s.waiters = nil
+	s.readMu.Unlock()
+	for _, retry := range waiters {
+		retry()
+	}
+}
+
+// create creates a file to spill messages, in the temporary directory by default
+func (s *messageSpill) create(prefix string) (*os.File, error) {
+	f, err := ioutil.TempFile(s.getConfig().GetDir(), prefix+"-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create spill file")
+	}
+	return f, nil
+}
+
+// spillWriter writes messages prefixed by uvarint length
+type spillWriter struct {
+	f   *os.File
+	w   *bufio.Writer
+	tmp [binary.MaxVarintLen64]byte
+}
+
+func newSpillWriter(f *os.File) *spillWriter {
+	return &spillWriter{f: f, w: bufio.NewWriter(f)}
+}
+
+func (w *spillWriter) write(pb proto.Message) error {
+	b, err := proto.Marshal(pb)
+	if err != nil {
+		return err
+	}
+	l := binary.PutUvarint(w.tmp[:], uint64(len(b)))
+	if _, err := w.w.Write(w.tmp[:l]); err != nil {
+		return err
+	}
+	_, err = w.w.Write(b)
+	return err
+}
+
+func (w *spillWriter) close() error {
+	if err := w.w.Flush(); err != nil {
+		w.f.Close()
+		return err
+	}
+	return w.f.Close()
+}
+
+// spillReader streams messages written by spillWriter
+type spillReader struct {
+	f   *os.File
+	r   *bufio.Reader
+	buf []byte
+}
+
+func openSpillReader(path string) (*spillReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open spill file")
+	}
+	return &spillReader{f: f, r: bufio.NewReader(f)}, nil
+}
+
+// next reads the next message into pb, it returns io.EOF at the end of the file
+func (r *spillReader) next(pb proto.Message) error {
+	l, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return err
+	}
+	if uint64(cap(r.buf)) < l {
+		r.buf = make([]byte, l)
+	}
+	r.buf = r.buf[:l]
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		return errors.Wrap(err, "truncated spill file")
+	}
+	return proto.Unmarshal(r.buf, pb)
+}
+
+func (r *spillReader) close() {
+	r.f.Close()
+}
+
+// heldSpill keeps messages held by an outbox in a file, they are read back one by one as acks come.
+// The file is removed as soon as it's created so that it doesn't outlive the process.
+type heldSpill struct {
+	w *spillWriter
+	r *spillReader
+	n int
+}
+
+func newHeldSpill(spill *messageSpill) (*heldSpill, error) {
+	f, err := spill.create("prerogel-held")
+	if err != nil {
+		return nil, err
+	}
+	os.Remove(f.Name())
+	return &heldSpill{
+		w: newSpillWriter(f),
+		// reads don't move the offset of writes
+		r: &spillReader{f: f, r: bufio.NewReader(io.NewSectionReader(f, 0, math.MaxInt64))},
+	}, nil
+}
+
+// push appends the message and its destination
+func (h *heldSpill) push(to *actor.PID, msg proto.Message) error {
+	pb, err := types.MarshalAny(msg)
+	if err != nil {
+		return err
+	}
+	if err := h.w.write(to); err != nil {
+		return err
+	}
+	if err := h.w.write(pb); err != nil {
+		return err
+	}
+	h.n++
+	return nil
+}
+
+// pop reads the oldest message and its destination
+func (h *heldSpill) pop() (*actor.PID, interface{}, error) {
+	if h.w.w.Buffered() > 0 {
+		if err := h.w.w.Flush(); err != nil {
+			return nil, nil, err
+		}
+	}
+	to := &actor.PID{}
+	if err := h.r.next(to); err != nil {
+		return nil, nil, err
+	}
+	var pb types.Any
+	if err := h.r.next(&pb); err != nil {
+		return nil, nil, err
+	}
+	msg, err := types.EmptyAny(&pb)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := types.UnmarshalAny(&pb, msg); err != nil {
+		return nil, nil, err
+	}
+	h.n--
+	return to, msg, nil
+}
+
+func (h *heldSpill) numOfMessages() int {
+	if h == nil {
+		return 0
+	}
+	return h.n
+}
+
+func (h *heldSpill) close() {
+	h.r.close()
+}
+
+// appendSpilledMessages appends messages to the spill file of a vertex, it's created if path is empty
+func appendSpilledMessages(spill *messageSpill, path string, plg plugin.Plugin, msgs []plugin.Message) (string, error) {
+	var f *os.File
+	var err error
+	if path == "" {
+		f, err = spill.create("prerogel-vertex")
+	} else {
+		f, err = os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	}
+	if err != nil {
+		return path, err
+	}
+	w := newSpillWriter(f)
+	for _, m := range msgs {
+		pb, err := plg.MarshalMessage(m)
+		if err != nil {
+			w.close()
+			return f.Name(), errors.Wrapf(err, "failed to marshal message: %#v", m)
+		}
+		if err := w.write(pb); err != nil {
+			w.close()
+			return f.Name(), errors.Wrap(err, "failed to spill message")
+		}
+	}
+	return f.Name(), w.close()
+}
+
+// readSpilledMessages reads messages spilled by a vertex then removes the file
+func readSpilledMessages(path string, plg plugin.Plugin) ([]plugin.Message, error) {
+	defer os.Remove(path)
+	r, err := openSpillReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer r.close()
+
+	var msgs []plugin.Message
+	for {
+		var pb types.Any
+		if err := r.next(&pb); err == io.EOF {
+			return msgs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		m, err := plg.UnmarshalMessage(&pb)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal spilled message: %#v", pb)
+		}
+		msgs = append(msgs, m)
+	}
+}
+
+func keyLess(a, b vertexKey) bool {
+	if a.num != b.num {
+		return a.num < b.num
+	}
+	return a.id < b.id
+}
+
+// spillRun writes buffered messages to a file ordered by destination, then clears the buffer
+func (buf *superStepMsgBuf) spillRun() error {
+	if len(buf.buf) == 0 {
+		return nil
+	}
+	keys := make([]vertexKey, 0, len(buf.buf))
+	for k := range buf.buf {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keyLess(keys[i], keys[j]) })
+
+	f, err := buf.spill.create("prerogel-run")
+	if err != nil {
+		return err
+	}
+	buf.runs = append(buf.runs, f.Name())
+	w := newSpillWriter(f)
+	for _, k := range keys {
+		for _, m := range buf.buf[k] {
+			if err := w.write(m); err != nil {
+				w.close()
+				return errors.Wrap(err, "failed to spill message")
+			}
+			buf.spilled++
+		}
+	}
+	if err := w.close(); err != nil {
+		return errors.Wrap(err, "failed to spill message")
+	}
+	buf.buf = make(map[vertexKey][]*command.SuperStepMessage)
+	buf.spill.release(buf.bytes)
+	buf.bytes = 0
+	return nil
+}
+
+// mergeRuns reads spilled runs in order of destinations, messages of each destination are combined across runs
+func (buf *superStepMsgBuf) mergeRuns(fn func(dest vertexKey, msgs []*command.SuperStepMessage) error) error {
+	defer buf.removeRuns()
+
+	readers := make([]*spillReader, 0, len(buf.runs))
+	heads := make([]*command.SuperStepMessage, 0, len(buf.runs))
+	defer func() {
+		for _, r := range readers {
+			r.close()
+		}
+	}()
+	next := func(i int) error {
+		m := &command.SuperStepMessage{}
+		if err := readers[i].next(m); err == io.EOF {
+			heads[i] = nil
+			return nil
+		} else if err != nil {
+			return err
+		}
+		heads[i] = m
+		return nil
+	}
+	for i, path := range buf.runs {
+		r, err := openSpillReader(path)
+		if err != nil {
+			return err
+		}
+		readers = append(readers, r)
+		heads = append(heads, nil)
+		if err := next(i); err != nil {
+			return err
+		}
+	}
+
+	for {
+		var dest *vertexKey
+		for _, h := range heads {
+			if h == nil {
+				continue
+			}
+			if k := destKeyOf(h); dest == nil || keyLess(k, *dest) {
+				dest = &k
+			}
+		}
+		if dest == nil {
+			return nil
+		}
+		var msgs []*command.SuperStepMessage
+		for i := range heads {
+			for heads[i] != nil && destKeyOf(heads[i]) == *dest {
+				msgs = append(msgs, heads[i])
+				if err := next(i); err != nil {
+					return err
+				}
+			}
+		}
+		msgs, err := buf.combineMessages(*dest, msgs)
+		if err != nil {
+			return err
+		}
+		if err := fn(*dest, msgs); err != nil {
+			return err
+		}
+	}
+}
+
+func (buf *superStepMsgBuf) removeRuns() {
+	for _, path := range buf.runs {
+		os.Remove(path)
+	}
+	buf.runs = nil
+	buf.spilled = 0
+}
@@ -0,0 +1,268 @@
+package worker
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/gogo/protobuf/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func newTestSpill(t *testing.T, limit uint64) (*messageSpill, string) {
+	dir, err := ioutil.TempDir("", "prerogel-spill-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &messageSpill{}
+	s.configure(&command.MessageSpill{MemoryLimit: limit, Dir: dir})
+	return s, dir
+}
+
+func filesIn(t *testing.T, dir string) int {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return len(files)
+}
+
+func Test_superStepMsgBuf_spill(t *testing.T) {
+	spill, dir := newTestSpill(t, 1)
+	defer os.RemoveAll(dir)
+
+	buf := newSuperStepMsgBuf(&MockedPlugin{
+		MarshalMessageMock: func(msg plugin.Message) (*types.Any, error) {
+			return anyOf(msg.(string)), nil
+		},
+		UnmarshalMessageMock: func(a *types.Any) (plugin.Message, error) {
+			return string(a.Value), nil
+		},
+		GetCombinerMock: func() func(plugin.VertexID, []plugin.Message) ([]plugin.Message, error) {
+			return func(id plugin.VertexID, msgs []plugin.Message) ([]plugin.Message, error) {
+				// sort to combine into one message
+				var s []string
+				for _, m := range msgs {
+					s = append(s, m.(string))
+				}
+				sort.Strings(s)
+				var combined string
+				for _, m := range s {
+					combined += m
+				}
+				return []plugin.Message{combined}, nil
+			}
+		},
+	})
+	buf.spill = spill
+
+	for _, m := range []*command.SuperStepMessage{
+		{SuperStep: 1, DestVertexId: "d2", Message: anyOf("a")},
+		{SuperStep: 1, DestVertexId: "d1", Message: anyOf("b")},
+		{SuperStep: 1, DestVertexId: "d2", Message: anyOf("c")},
+		{SuperStep: 1, DestVertexId: "d3", Message: anyOf("d")},
+	} {
+		if err := buf.add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(buf.runs) != 4 || filesIn(t, dir) != 4 {
+		t.Fatalf("every message should be spilled: runs=%d", len(buf.runs))
+	}
+	if buf.numOfMessage() != 4 {
+		t.Fatalf("spilled messages should be counted: %d", buf.numOfMessage())
+	}
+
+	var dests []string
+	got := make(map[string][]string)
+	if err := buf.forEach(func(dest vertexKey, msgs []*command.SuperStepMessage) error {
+		dests = append(dests, string(dest.id))
+		for _, m := range msgs {
+			got[string(dest.id)] = append(got[string(dest.id)], string(m.Message.Value))
+		}
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"d1", "d2", "d3"}, dests); diff != "" {
+		t.Fatalf("runs should be merged in order of destinations: %s", diff)
+	}
+	if diff := cmp.Diff(map[string][]string{"d1": {"b"}, "d2": {"ac"}, "d3": {"d"}}, got); diff != "" {
+		t.Fatalf("unexpected messages: %s", diff)
+	}
+	if filesIn(t, dir) != 0 {
+		t.Fatal("runs should be removed")
+	}
+	if spill.used != 0 {
+		t.Fatalf("spilled messages should be released: %d", spill.used)
+	}
+}
+
+func Test_appendSpilledMessages(t *testing.T) {
+	spill, dir := newTestSpill(t, 1)
+	defer os.RemoveAll(dir)
+
+	plg := &MockedPlugin{
+		MarshalMessageMock: func(msg plugin.Message) (*types.Any, error) {
+			return anyOf(msg.(string)), nil
+		},
+		UnmarshalMessageMock: func(a *types.Any) (plugin.Message, error) {
+			return string(a.Value), nil
+		},
+	}
+	path, err := appendSpilledMessages(spill, "", plg, []plugin.Message{"m1", "m2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := appendSpilledMessages(spill, path, plg, []plugin.Message{"m3"}); err != nil {
+		t.Fatal(err)
+	}
+	msgs, err := readSpilledMessages(path, plg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]plugin.Message{"m1", "m2", "m3"}, msgs); diff != "" {
+		t.Fatalf("unexpected messages: %s", diff)
+	}
+	if filesIn(t, dir) != 0 {
+		t.Fatal("spill file should be removed")
+	}
+}
+
+func Test_messageSpill_readBack(t *testing.T) {
+	s, dir := newTestSpill(t, 100)
+	defer os.RemoveAll(dir)
+
+	var retried []string
+	retry := func(name string) func() {
+		return func() { retried = append(retried, name) }
+	}
+	if !s.admitReadBack(80, retry("a")) {
+		t.Fatal("the first read back should be admitted")
+	}
+	if s.admitReadBack(50, retry("b")) {
+		t.Fatal("read back exceeding the limit should wait")
+	}
+	if !s.admitReadBack(20, retry("c")) {
+		t.Fatal("read back fitting the limit should be admitted")
+	}
+	if s.used != 100 {
+		t.Fatalf("read back bytes should be counted: %d", s.used)
+	}
+
+	s.releaseReadBack(80)
+	if diff := cmp.Diff([]string{"b"}, retried); diff != "" {
+		t.Fatalf("waiting read back should be retried: %s", diff)
+	}
+	s.releaseReadBack(20)
+	// nothing is being read back
+	if !s.admitReadBack(500, retry("b")) {
+		t.Fatal("read back should be admitted alone even if it exceeds the limit")
+	}
+	s.releaseReadBack(500)
+	if s.used != 0 {
+		t.Fatalf("read back bytes should be released: %d", s.used)
+	}
+}
+
+func Test_messageBatcher_spilled(t *testing.T) {
+	spill, dir := newTestSpill(t, 64)
+	defer os.RemoveAll(dir)
+
+	buf := newSuperStepMsgBuf(&MockedPlugin{
+		GetCombinerMock: func() func(plugin.VertexID, []plugin.Message) ([]plugin.Message, error) {
+			return nil
+		},
+	})
+	buf.spill = spill
+	workers := pids("w0", "w1")
+	for i := 0; i < 20; i++ {
+		if err := buf.add(&command.SuperStepMessage{SuperStep: 1, DestVertexId: fmt.Sprintf("d%02d", i), Message: anyOf("m")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(buf.runs) < 2 {
+		t.Fatalf("messages should be spilled: runs=%d", len(buf.runs))
+	}
+
+	var sent []*command.SuperStepMessageBatch
+	request := func(pid *actor.PID, msg interface{}) {
+		sent = append(sent, msg.(*command.SuperStepMessageBatch))
+	}
+	outboxes := make(map[string]*outbox)
+	for _, w := range workers {
+		o := &outbox{}
+		o.reset(1)
+		o.spill = spill
+		outboxes[w.GetId()] = o
+	}
+	batcher := &messageBatcher{
+		size:     2,
+		maxBytes: spill.chunk(),
+		flush: func(to *actor.PID, msgs []*command.SuperStepMessage) error {
+			batch, err := encodeMessageBatch(msgs, false, command.BATCH_COMPRESSION_NONE)
+			if err != nil {
+				return err
+			}
+			return outboxes[to.GetId()].sendProto(request, to, batch)
+		},
+	}
+	if err := buf.forEach(func(dest vertexKey, msgs []*command.SuperStepMessage) error {
+		var i int
+		fmt.Sscanf(string(dest.id), "d%d", &i)
+		return batcher.add(workers[i%2], msgs)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := batcher.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// messages don't gather in memory while they are read back from runs
+	if batcher.maxBuffered > batcher.size*len(workers) {
+		t.Fatalf("too many messages are buffered: %d", batcher.maxBuffered)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("a batch should be in flight for each worker: %d", len(sent))
+	}
+	for id, o := range outboxes {
+		if len(o.held)-o.head != 0 || o.numOfHeld() != 4 {
+			t.Fatalf("batches should be held on disk: worker=%s memory=%d held=%d", id, len(o.held)-o.head, o.numOfHeld())
+		}
+	}
+	if filesIn(t, dir) != 0 {
+		t.Fatal("runs should be removed and held batches shouldn't leave files")
+	}
+
+	for _, o := range outboxes {
+		for o.numOfHeld() > 0 {
+			if err := o.ack(request); err != nil {
+				t.Fatal(err)
+			}
+		}
+		o.release()
+	}
+	got := make(map[string]int)
+	for _, batch := range sent {
+		msgs, err := decodeMessageBatch(batch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range msgs {
+			got[m.DestVertexId]++
+		}
+	}
+	if len(got) != 20 {
+		t.Fatalf("all messages should be sent once: %v", got)
+	}
+	for id, n := range got {
+		if n != 1 {
+			t.Fatalf("message to %s is sent %d times", id, n)
+		}
+	}
+}
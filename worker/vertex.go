@@ -5,8 +5,6 @@ import (
 	"reflect"
 
 	"github.com/AsynkronIT/protoactor-go/actor"
-	"github.com/gogo/protobuf/types"
-	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/rerorero/prerogel/aggregator"
 	"github.com/rerorero/prerogel/command"
@@ -17,31 +15,90 @@ import (
 
 type vertexActor struct {
 	util.ActorUtil
-	behavior              actor.Behavior
-	plugin                plugin.Plugin
-	vertex                plugin.Vertex
-	partitionID           uint64
-	halted                bool
-	prevStepMessages      []plugin.Message
-	messageQueue          []plugin.Message
-	ackRecorder           *util.AckRecorder
-	computeRespondTo      *actor.PID
-	aggregatedCurrentStep map[string]*types.Any
+	behavior         actor.Behavior
+	plugin           plugin.Plugin
+	vertex           plugin.Vertex
+	id               plugin.VertexID
+	partitionID      uint64
+	halted           bool
+	prevStepMessages []plugin.Message
+	messageQueue     []plugin.Message
+	ackRecorder      *util.SeqAckRecorder
+	outbox           outbox
+	computeRespondTo *actor.PID
+	// computeErr is the error returned by Compute() in the current superstep, it's reported by ComputeAck
+	computeErr            string
+	aggregatedCurrentStep map[string]plugin.AggregatableValue
 	statsMessageSent      uint64
+	// numericID is integer ID of the vertex, parsed when it's used for the first time
+	numericID       uint64
+	numericIDParsed bool
+	// spill is nil unless the vertex is spawned by workerProps. Messages of the queue are appended to spillPath past its memory limit
+	spill           *messageSpill
+	queueBytes      int
+	spillPath       string
+	spilledMessages int
+	spilledBytes    int
+	prevBytes       int
+	prevSpillPath   string
+	prevSpilled     int
+	// prevSpilledBytes is read back when the vertex is admitted by spill, waitingCompute is Compute waiting for it
+	prevSpilledBytes int
+	waitingCompute   *computeLocal
+	// store is the partition store, vertex is nil while it's paged out
+	store *vertexStore
+	// edges stores outgoing edges of vertices of the partition if the plugin implements EdgePlugin
+	edges *edgeStore
+	// step is the superstep the vertex has started. Messages of the next superstep sent by vertices which have already started it
+	// are kept in nextQueue in memory until the vertex starts it too
+	step      uint64
+	started   bool
+	nextQueue []plugin.Message
+	nextBytes int
 }
 
 type loadVertexLocal struct {
 	vertex plugin.Vertex
+	store  *vertexStore
+	edges  *edgeStore
+}
+
+// importVertexLocal restores a vertex moved from another worker
+type importVertexLocal struct {
+	vertex   plugin.Vertex
+	halted   bool
+	messages []plugin.Message
+	store    *vertexStore
+	edges    *edgeStore
+}
+
+// readBackLocal retries Compute waiting for spilled messages to be read back
+type readBackLocal struct{}
+
+// useStoresLocal hands edges of the vertex loaded by LoadVertex over to the edge store, then pages it out to the partition store
+type useStoresLocal struct {
+	store *vertexStore
+	edges *edgeStore
+}
+
+// computeAckLocal is ComputeAck carrying aggregated values without marshaling them, vertices always run in the process of their partition
+type computeAckLocal struct {
+	*command.ComputeAck
+	aggregated map[string]plugin.AggregatableValue
+	// flow is nil unless messages have been held by the flow control
+	flow *command.FlowControlStats
 }
 
 type computeContextImpl struct {
 	superStep          uint64
 	ctx                actor.Context
 	vertexActor        *vertexActor
-	aggregatedPrevStep map[string]*types.Any
+	aggregatedPrevStep map[string]plugin.AggregatableValue
+	numericIDs         bool
 }
 
-var _ = (plugin.ComputeContext)(&computeContextImpl{})
+var _ = (plugin.NumericComputeContext)(&computeContextImpl{})
+var _ = (plugin.EdgeComputeContext)(&computeContextImpl{})
 
 func (c *computeContextImpl) SuperStep() uint64 {
 	return c.superStep
@@ -51,27 +108,56 @@ func (c *computeContextImpl) ReceivedMessages() []plugin.Message {
 	return c.vertexActor.prevStepMessages
 }
 
+func (c *computeContextImpl) NumOfOutEdges() int {
+	return c.vertexActor.edges.numOfEdges(c.vertexActor.id)
+}
+
+func (c *computeContextImpl) OutEdges() plugin.EdgeIterator {
+	return c.vertexActor.edges.iterator(c.vertexActor.id)
+}
+
 func (c *computeContextImpl) SendMessageTo(dest plugin.VertexID, m plugin.Message) error {
-	pb, err := c.vertexActor.plugin.MarshalMessage(m)
-	if err != nil {
-		c.vertexActor.ActorUtil.LogError(c.ctx, fmt.Sprintf("failed to marshal message: id=%v, message=%#v", c.vertexActor.vertex.GetID(), m))
-		return err
+	if c.numericIDs {
+		n, err := plugin.ParseNumericID(dest)
+		if err != nil {
+			return err
+		}
+		return c.SendMessageToNumeric(n, m)
 	}
-	messageID := uuid.New().String()
-	c.ctx.Request(c.ctx.Parent(), &command.SuperStepMessage{
-		Uuid:         messageID,
-		SuperStep:    c.superStep,
-		SrcVertexId:  string(c.vertexActor.vertex.GetID()),
+	return c.send(&command.SuperStepMessage{
+		SrcVertexId:  string(c.vertexActor.id),
 		DestVertexId: string(dest),
-		Message:      pb,
-	})
+	}, dest, m)
+}
 
-	c.vertexActor.ActorUtil.LogDebug(c.ctx, fmt.Sprintf("message sent: uuid=%s, %v -> %v",
-		messageID, c.vertexActor.vertex.GetID(), dest))
+func (c *computeContextImpl) SendMessageToNumeric(dest uint64, m plugin.Message) error {
+	if !c.numericIDs {
+		return c.SendMessageTo(plugin.VertexIDOf(dest), m)
+	}
+	src, err := c.vertexActor.getNumericID()
+	if err != nil {
+		return err
+	}
+	return c.send(&command.SuperStepMessage{
+		SrcNumericId:  src,
+		DestNumericId: dest,
+	}, dest, m)
+}
 
-	if !c.vertexActor.ackRecorder.AddToWaitList(messageID) {
-		c.vertexActor.ActorUtil.LogWarn(c.ctx, fmt.Sprintf("duplicate superstep message: from=%v to=%v", c.vertexActor.vertex.GetID(), dest))
+// send sends the message addressed by msg, dest is only logged
+func (c *computeContextImpl) send(msg *command.SuperStepMessage, dest interface{}, m plugin.Message) error {
+	pb, err := c.vertexActor.plugin.MarshalMessage(m)
+	if err != nil {
+		c.vertexActor.ActorUtil.LogError(c.ctx, fmt.Sprintf("failed to marshal message: id=%v, message=%#v", c.vertexActor.id, m))
+		return err
 	}
+	msg.Seq = c.vertexActor.ackRecorder.Issue()
+	msg.SuperStep = c.superStep
+	msg.Message = pb
+	c.vertexActor.outbox.send(c.ctx.Request, c.ctx.Parent(), msg)
+
+	c.vertexActor.ActorUtil.LogDebug(c.ctx, fmt.Sprintf("message sent: seq=%d, %v -> %v",
+		msg.Seq, c.vertexActor.id, dest))
 
 	return nil
 }
@@ -82,65 +168,27 @@ func (c *computeContextImpl) VoteToHalt() {
 }
 
 func (c *computeContextImpl) GetAggregated(aggregatorName string) (plugin.AggregatableValue, bool, error) {
-	aggregator, err := findAggregator(c.vertexActor.plugin.GetAggregators(), aggregatorName)
-	if err != nil {
+	if _, err := findAggregator(c.vertexActor.plugin.GetAggregators(), aggregatorName); err != nil {
 		return nil, false, err
 	}
 
-	value, ok := c.aggregatedPrevStep[aggregatorName]
-	if ok {
-		v, err := aggregator.UnmarshalValue(value)
-		if err != nil {
-			return nil, false, errors.Wrapf(err, "failed to unmarshal aggregated value: %+v", value)
-		}
-		return v, true, nil
-	}
-	return nil, false, nil
+	// values are unmarshaled by the worker once per superstep
+	v, ok := c.aggregatedPrevStep[aggregatorName]
+	return v, ok, nil
 }
 
 func (c *computeContextImpl) PutAggregatable(aggregatorName string, v plugin.AggregatableValue) error {
-	aggregator, err := findAggregator(c.vertexActor.plugin.GetAggregators(), aggregatorName)
-	if err != nil {
-		return err
-	}
-
-	current, ok := c.vertexActor.aggregatedCurrentStep[aggregatorName]
-	if ok {
-		// aggregate TODO: verbose marshalling
-		v2, err := aggregator.UnmarshalValue(current)
-		if err != nil {
-			return errors.Wrapf(err, "failed to unmarshal aggregated value: %+v", current.Value)
-		}
-		val, err := aggregator.Aggregate(v, v2)
-		if err != nil {
-			return errors.Wrap(err, "failed to Aggregate()")
-		}
-		pb, err := aggregator.MarshalValue(val)
-		if err != nil {
-			return errors.Wrapf(err, "failed to marshal aggregatable value: %#v", v)
-		}
-		c.vertexActor.aggregatedCurrentStep[aggregatorName] = pb
-		return nil
-	}
-
-	pb, err := aggregator.MarshalValue(v)
-	if err != nil {
-		return errors.Wrapf(err, "failed to marshal aggregatable value: %#v", v)
-	}
-	c.vertexActor.aggregatedCurrentStep[aggregatorName] = pb
-	return nil
+	return aggregateValue(c.vertexActor.plugin.GetAggregators(), c.vertexActor.aggregatedCurrentStep, aggregatorName, v)
 }
 
 // NewVertexActor returns an actor instance
 func NewVertexActor(plugin plugin.Plugin, logger *logrus.Logger) actor.Actor {
-	ar := &util.AckRecorder{}
-	ar.Clear()
 	a := &vertexActor{
 		plugin: plugin,
 		ActorUtil: util.ActorUtil{
 			Logger: logger,
 		},
-		ackRecorder: ar,
+		ackRecorder: &util.SeqAckRecorder{},
 	}
 	a.behavior.Become(a.waitInit)
 	return a
@@ -157,12 +205,28 @@ func (state *vertexActor) Receive(context actor.Context) {
 		ack := &command.GetVertexValueAck{
 			VertexId: cmd.VertexId,
 		}
-		if state.vertex != nil {
-			ack.Value = state.vertex.GetValueAsString()
+		if v, err := state.currentVertex(); err != nil {
+			state.ActorUtil.LogError(context, err.Error())
+		} else if v != nil {
+			ack.Value = v.GetValueAsString()
 		}
 		context.Respond(ack)
 		return
 
+	case *useStoresLocal:
+		state.store = cmd.store
+		state.edges = cmd.edges
+		if src, ok := state.vertex.(plugin.EdgeSource); ok && state.edges != nil {
+			if err := state.edges.take(state.id, src); err != nil {
+				state.ActorUtil.Fail(context, err)
+				return
+			}
+		}
+		if err := state.pageOut(); err != nil {
+			state.ActorUtil.Fail(context, err)
+		}
+		return
+
 	default:
 		state.behavior.Receive(context)
 		return
@@ -179,51 +243,75 @@ func (state *vertexActor) waitInit(context actor.Context) {
 		if err != nil {
 			e := fmt.Sprintf("failed to NewVertex: id=%s err=%s", cmd.VertexId, err.Error())
 			state.ActorUtil.LogError(context, e)
-			context.Respond(&command.LoadVertexAck{VertexId: string(state.vertex.GetID()), Error: e})
+			context.Respond(&command.LoadVertexAck{VertexId: cmd.VertexId, Error: e})
 			return
 		}
 
 	case *loadVertexLocal:
 		vert = cmd.vertex
+		state.store = cmd.store
+		state.edges = cmd.edges
+
+	case *importVertexLocal:
+		vert = cmd.vertex
+		state.halted = cmd.halted
+		state.messageQueue = cmd.messages
+		state.store = cmd.store
+		state.edges = cmd.edges
 
 	default:
 		state.ActorUtil.Fail(context, fmt.Errorf("[waitInit] unhandled vertex command: command=%#v(%v)", cmd, reflect.TypeOf(cmd)))
 	}
 
-	if state.vertex != nil {
+	if state.id != "" {
 		err := fmt.Sprintf("vertex has already initialized: id=%s", vert.GetID())
 		state.ActorUtil.LogError(context, err)
-		context.Respond(&command.LoadVertexAck{VertexId: string(state.vertex.GetID()), Error: err})
+		context.Respond(&command.LoadVertexAck{VertexId: string(state.id), Error: err})
 		return
 	}
 
 	state.vertex = vert
+	state.id = vert.GetID()
+	if err := state.pageOut(); err != nil {
+		state.ActorUtil.LogError(context, err.Error())
+		context.Respond(&command.LoadVertexAck{VertexId: string(state.id), Error: err.Error()})
+		return
+	}
 	context.Respond(&command.LoadVertexAck{
-		VertexId: string(state.vertex.GetID()),
+		VertexId: string(state.id),
 	})
 	state.behavior.Become(state.superstep)
-	state.ActorUtil.LogInfo(context, fmt.Sprintf("vertex %v loaded", state.vertex.GetID()))
+	state.ActorUtil.LogInfo(context, fmt.Sprintf("vertex %v loaded", state.id))
 }
 
 func (state *vertexActor) superstep(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.SuperStepBarrier:
-		// move messages from queue to buffer
-		state.prevStepMessages = state.messageQueue
-		state.messageQueue = nil
+		state.startStep(cmd.SuperStep)
 		context.Respond(&command.SuperStepBarrierAck{
-			VertexId: string(state.vertex.GetID()),
+			VertexId: string(state.id),
 		})
 		state.ActorUtil.LogDebug(context, fmt.Sprintf("received barrier message"))
 		return
 
-	case *command.Compute:
+	case *readBackLocal:
+		if cmd := state.waitingCompute; cmd != nil {
+			state.waitingCompute = nil
+			state.onComputed(context, cmd)
+		}
+		return
+
+	case *computeLocal:
+		if !state.started || state.step != cmd.SuperStep {
+			// the barrier is implied by Compute
+			state.startStep(cmd.SuperStep)
+		}
 		state.computeRespondTo = context.Sender()
 		state.onComputed(context, cmd)
 		return
 
 	case *command.SuperStepMessage:
-		if state.vertex.GetID() != plugin.VertexID(cmd.DestVertexId) {
+		if !state.isDestination(cmd) {
 			state.ActorUtil.Fail(context, fmt.Errorf("inconsistent vertex id: %#v", *cmd))
 			return
 		}
@@ -233,20 +321,50 @@ func (state *vertexActor) superstep(context actor.Context) {
 			state.ActorUtil.Fail(context, fmt.Errorf("failed to unmarshal message: %#v", *cmd))
 			return
 		}
-		state.messageQueue = append(state.messageQueue, pb)
 		state.halted = false
+		if !state.started || cmd.SuperStep != state.step {
+			// sent in the superstep the vertex hasn't started yet
+			state.nextQueue = append(state.nextQueue, pb)
+			if state.spill.enabled() {
+				state.nextBytes += cmd.Message.Size()
+				state.spill.reserve(cmd.Message.Size())
+			}
+		} else {
+			state.messageQueue = append(state.messageQueue, pb)
+			if err := state.spillQueueIfExceeded(cmd.Message.Size()); err != nil {
+				state.ActorUtil.Fail(context, err)
+				return
+			}
+		}
 		context.Respond(&command.SuperStepMessageAck{
-			Uuid: cmd.Uuid,
+			Seq: cmd.Seq,
 		})
 		return
 
+	case *command.ExportVertex:
+		ack := &command.ExportVertexAck{VertexId: string(state.id)}
+		if err := state.pageIn(); err != nil {
+			ack.Error = err.Error()
+			state.ActorUtil.LogError(context, err.Error())
+		} else if vs, err := state.export(); err != nil {
+			ack.Error = err.Error()
+			state.ActorUtil.LogError(context, fmt.Sprintf("failed to export vertex: %v", err))
+		} else {
+			ack.State = vs
+		}
+		context.Respond(ack)
+		return
+
 	case *command.SuperStepMessageAck:
 		if state.ackRecorder.HasCompleted() {
-			state.ActorUtil.LogWarn(context, fmt.Sprintf("unhaneled message id=%v, compute() has already completed", cmd.Uuid))
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("unhaneled message seq=%v, compute() has already completed", cmd.Seq))
 			return
 		}
-		if !state.ackRecorder.Ack(cmd.Uuid) {
-			state.ActorUtil.LogWarn(context, fmt.Sprintf("duplicated or unhaneled message: uuid=%v", cmd.Uuid))
+		if !state.ackRecorder.Ack(cmd.Seq) {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("duplicated or unhaneled message: seq=%v", cmd.Seq))
+		} else if err := state.outbox.ack(context.Request); err != nil {
+			state.ActorUtil.Fail(context, err)
+			return
 		}
 		if state.ackRecorder.HasCompleted() {
 			state.respondComputeAck(context)
@@ -259,15 +377,155 @@ func (state *vertexActor) superstep(context actor.Context) {
 	}
 }
 
-func (state *vertexActor) onComputed(ctx actor.Context, cmd *command.Compute) {
-	state.aggregatedCurrentStep = make(map[string]*types.Any)
+// startStep moves messages from the queue to the buffer, messages which arrived early are queued for the next superstep
+func (state *vertexActor) startStep(step uint64) {
+	state.prevStepMessages = state.messageQueue
+	state.prevBytes, state.prevSpillPath, state.prevSpilled = state.queueBytes, state.spillPath, state.spilledMessages
+	state.prevSpilledBytes = state.spilledBytes
+	state.spilledBytes = 0
+	state.messageQueue, state.queueBytes = state.nextQueue, state.nextBytes
+	state.spillPath, state.spilledMessages = "", 0
+	state.nextQueue, state.nextBytes = nil, 0
+	state.step = step
+	state.started = true
+}
+
+// spillQueueIfExceeded counts size of a queued message, then spills the queue to disk if messages of the process exceed the memory limit
+func (state *vertexActor) spillQueueIfExceeded(size int) error {
+	if !state.spill.enabled() {
+		return nil
+	}
+	state.queueBytes += size
+	if !state.spill.reserve(size) || state.queueBytes < state.spill.chunk() {
+		return nil
+	}
+	path, err := appendSpilledMessages(state.spill, state.spillPath, state.plugin, state.messageQueue)
+	state.spillPath = path
+	if err != nil {
+		return err
+	}
+	state.spilledMessages += len(state.messageQueue)
+	state.spilledBytes += state.queueBytes
+	state.messageQueue = nil
+	state.spill.release(state.queueBytes)
+	state.queueBytes = 0
+	return nil
+}
+
+// unspillQueue reads spilled messages back into the queue
+func (state *vertexActor) unspillQueue() error {
+	if state.spillPath == "" {
+		return nil
+	}
+	spilled, err := readSpilledMessages(state.spillPath, state.plugin)
+	if err != nil {
+		return err
+	}
+	state.messageQueue = append(spilled, state.messageQueue...)
+	state.spillPath, state.spilledMessages, state.spilledBytes = "", 0, 0
+	return nil
+}
+
+// pageOut writes the vertex to the partition store then drops it, it does nothing without the store
+func (state *vertexActor) pageOut() error {
+	if state.store == nil || state.vertex == nil {
+		return nil
+	}
+	// NumericVertex may have the integer ID other than the string one
+	state.getNumericID()
+	if err := state.store.put(state.vertex); err != nil {
+		return err
+	}
+	state.vertex = nil
+	return nil
+}
+
+// pageIn reads the vertex from the partition store if it's paged out
+func (state *vertexActor) pageIn() error {
+	v, err := state.currentVertex()
+	if err != nil {
+		return err
+	}
+	state.vertex = v
+	return nil
+}
+
+// currentVertex returns the vertex, which is read from the partition store without paging in if it's paged out
+func (state *vertexActor) currentVertex() (plugin.Vertex, error) {
+	if state.vertex != nil || state.store == nil || state.id == "" {
+		return state.vertex, nil
+	}
+	return state.store.get(state.id)
+}
+
+// getNumericID returns integer ID of the vertex
+func (state *vertexActor) getNumericID() (uint64, error) {
+	if !state.numericIDParsed {
+		var id uint64
+		var err error
+		if state.vertex != nil {
+			id, err = plugin.NumericIDOf(state.vertex)
+		} else {
+			id, err = plugin.ParseNumericID(state.id)
+		}
+		if err != nil {
+			return 0, err
+		}
+		state.numericID = id
+		state.numericIDParsed = true
+	}
+	return state.numericID, nil
+}
+
+// isDestination checks the message is sent to the vertex, the message carries the integer ID if the string ID is empty
+func (state *vertexActor) isDestination(cmd *command.SuperStepMessage) bool {
+	if cmd.DestVertexId != "" {
+		return state.id == plugin.VertexID(cmd.DestVertexId)
+	}
+	id, err := state.getNumericID()
+	return err == nil && id == cmd.DestNumericId
+}
+
+// export serializes the vertex and messages for the next superstep
+func (state *vertexActor) export() (*command.VertexState, error) {
+	marshaler, ok := vertexMarshalerOf(state.plugin)
+	if !ok {
+		return nil, errors.New("plugin doesn't implement VertexMarshaler")
+	}
+	pb, err := marshaler.MarshalVertex(state.vertex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal vertex: id=%v", state.id)
+	}
+	vs := &command.VertexState{
+		VertexId: string(state.id),
+		Vertex:   pb,
+		Halted:   state.halted,
+		OutEdges: state.edges.export(state.id),
+	}
+	if err := state.unspillQueue(); err != nil {
+		return nil, err
+	}
+	for _, m := range state.messageQueue {
+		pb, err := state.plugin.MarshalMessage(m)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal message: %#v", m)
+		}
+		vs.Messages = append(vs.Messages, pb)
+	}
+	return vs, nil
+}
+
+func (state *vertexActor) onComputed(ctx actor.Context, cmd *computeLocal) {
+	state.aggregatedCurrentStep = make(map[string]plugin.AggregatableValue)
 	state.statsMessageSent = 0
+	state.computeErr = ""
+	state.outbox.reset(cmd.FlowControl.GetVertexInflight())
 
 	// force to compute() in super step 0
 	// otherwise halt if there are no messages
 	if cmd.SuperStep == 0 {
 		state.halted = false
-	} else if len(state.prevStepMessages) == 0 {
+	} else if len(state.prevStepMessages) == 0 && state.prevSpilled == 0 {
 		state.ActorUtil.LogDebug(ctx, "halted due to no message")
 		state.halted = true
 	}
@@ -277,18 +535,48 @@ func (state *vertexActor) onComputed(ctx actor.Context, cmd *command.Compute) {
 		return
 	}
 
+	// messages spilled in the previous step are read back before the vertex receives them, in turns with other vertices of the process
+	readBack := 0
+	if state.prevSpillPath != "" {
+		self := ctx.Self()
+		if !state.spill.admitReadBack(state.prevSpilledBytes, func() { actor.EmptyRootContext.Send(self, &readBackLocal{}) }) {
+			state.waitingCompute = cmd
+			return
+		}
+		readBack = state.prevSpilledBytes
+		spilled, err := readSpilledMessages(state.prevSpillPath, state.plugin)
+		if err != nil {
+			state.ActorUtil.Fail(ctx, errors.Wrap(err, "failed to read spilled messages"))
+			return
+		}
+		state.prevStepMessages = append(spilled, state.prevStepMessages...)
+		state.prevSpillPath, state.prevSpilled, state.prevSpilledBytes = "", 0, 0
+	}
+
+	if err := state.pageIn(); err != nil {
+		state.ActorUtil.Fail(ctx, err)
+		return
+	}
+
 	state.ackRecorder.Clear()
 	computeContext := &computeContextImpl{
 		superStep:          cmd.SuperStep,
 		ctx:                ctx,
 		vertexActor:        state,
-		aggregatedPrevStep: cmd.AggregatedValues,
+		aggregatedPrevStep: cmd.aggregated,
+		numericIDs:         cmd.NumericVertexIds,
 	}
-	if err := state.vertex.Compute(computeContext); err != nil {
-		state.ActorUtil.Fail(ctx, errors.Wrap(err, "failed to compute"))
-		return
+	if err := state.compute(computeContext, cmd); err != nil {
+		// messages sent so far are still acked, then the coordinator stops supersteps
+		state.computeErr = fmt.Sprintf("failed to compute vertex %v: %v", state.id, err)
+		state.ActorUtil.LogError(ctx, state.computeErr)
 	}
-	state.statsMessageSent = uint64(state.ackRecorder.Size())
+	// received messages are no longer needed
+	state.prevStepMessages = nil
+	if readBack > 0 {
+		state.spill.releaseReadBack(readBack)
+	}
+	state.statsMessageSent = state.ackRecorder.Size()
 
 	if state.ackRecorder.HasCompleted() {
 		state.respondComputeAck(ctx)
@@ -296,36 +584,55 @@ func (state *vertexActor) onComputed(ctx actor.Context, cmd *command.Compute) {
 	return
 }
 
+func (state *vertexActor) compute(computeContext plugin.ComputeContext, cmd *computeLocal) error {
+	if cmd.Stage == "" {
+		return state.vertex.Compute(computeContext)
+	}
+	stage, err := findStage(state.plugin, cmd.Stage)
+	if err != nil {
+		return err
+	}
+	return stage.Compute(state.vertex, computeContext, cmd.StageParams)
+}
+
 func (state *vertexActor) respondComputeAck(ctx actor.Context) {
-	if len(state.messageQueue) > 0 {
+	if len(state.messageQueue) > 0 || state.spilledMessages > 0 {
 		// activate if it receives messages to be handled in the next step
 		state.halted = false
 	}
 
-	stats, err := findAggregator(state.plugin.GetAggregators(), VertexStatsName)
-	if err == nil {
+	if _, err := findAggregator(state.plugin.GetAggregators(), VertexStatsName); err == nil {
 		var active uint64
 		if !state.halted {
 			active = 1
 		}
-		pb, err := stats.MarshalValue(&aggregator.VertexStats{
+		state.aggregatedCurrentStep[VertexStatsName] = &aggregator.VertexStats{
 			ActiveVertices: active,
 			TotalVertices:  1,
 			MessagesSent:   state.statsMessageSent,
-		})
-		if err != nil {
-			state.ActorUtil.LogError(ctx, fmt.Sprintf("failed to marshal stats: %v", err))
-			return
 		}
-		state.aggregatedCurrentStep[VertexStatsName] = pb
 	}
 
-	// TODO: store vertex state
+	// received messages are no longer counted as queued
+	if state.prevBytes > 0 {
+		state.spill.release(state.prevBytes)
+		state.prevBytes = 0
+	}
 
-	ctx.Send(state.computeRespondTo, &command.ComputeAck{
-		VertexId:         string(state.vertex.GetID()),
-		Halted:           state.halted,
-		AggregatedValues: state.aggregatedCurrentStep,
+	if err := state.pageOut(); err != nil {
+		state.ActorUtil.Fail(ctx, err)
+		return
+	}
+
+	ctx.Send(state.computeRespondTo, &computeAckLocal{
+		ComputeAck: &command.ComputeAck{
+			VertexId:     string(state.id),
+			Halted:       state.halted,
+			MessagesSent: state.statsMessageSent,
+			Error:        state.computeErr,
+		},
+		aggregated: state.aggregatedCurrentStep,
+		flow:       state.outbox.addStats(nil),
 	})
 	state.aggregatedCurrentStep = nil
 	state.ActorUtil.LogDebug(ctx, "compute() completed")
@@ -0,0 +1,125 @@
+package worker
+
+import (
+	"math"
+	"sort"
+
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// vertexMoveSlack is how much a partition can exceed the average number of vertices by receiving moved vertices
+const vertexMoveSlack = 0.1
+
+// vertexMoveGroup is vertices moved from a partition to another partition at once
+type vertexMoveGroup struct {
+	from     uint64
+	to       uint64
+	vertices []string
+}
+
+// vertexMigration is a pending move of vertices between supersteps
+type vertexMigration struct {
+	groups   []*vertexMoveGroup
+	next     int
+	exported []*command.VertexState
+	// rollback is true while exported vertices are being imported back to the source partition
+	rollback bool
+	moved    int
+	// routes is MovedVertices of ClusterInfo broadcast after the migration
+	routes map[string]uint64
+}
+
+// vertexMoveCandidates returns vertices which send more messages to another partition than to their own partition.
+// traffic is messages sent to other partitions by source vertex, internal is messages delivered within the partition.
+func vertexMoveCandidates(partitionOfVertex func(plugin.VertexID) (uint64, error), traffic map[plugin.VertexID]map[uint64]uint64, internal map[string]uint64) []*command.VertexMove {
+	var moves []*command.VertexMove
+	for vid, sent := range traffic {
+		own, err := partitionOfVertex(vid)
+		if err != nil {
+			continue
+		}
+		// label propagation: the vertex follows the partition it talks to most, the smaller partition wins a tie
+		var best, max uint64
+		found := false
+		for p, n := range sent {
+			if p == own {
+				continue
+			}
+			if !found || n > max || (n == max && p < best) {
+				best, max, found = p, n, true
+			}
+		}
+		if !found || max <= internal[string(vid)] {
+			continue
+		}
+		moves = append(moves, &command.VertexMove{
+			VertexId: string(vid),
+			From:     own,
+			To:       best,
+			Gain:     max - internal[string(vid)],
+		})
+	}
+	sort.Slice(moves, func(i, j int) bool { return moves[i].VertexId < moves[j].VertexId })
+	return moves
+}
+
+// planVertexMoves chooses up to limit candidates in order of gain.
+// Vertices move only to larger partition IDs in even supersteps and to smaller ones in odd supersteps so that neighbors don't swap each other.
+// Partitions don't grow beyond the average number of vertices by vertexMoveSlack and aren't emptied.
+func planVertexMoves(candidates []*command.VertexMove, vertices map[uint64]uint64, superStep uint64, limit int) []*vertexMoveGroup {
+	if len(vertices) == 0 || limit <= 0 {
+		return nil
+	}
+	var total uint64
+	counts := make(map[uint64]uint64, len(vertices))
+	for p, n := range vertices {
+		counts[p] = n
+		total += n
+	}
+	capacity := uint64(math.Ceil(float64(total) / float64(len(vertices)) * (1 + vertexMoveSlack)))
+
+	sorted := append([]*command.VertexMove{}, candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Gain == sorted[j].Gain {
+			return sorted[i].VertexId < sorted[j].VertexId
+		}
+		return sorted[i].Gain > sorted[j].Gain
+	})
+
+	groups := make(map[partitionPair]*vertexMoveGroup)
+	moved := 0
+	for _, c := range sorted {
+		if moved >= limit {
+			break
+		}
+		if (superStep%2 == 0) != (c.From < c.To) {
+			continue
+		}
+		if _, ok := counts[c.To]; !ok || counts[c.To]+1 > capacity || counts[c.From] <= 1 {
+			continue
+		}
+		counts[c.From]--
+		counts[c.To]++
+		key := partitionPair{src: c.From, dest: c.To}
+		g, ok := groups[key]
+		if !ok {
+			g = &vertexMoveGroup{from: c.From, to: c.To}
+			groups[key] = g
+		}
+		g.vertices = append(g.vertices, c.VertexId)
+		moved++
+	}
+
+	var result []*vertexMoveGroup
+	for _, g := range groups {
+		result = append(result, g)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].from == result[j].from {
+			return result[i].to < result[j].to
+		}
+		return result[i].from < result[j].from
+	})
+	return result
+}
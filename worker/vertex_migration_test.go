@@ -0,0 +1,168 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/AsynkronIT/protoactor-go/actor"
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func Test_vertexMoveCandidates(t *testing.T) {
+	own := map[plugin.VertexID]uint64{"a": 0, "b": 0, "c": 1, "d": 1}
+	partitionOfVertex := func(vid plugin.VertexID) (uint64, error) {
+		p, ok := own[vid]
+		if !ok {
+			return 0, errors.New("unknown vertex")
+		}
+		return p, nil
+	}
+	traffic := map[plugin.VertexID]map[uint64]uint64{
+		"a": {1: 3, 2: 1},
+		"b": {1: 1},
+		"c": {0: 2, 2: 2},
+		"x": {1: 5},
+	}
+	internal := map[string]uint64{"a": 1, "b": 1}
+	got := vertexMoveCandidates(partitionOfVertex, traffic, internal)
+	want := []*command.VertexMove{
+		{VertexId: "a", From: 0, To: 1, Gain: 2},
+		// tie goes to the smaller partition
+		{VertexId: "c", From: 1, To: 0, Gain: 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected candidates: %s", diff)
+	}
+}
+
+func Test_planVertexMoves(t *testing.T) {
+	candidates := []*command.VertexMove{
+		{VertexId: "a", From: 0, To: 1, Gain: 1},
+		{VertexId: "b", From: 0, To: 1, Gain: 3},
+		{VertexId: "c", From: 0, To: 2, Gain: 2},
+		{VertexId: "d", From: 1, To: 0, Gain: 5},
+		{VertexId: "e", From: 2, To: 1, Gain: 1},
+	}
+	tests := []struct {
+		name      string
+		vertices  map[uint64]uint64
+		superStep uint64
+		limit     int
+		want      []*vertexMoveGroup
+	}{
+		{
+			name:      "even superstep moves to larger partitions",
+			vertices:  map[uint64]uint64{0: 10, 1: 10, 2: 10},
+			superStep: 2,
+			limit:     10,
+			// a doesn't fit in partition 1 as capacity is 11
+			want: []*vertexMoveGroup{
+				{from: 0, to: 1, vertices: []string{"b"}},
+				{from: 0, to: 2, vertices: []string{"c"}},
+			},
+		},
+		{
+			name:      "odd superstep moves to smaller partitions",
+			vertices:  map[uint64]uint64{0: 10, 1: 10, 2: 10},
+			superStep: 1,
+			limit:     10,
+			want: []*vertexMoveGroup{
+				{from: 1, to: 0, vertices: []string{"d"}},
+				{from: 2, to: 1, vertices: []string{"e"}},
+			},
+		},
+		{
+			name:      "limit",
+			vertices:  map[uint64]uint64{0: 20, 1: 20, 2: 20},
+			superStep: 0,
+			limit:     2,
+			want: []*vertexMoveGroup{
+				{from: 0, to: 1, vertices: []string{"b"}},
+				{from: 0, to: 2, vertices: []string{"c"}},
+			},
+		},
+		{
+			name:      "partitions don't exceed capacity",
+			vertices:  map[uint64]uint64{0: 10, 1: 11, 2: 9},
+			superStep: 0,
+			limit:     10,
+			// capacity is 11
+			want: []*vertexMoveGroup{
+				{from: 0, to: 2, vertices: []string{"c"}},
+			},
+		},
+		{
+			name:      "partitions aren't emptied",
+			vertices:  map[uint64]uint64{0: 1, 1: 1, 2: 1},
+			superStep: 0,
+			limit:     10,
+			want:      nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := planVertexMoves(candidates, tt.vertices, tt.superStep, tt.limit)
+			if diff := cmp.Diff(tt.want, got, cmp.AllowUnexported(vertexMoveGroup{})); diff != "" {
+				t.Fatalf("unexpected moves: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCoordinator_moveVertices(t *testing.T) {
+	plg := &movableMaxPlugin{maxPlugin{size: 16}}
+	coordinator, request, waitUntilIdle := startLocalCluster(t, plg, 2, 4, func(nc *command.NewCluster) {
+		nc.MaxVertexMoves = 4
+	})
+	defer actor.EmptyRootContext.Stop(coordinator)
+
+	actor.EmptyRootContext.Send(coordinator, &command.StartSuperStep{})
+	waitUntilIdle()
+
+	report := request(&command.ShowPartitions{}).(*command.ShowPartitionsAck)
+	if report.MovedVertices == 0 {
+		t.Fatal("no vertices are moved")
+	}
+	var vertices uint64
+	for _, p := range report.Partitions {
+		vertices += p.Stats.Vertices
+	}
+	if vertices != 16 {
+		t.Fatalf("vertices are lost: %v", report.Partitions)
+	}
+	// moved vertices are routed by override
+	for i := 0; i < plg.size; i++ {
+		id := fmt.Sprintf("v%d", i)
+		ack := request(&command.GetVertexValue{VertexId: id}).(*command.GetVertexValueAck)
+		if ack.Value != "15" {
+			t.Fatalf("unexpected value of %s: %q", id, ack.Value)
+		}
+	}
+}
+
+func TestRunJob_moveVertices(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	res, err := RunJob(ctx, &movableMaxPlugin{maxPlugin{size: 20}}, &JobOptions{
+		NumOfWorkers:    3,
+		NumOfPartitions: 5,
+		MaxVertexMoves:  3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.VertexValues) != 20 {
+		t.Fatalf("unexpected number of vertices: %d", len(res.VertexValues))
+	}
+	for id, v := range res.VertexValues {
+		if v != "19" {
+			t.Fatalf("unexpected value of %v: %s", id, v)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package worker
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+// vertexStore keeps vertices of a partition serialized by VertexMarshaler in an append-only file.
+// Vertices write themselves after they are loaded or computed, so it's shared by vertex actors of the partition.
+type vertexStore struct {
+	mu        sync.Mutex
+	marshaler plugin.VertexMarshaler
+	dir       string
+	f         *os.File
+	size      int64
+	live      int64
+	index     map[plugin.VertexID]storedVertex
+}
+
+// storedVertex is the latest record of a vertex in the file
+type storedVertex struct {
+	offset int64
+	length int
+}
+
+// newVertexStore creates the store file. It's removed as soon as it's opened so that it doesn't outlive the process
+func newVertexStore(config *command.PartitionStore, plg plugin.Plugin) (*vertexStore, error) {
+	marshaler, ok := vertexMarshalerOf(plg)
+	if !ok {
+		return nil, errors.New("partition store requires the plugin implementing VertexMarshaler")
+	}
+	s := &vertexStore{
+		marshaler: marshaler,
+		dir:       config.GetDir(),
+		index:     make(map[plugin.VertexID]storedVertex),
+	}
+	f, err := s.create()
+	if err != nil {
+		return nil, err
+	}
+	s.f = f
+	return s, nil
+}
+
+func (s *vertexStore) create() (*os.File, error) {
+	f, err := ioutil.TempFile(s.dir, "prerogel-partition-*")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create partition store")
+	}
+	os.Remove(f.Name())
+	return f, nil
+}
+
+// put appends the vertex, the previous record becomes garbage
+func (s *vertexStore) put(v plugin.Vertex) error {
+	pb, err := s.marshaler.MarshalVertex(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal vertex: id=%v", v.GetID())
+	}
+	b, err := proto.Marshal(pb)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal vertex: id=%v", v.GetID())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.f.WriteAt(b, s.size); err != nil {
+		return errors.Wrapf(err, "failed to store vertex: id=%v", v.GetID())
+	}
+	if prev, ok := s.index[v.GetID()]; ok {
+		s.live -= int64(prev.length)
+	}
+	s.index[v.GetID()] = storedVertex{offset: s.size, length: len(b)}
+	s.size += int64(len(b))
+	s.live += int64(len(b))
+	return nil
+}
+
+// get reads the vertex
+func (s *vertexStore) get(id plugin.VertexID) (plugin.Vertex, error) {
+	s.mu.Lock()
+	sv, ok := s.index[id]
+	if !ok {
+		s.mu.Unlock()
+		return nil, errors.Errorf("vertex is not stored: id=%v", id)
+	}
+	b := make([]byte, sv.length)
+	_, err := s.f.ReadAt(b, sv.offset)
+	s.mu.Unlock()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read stored vertex: id=%v", id)
+	}
+
+	var pb types.Any
+	if err := proto.Unmarshal(b, &pb); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal stored vertex: id=%v", id)
+	}
+	v, err := s.marshaler.UnmarshalVertex(&pb)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal stored vertex: id=%v", id)
+	}
+	return v, nil
+}
+
+// delete forgets the vertex moved to another partition
+func (s *vertexStore) delete(id plugin.VertexID) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sv, ok := s.index[id]; ok {
+		s.live -= int64(sv.length)
+		delete(s.index, id)
+	}
+}
+
+// bytes returns the size of the latest records of vertices
+func (s *vertexStore) bytes() uint64 {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return uint64(s.live)
+}
+
+// compact rewrites the latest records to a new file if garbage exceeds them
+func (s *vertexStore) compact() error {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.size <= 2*s.live {
+		return nil
+	}
+
+	f, err := s.create()
+	if err != nil {
+		return err
+	}
+	// copy records in order of the old file to read it sequentially
+	ids := make([]plugin.VertexID, 0, len(s.index))
+	for id := range s.index {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return s.index[ids[i]].offset < s.index[ids[j]].offset })
+
+	index := make(map[plugin.VertexID]storedVertex, len(s.index))
+	var size int64
+	var b []byte
+	for _, id := range ids {
+		sv := s.index[id]
+		if cap(b) < sv.length {
+			b = make([]byte, sv.length)
+		}
+		b = b[:sv.length]
+		if _, err := s.f.ReadAt(b, sv.offset); err != nil {
+			f.Close()
+			return errors.Wrapf(err, "failed to read stored vertex: id=%v", id)
+		}
+		if _, err := f.WriteAt(b, size); err != nil {
+			f.Close()
+			return errors.Wrapf(err, "failed to store vertex: id=%v", id)
+		}
+		index[id] = storedVertex{offset: size, length: sv.length}
+		size += int64(sv.length)
+	}
+	s.f.Close()
+	s.f, s.index, s.size, s.live = f, index, size, size
+	return nil
+}
+
+func (s *vertexStore) close() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.f.Close()
+}
+
+// computeTurns admits partitions of a worker to compute while their stored vertices fit the memory limit.
+// At least one partition is computed even if it exceeds the limit by itself.
+type computeTurns struct {
+	limit    uint64
+	bytes    map[uint64]uint64
+	queue    []uint64
+	running  map[uint64]uint64
+	resident uint64
+}
+
+// setBytes records bytes of vertices stored by the partition
+func (t *computeTurns) setBytes(partition uint64, n uint64) {
+	if t.bytes == nil {
+		t.bytes = make(map[uint64]uint64)
+	}
+	t.bytes[partition] = n
+}
+
+// start queues partitions to compute, the limit 0 admits all of them at once
+func (t *computeTurns) start(partitions []uint64, limit uint64) {
+	t.limit = limit
+	t.queue = append(t.queue[:0], partitions...)
+	sort.Slice(t.queue, func(i, j int) bool { return t.queue[i] < t.queue[j] })
+	t.running = make(map[uint64]uint64)
+	t.resident = 0
+}
+
+// next pops the partition to compute next if it's admitted
+func (t *computeTurns) next() (uint64, bool) {
+	if len(t.queue) == 0 {
+		return 0, false
+	}
+	p := t.queue[0]
+	n := t.bytes[p]
+	if t.limit > 0 && len(t.running) > 0 && t.resident+n > t.limit {
+		return 0, false
+	}
+	t.queue = t.queue[1:]
+	t.running[p] = n
+	t.resident += n
+	return p, true
+}
+
+// done releases the partition which has computed, n is bytes of its vertices stored after compute
+func (t *computeTurns) done(partition uint64, n uint64) {
+	if admitted, ok := t.running[partition]; ok {
+		t.resident -= admitted
+		delete(t.running, partition)
+	}
+	t.setBytes(partition, n)
+}
@@ -0,0 +1,137 @@
+package worker
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/rerorero/prerogel/command"
+	"github.com/rerorero/prerogel/plugin"
+)
+
+func Test_vertexStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "prerogel-store-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s, err := newVertexStore(&command.PartitionStore{Enabled: true, Dir: dir}, &movableMaxPlugin{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.close()
+	if filesIn(t, dir) != 0 {
+		t.Fatal("store file should be removed as soon as it's opened")
+	}
+
+	for _, v := range []*maxVertex{
+		{id: "a", value: 1, edges: []plugin.VertexID{"b"}},
+		{id: "b", value: 2},
+		{id: "c", value: 3},
+	} {
+		if err := s.put(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// overwrite a and c several times to leave garbage
+	for i := uint32(10); i < 15; i++ {
+		if err := s.put(&maxVertex{id: "a", value: i, edges: []plugin.VertexID{"b"}}); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.put(&maxVertex{id: "c", value: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.delete("b")
+	live := s.bytes()
+	if uint64(s.size) <= 2*live {
+		t.Fatalf("garbage should exceed live records: size=%d live=%d", s.size, live)
+	}
+
+	if err := s.compact(); err != nil {
+		t.Fatal(err)
+	}
+	if uint64(s.size) != live || s.bytes() != live {
+		t.Fatalf("only live records should be kept: size=%d live=%d", s.size, live)
+	}
+
+	cmpOpt := cmp.AllowUnexported(maxVertex{})
+	v, err := s.get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&maxVertex{id: "a", value: 14, edges: []plugin.VertexID{"b"}}, v, cmpOpt); diff != "" {
+		t.Fatalf("unexpected vertex: %s", diff)
+	}
+	v, err = s.get("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&maxVertex{id: "c", value: 14}, v, cmpOpt); diff != "" {
+		t.Fatalf("unexpected vertex: %s", diff)
+	}
+	if _, err := s.get("b"); err == nil {
+		t.Fatal("deleted vertex should not be found")
+	}
+}
+
+func Test_newVertexStoreWithoutVertexMarshaler(t *testing.T) {
+	if _, err := newVertexStore(&command.PartitionStore{Enabled: true}, &maxPlugin{}); err == nil {
+		t.Fatal("partition store requires VertexMarshaler")
+	}
+}
+
+func Test_computeTurns(t *testing.T) {
+	var turns computeTurns
+	turns.setBytes(1, 40)
+	turns.setBytes(2, 40)
+	turns.setBytes(3, 150)
+	turns.start([]uint64{3, 2, 1, 4}, 100)
+
+	var started []uint64
+	startAll := func() {
+		for {
+			p, ok := turns.next()
+			if !ok {
+				return
+			}
+			started = append(started, p)
+		}
+	}
+	startAll()
+	if diff := cmp.Diff([]uint64{1, 2}, started); diff != "" {
+		t.Fatalf("partitions fitting the limit should be started: %s", diff)
+	}
+
+	turns.done(1, 40)
+	startAll()
+	if diff := cmp.Diff([]uint64{1, 2}, started); diff != "" {
+		t.Fatalf("partition 3 doesn't fit the limit with 2: %s", diff)
+	}
+
+	turns.done(2, 50)
+	startAll()
+	if diff := cmp.Diff([]uint64{1, 2, 3}, started); diff != "" {
+		t.Fatalf("partition 3 exceeding the limit should be started alone: %s", diff)
+	}
+
+	turns.done(3, 150)
+	startAll()
+	if diff := cmp.Diff([]uint64{1, 2, 3, 4}, started); diff != "" {
+		t.Fatalf("unexpected partitions: %s", diff)
+	}
+	turns.done(4, 10)
+	if turns.resident != 0 || turns.bytes[2] != 50 {
+		t.Fatalf("bytes should be updated: resident=%d bytes=%v", turns.resident, turns.bytes)
+	}
+
+	// no limit
+	started = nil
+	turns.start([]uint64{2, 1, 3}, 0)
+	startAll()
+	if diff := cmp.Diff([]uint64{1, 2, 3}, started); diff != "" {
+		t.Fatalf("all partitions should be started: %s", diff)
+	}
+}
@@ -98,7 +98,7 @@ func Test_vertexActor_Receive_InitVertex(t *testing.T) {
 				if (err != nil) != (tt.wantRespond[i] == nil) {
 					t.Fatal(err)
 				}
-				if diff := cmp.Diff(tt.wantRespond[i], res); diff != "" {
+				if diff := cmp.Diff(tt.wantRespond[i], res, localAckOpts); diff != "" {
 					t.Errorf("unexpected respond: %s", diff)
 				}
 			}
@@ -109,19 +109,27 @@ func Test_vertexActor_Receive_InitVertex(t *testing.T) {
 func Test_vertexActor_Receive_Compute(t *testing.T) {
 	var computed int
 	msg1 := &command.SuperStepMessage{
-		Uuid:         "a",
+		Seq:          1,
 		SuperStep:    0,
 		SrcVertexId:  "foo",
 		DestVertexId: "test-id",
 		Message:      &types.Any{TypeUrl: "com.example/test", Value: []byte("test1")},
 	}
 	msg2 := &command.SuperStepMessage{
-		Uuid:         "b",
+		Seq:          2,
 		SuperStep:    0,
 		SrcVertexId:  "bar",
 		DestVertexId: "test-id",
 		Message:      &types.Any{TypeUrl: "com.example/test", Value: []byte("test2")},
 	}
+	// msg3 is sent in step 1 by a vertex which has started it earlier
+	msg3 := &command.SuperStepMessage{
+		Seq:          3,
+		SuperStep:    1,
+		SrcVertexId:  "baz",
+		DestVertexId: "test-id",
+		Message:      &types.Any{TypeUrl: "com.example/test", Value: []byte("test3")},
+	}
 
 	tests := []struct {
 		name             string
@@ -152,8 +160,8 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			cmd: []proto.Message{
 				&command.LoadVertex{VertexId: "test-id"},
 				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 0},
-				&command.Compute{SuperStep: 1},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
+				&computeLocal{Compute: &command.Compute{SuperStep: 1}},
 			},
 			wantRespond: []proto.Message{
 				&command.LoadVertexAck{
@@ -162,15 +170,19 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 				&command.SuperStepBarrierAck{
 					VertexId: string("test-id"),
 				},
-				&command.ComputeAck{
-					VertexId:         string("test-id"),
-					Halted:           false,
-					AggregatedValues: make(map[string]*types.Any),
+				&computeAckLocal{
+					ComputeAck: &command.ComputeAck{
+						VertexId: string("test-id"),
+						Halted:   false,
+					},
+					aggregated: make(map[string]plugin.AggregatableValue),
 				},
-				&command.ComputeAck{
-					VertexId:         string("test-id"),
-					Halted:           true,
-					AggregatedValues: make(map[string]*types.Any),
+				&computeAckLocal{
+					ComputeAck: &command.ComputeAck{
+						VertexId: string("test-id"),
+						Halted:   true,
+					},
+					aggregated: make(map[string]plugin.AggregatableValue),
 				},
 			},
 			wantComputed:     1,
@@ -198,11 +210,11 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			cmd: []proto.Message{
 				&command.LoadVertex{VertexId: "test-id"},
 				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 0},
-				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 1},
-				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 2},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
+				&command.SuperStepBarrier{SuperStep: 1},
+				&computeLocal{Compute: &command.Compute{SuperStep: 1}},
+				&command.SuperStepBarrier{SuperStep: 2},
+				&computeLocal{Compute: &command.Compute{SuperStep: 2}},
 			},
 			incomingMessages: map[int][]*command.SuperStepMessage{
 				2: {msg1, msg2}, // sent between Compute(step0) and Compute(step1)
@@ -210,15 +222,54 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			wantRespond: []proto.Message{
 				&command.LoadVertexAck{VertexId: "test-id"},
 				&command.SuperStepBarrierAck{VertexId: string("test-id")},
-				&command.ComputeAck{VertexId: string("test-id"), Halted: false, AggregatedValues: make(map[string]*types.Any)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false}, aggregated: make(map[string]plugin.AggregatableValue)},
 				&command.SuperStepBarrierAck{VertexId: string("test-id")},
-				&command.ComputeAck{VertexId: string("test-id"), Halted: false, AggregatedValues: make(map[string]*types.Any)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false}, aggregated: make(map[string]plugin.AggregatableValue)},
 				&command.SuperStepBarrierAck{VertexId: string("test-id")},
-				&command.ComputeAck{VertexId: string("test-id"), Halted: true, AggregatedValues: make(map[string]*types.Any)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: true}, aggregated: make(map[string]plugin.AggregatableValue)},
 			},
 			wantComputed:     2,
 			wantSentMessages: nil,
 		},
+		{
+			name: "compute implies the barrier",
+			vertex: &MockedVertex{
+				ComputeMock: func(ctx plugin.ComputeContext) error {
+					var expected []plugin.Message
+					switch ctx.SuperStep() {
+					case 1:
+						expected = []plugin.Message{"test1", "test2"}
+					case 2:
+						expected = []plugin.Message{"test3"}
+					}
+					if diff := cmp.Diff(expected, ctx.ReceivedMessages()); diff != "" {
+						t.Fatalf("unexpected received messages in step %d: %s", ctx.SuperStep(), diff)
+					}
+					computed++
+					return nil
+				},
+				GetIDMock: func() plugin.VertexID { return "test-id" },
+			},
+			cmd: []proto.Message{
+				&command.LoadVertex{VertexId: "test-id"},
+				&command.SuperStepBarrier{},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
+				&computeLocal{Compute: &command.Compute{SuperStep: 1}},
+				&computeLocal{Compute: &command.Compute{SuperStep: 2}},
+			},
+			incomingMessages: map[int][]*command.SuperStepMessage{
+				2: {msg1, msg3, msg2}, // msg3 arrives before Compute(step1)
+			},
+			wantRespond: []proto.Message{
+				&command.LoadVertexAck{VertexId: "test-id"},
+				&command.SuperStepBarrierAck{VertexId: string("test-id")},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false}, aggregated: make(map[string]plugin.AggregatableValue)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false}, aggregated: make(map[string]plugin.AggregatableValue)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false}, aggregated: make(map[string]plugin.AggregatableValue)},
+			},
+			wantComputed:     3,
+			wantSentMessages: nil,
+		},
 		{
 			name: "send messages to other vertices",
 			vertex: &MockedVertex{
@@ -238,11 +289,11 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			cmd: []proto.Message{
 				&command.LoadVertex{VertexId: "test-id"},
 				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 0},
-				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 1},
-				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 2},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
+				&command.SuperStepBarrier{SuperStep: 1},
+				&computeLocal{Compute: &command.Compute{SuperStep: 1}},
+				&command.SuperStepBarrier{SuperStep: 2},
+				&computeLocal{Compute: &command.Compute{SuperStep: 2}},
 			},
 			incomingMessages: map[int][]*command.SuperStepMessage{
 				2: {msg1}, // sent between Compute(step0) and Compute(step1)
@@ -250,23 +301,21 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			wantRespond: []proto.Message{
 				&command.LoadVertexAck{VertexId: "test-id"},
 				&command.SuperStepBarrierAck{VertexId: string("test-id")},
-				&command.ComputeAck{VertexId: string("test-id"), Halted: false, AggregatedValues: make(map[string]*types.Any)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false, MessagesSent: 1}, aggregated: make(map[string]plugin.AggregatableValue)},
 				&command.SuperStepBarrierAck{VertexId: string("test-id")},
-				&command.ComputeAck{VertexId: string("test-id"), Halted: false, AggregatedValues: make(map[string]*types.Any)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: false, MessagesSent: 1}, aggregated: make(map[string]plugin.AggregatableValue)},
 				&command.SuperStepBarrierAck{VertexId: string("test-id")},
-				&command.ComputeAck{VertexId: string("test-id"), Halted: true, AggregatedValues: make(map[string]*types.Any)},
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: string("test-id"), Halted: true}, aggregated: make(map[string]plugin.AggregatableValue)},
 			},
 			wantComputed: 2,
 			wantSentMessages: []*command.SuperStepMessage{
 				{
-					Uuid:         "", // ignore
 					SuperStep:    0,
 					SrcVertexId:  "test-id",
 					DestVertexId: "dest-0",
 					Message:      timestampToAny(t, 123456, 0),
 				},
 				{
-					Uuid:         "", // ignore
 					SuperStep:    1,
 					SrcVertexId:  "test-id",
 					DestVertexId: "dest-1",
@@ -275,7 +324,7 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			},
 		},
 		{
-			name: "fails when compute() fails",
+			name: "reports error of compute()",
 			vertex: &MockedVertex{
 				ComputeMock: func(ctx plugin.ComputeContext) error {
 					return errors.New("test")
@@ -285,12 +334,12 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			cmd: []proto.Message{
 				&command.LoadVertex{VertexId: "test-id"},
 				&command.SuperStepBarrier{},
-				&command.Compute{SuperStep: 0},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
 			},
 			wantRespond: []proto.Message{
 				&command.LoadVertexAck{VertexId: "test-id"},
 				&command.SuperStepBarrierAck{VertexId: "test-id"},
-				nil,
+				&computeAckLocal{ComputeAck: &command.ComputeAck{VertexId: "test-id", Error: "failed to compute vertex test-id: test"}, aggregated: make(map[string]plugin.AggregatableValue)},
 			},
 		},
 		{
@@ -299,7 +348,7 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 				GetIDMock: func() plugin.VertexID { return "test-id" },
 			},
 			cmd: []proto.Message{
-				&command.Compute{SuperStep: 0},
+				&computeLocal{Compute: &command.Compute{SuperStep: 0}},
 			},
 			wantRespond: []proto.Message{
 				nil,
@@ -362,7 +411,7 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 				case *command.SuperStepMessage:
 					sentMessages = append(sentMessages, m)
 					ctx.Respond(&command.SuperStepMessageAck{
-						Uuid: m.Uuid,
+						Seq: m.Seq,
 					})
 				case proto.Message:
 					ctx.Forward(child)
@@ -374,7 +423,7 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 				if (err != nil) != (tt.wantRespond[i] == nil) {
 					t.Fatalf("i=%v: %v", i, err)
 				}
-				if diff := cmp.Diff(tt.wantRespond[i], res); diff != "" {
+				if diff := cmp.Diff(tt.wantRespond[i], res, localAckOpts); diff != "" {
 					t.Fatalf("unexpected respond of %d: %s", i, diff)
 				}
 				// send super step messages
@@ -385,7 +434,7 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 						if err != nil {
 							t.Fatalf("no Ack: i=%d, msg=%+v", i, *msg)
 						}
-						if ack.(*command.SuperStepMessageAck).Uuid != msg.Uuid {
+						if ack.(*command.SuperStepMessageAck).Seq != msg.Seq {
 							t.Fatalf("unexpected Ack: %+v", ack)
 						}
 						childLock.Unlock()
@@ -400,7 +449,7 @@ func Test_vertexActor_Receive_Compute(t *testing.T) {
 			if len(tt.wantSentMessages) != len(sentMessages) {
 				t.Fatalf("unexpected number of messages: %d", len(sentMessages))
 			}
-			ignoreFields := cmpopts.IgnoreFields(command.SuperStepMessage{}, "Uuid")
+			ignoreFields := cmpopts.IgnoreFields(command.SuperStepMessage{}, "Seq")
 			for i := range tt.wantSentMessages {
 				if diff := cmp.Diff(*tt.wantSentMessages[i], *sentMessages[i], ignoreFields); diff != "" {
 					t.Errorf("unexpected messages: %s", diff)
@@ -425,6 +474,16 @@ func timestampToAny(t *testing.T, sec int64, nano int32) *types.Any {
 	}
 }
 
+// localAckOpts compares acks sent within a worker by fields, they'd be compared by Equal() of the embedded command otherwise
+var localAckOpts = cmp.Options{
+	cmp.Transformer("computeAckLocal", func(a *computeAckLocal) []interface{} {
+		return []interface{}{a.ComputeAck, a.aggregated}
+	}),
+	cmp.Transformer("computePartitionAckLocal", func(a *computePartitionAckLocal) []interface{} {
+		return []interface{}{a.ComputePartitionAck, a.aggregated}
+	}),
+}
+
 var aggregators = []plugin.Aggregator{
 	&MockedAggregator{
 		NameMock: func() string {
@@ -460,7 +519,7 @@ func Test_computeContextImpl_Aggregator(t *testing.T) {
 	logger, _ := test.NewNullLogger()
 
 	type fields struct {
-		aggregatedPrevStep map[string]*types.Any
+		aggregatedPrevStep map[string]plugin.AggregatableValue
 		plugin             plugin.Plugin
 	}
 	type args struct {
@@ -477,8 +536,8 @@ func Test_computeContextImpl_Aggregator(t *testing.T) {
 		{
 			name: "get value by name",
 			fields: fields{
-				aggregatedPrevStep: map[string]*types.Any{
-					"concat": &types.Any{Value: []byte("AA")},
+				aggregatedPrevStep: map[string]plugin.AggregatableValue{
+					"concat": "AA",
 				},
 				plugin: &MockedPlugin{
 					GetAggregatorsMock: func() []plugin.Aggregator {
@@ -496,8 +555,8 @@ func Test_computeContextImpl_Aggregator(t *testing.T) {
 		{
 			name: "get none value by name",
 			fields: fields{
-				aggregatedPrevStep: map[string]*types.Any{
-					"concat": &types.Any{Value: []byte("AA")},
+				aggregatedPrevStep: map[string]plugin.AggregatableValue{
+					"concat": "AA",
 				},
 				plugin: &MockedPlugin{
 					GetAggregatorsMock: func() []plugin.Aggregator {
@@ -515,8 +574,8 @@ func Test_computeContextImpl_Aggregator(t *testing.T) {
 		{
 			name: "unknown aggregator",
 			fields: fields{
-				aggregatedPrevStep: map[string]*types.Any{
-					"concat": &types.Any{Value: []byte("AA")},
+				aggregatedPrevStep: map[string]plugin.AggregatableValue{
+					"concat": "AA",
 				},
 				plugin: &MockedPlugin{
 					GetAggregatorsMock: func() []plugin.Aggregator {
@@ -561,7 +620,7 @@ func Test_computeContextImpl_Aggregator(t *testing.T) {
 func Test_computeContextImpl_PutAggregatable(t *testing.T) {
 	logger, _ := test.NewNullLogger()
 	type fields struct {
-		aggregatedCurrentStep map[string]*types.Any
+		aggregatedCurrentStep map[string]plugin.AggregatableValue
 		plugin                plugin.Plugin
 	}
 	type args struct {
@@ -573,12 +632,12 @@ func Test_computeContextImpl_PutAggregatable(t *testing.T) {
 		fields     fields
 		args       args
 		wantErr    bool
-		wantValues map[string]*types.Any
+		wantValues map[string]plugin.AggregatableValue
 	}{
 		{
 			name: "aggregate: append",
 			fields: fields{
-				aggregatedCurrentStep: make(map[string]*types.Any),
+				aggregatedCurrentStep: make(map[string]plugin.AggregatableValue),
 				plugin: &MockedPlugin{
 					GetAggregatorsMock: func() []plugin.Aggregator {
 						return aggregators
@@ -590,16 +649,16 @@ func Test_computeContextImpl_PutAggregatable(t *testing.T) {
 				v:              "AA",
 			},
 			wantErr: false,
-			wantValues: map[string]*types.Any{
-				"concat": &types.Any{Value: []byte("AA")},
+			wantValues: map[string]plugin.AggregatableValue{
+				"concat": "AA",
 			},
 		},
 		{
 			name: "aggregate: reduce",
 			fields: fields{
-				aggregatedCurrentStep: map[string]*types.Any{
-					"concat": &types.Any{Value: []byte("AA")},
-					"sum":    &types.Any{Value: []byte{uint8(10)}},
+				aggregatedCurrentStep: map[string]plugin.AggregatableValue{
+					"concat": "AA",
+					"sum":    uint8(10),
 				},
 				plugin: &MockedPlugin{
 					GetAggregatorsMock: func() []plugin.Aggregator {
@@ -612,15 +671,15 @@ func Test_computeContextImpl_PutAggregatable(t *testing.T) {
 				v:              uint8(3),
 			},
 			wantErr: false,
-			wantValues: map[string]*types.Any{
-				"concat": &types.Any{Value: []byte("AA")},
-				"sum":    &types.Any{Value: []byte{uint8(13)}},
+			wantValues: map[string]plugin.AggregatableValue{
+				"concat": "AA",
+				"sum":    uint8(13),
 			},
 		},
 		{
 			name: "unknown name",
 			fields: fields{
-				aggregatedCurrentStep: make(map[string]*types.Any),
+				aggregatedCurrentStep: make(map[string]plugin.AggregatableValue),
 				plugin: &MockedPlugin{
 					GetAggregatorsMock: func() []plugin.Aggregator {
 						return aggregators
@@ -632,7 +691,7 @@ func Test_computeContextImpl_PutAggregatable(t *testing.T) {
 				v:              "AA",
 			},
 			wantErr:    true,
-			wantValues: make(map[string]*types.Any),
+			wantValues: make(map[string]plugin.AggregatableValue),
 		},
 	}
 	for _, tt := range tests {
@@ -653,3 +712,64 @@ func Test_computeContextImpl_PutAggregatable(t *testing.T) {
 		})
 	}
 }
+
+func Test_vertexActor_Receive_Compute_stage(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vertex := &MockedVertex{
+		ComputeMock: func(ctx plugin.ComputeContext) error {
+			t.Error("Compute() should not be called when stage is specified")
+			return nil
+		},
+		GetIDMock: func() plugin.VertexID { return "test-id" },
+	}
+	var computedBy []string
+	plg := &MockedPipelinePlugin{
+		MockedPlugin: MockedPlugin{
+			NewVertexMock:      func(id plugin.VertexID) (plugin.Vertex, error) { return vertex, nil },
+			GetAggregatorsMock: func() []plugin.Aggregator { return nil },
+		},
+		GetStagesMock: func() []plugin.Stage {
+			return []plugin.Stage{&MockedStage{
+				NameMock: func() string { return "stage1" },
+				ComputeMock: func(v plugin.Vertex, ctx plugin.ComputeContext, params map[string]string) error {
+					computedBy = append(computedBy, fmt.Sprintf("%s:%s", v.GetID(), params["p"]))
+					ctx.VoteToHalt()
+					return nil
+				},
+			}}
+		},
+	}
+	context := actor.EmptyRootContext
+	pid := context.Spawn(actor.PropsFromProducer(func() actor.Actor {
+		return NewVertexActor(plg, logger)
+	}))
+
+	for _, cmd := range []proto.Message{
+		&command.LoadVertex{VertexId: "test-id"},
+		&command.SuperStepBarrier{},
+	} {
+		if _, err := context.RequestFuture(pid, cmd, time.Second).Result(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	res, err := context.RequestFuture(pid, &computeLocal{Compute: &command.Compute{
+		SuperStep:   0,
+		Stage:       "stage1",
+		StageParams: map[string]string{"p": "v"},
+	}}, time.Second).Result()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(&computeAckLocal{
+		ComputeAck: &command.ComputeAck{
+			VertexId: "test-id",
+			Halted:   true,
+		},
+		aggregated: make(map[string]plugin.AggregatableValue),
+	}, res, localAckOpts); diff != "" {
+		t.Fatalf("unexpected respond: %s", diff)
+	}
+	if diff := cmp.Diff([]string{"test-id:v"}, computedBy); diff != "" {
+		t.Fatalf("unexpected stage compute: %s", diff)
+	}
+}
@@ -8,8 +8,6 @@ import (
 
 	"github.com/AsynkronIT/protoactor-go/actor"
 	"github.com/gogo/protobuf/proto"
-	"github.com/gogo/protobuf/types"
-	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/rerorero/prerogel/command"
 	"github.com/rerorero/prerogel/plugin"
@@ -18,8 +16,26 @@ import (
 )
 
 type superStepMsgBuf struct {
-	buf    map[plugin.VertexID][]*command.SuperStepMessage
-	plugin plugin.Plugin
+	buf        map[vertexKey][]*command.SuperStepMessage
+	plugin     plugin.Plugin
+	numericIDs bool
+	// messages are spilled to runs on disk past the memory limit of spill, bytes is size of buf
+	spill   *messageSpill
+	bytes   int
+	runs    []string
+	spilled int
+}
+
+// computeLocal is Compute carrying aggregated values of the previous superstep unmarshaled by the worker, partitions and vertices always run in the process of their worker
+type computeLocal struct {
+	*command.Compute
+	aggregated map[string]plugin.AggregatableValue
+}
+
+// partitionPair is a pair of source and destination partitions of messages
+type partitionPair struct {
+	src  uint64
+	dest uint64
 }
 
 type workerActor struct {
@@ -30,30 +46,58 @@ type workerActor struct {
 	partitions            map[uint64]*actor.PID
 	partitionProps        *actor.Props
 	clusterInfo           *command.ClusterInfo
+	router                *router
 	ackRecorder           *util.AckRecorder
-	combinedMessagesAck   *util.AckRecorder
+	messageAcks           *util.SeqAckRecorder
 	ssMessageBuf          *superStepMsgBuf
-	aggregatedCurrentStep map[string]*types.Any
+	aggregatedCurrentStep map[string]plugin.AggregatableValue
+	partitionStats        []*command.PartitionStats
+	traffic               map[partitionPair]*command.PartitionTraffic
+	trackVertexTraffic    bool
+	vertexTraffic         map[plugin.VertexID]map[uint64]uint64
+	internalMessages      map[string]uint64
 	shutdownHandler       func()
+	// computeErr is the first error reported by partitions in the current superstep
+	computeErr string
+	// messageBatch sends messages to other workers by SuperStepMessageBatch
+	messageBatch       bool
+	messageCompression command.BatchCompression
+	batchStats         *command.MessageBatchStats
+	// inboundAcks numbers messages in batches from other workers, which are delivered by the worker
+	inboundAcks    *util.SeqAckRecorder
+	inboundBatches []*inboundBatch
+	// outboxes limit messages in flight to each of other workers by flowControl
+	flowControl *command.FlowControl
+	outboxes    map[string]*outbox
+	messageDest map[uint64]string
+	flowStats   *command.FlowControlStats
+	// mailboxes is nil unless the worker is spawned by workerProps
+	mailboxes *mailboxGauges
+	// spill is shared with vertices if the worker is spawned by workerProps
+	spill *messageSpill
+	// partitions are computed in turns by the memory limit of partitionStore
+	partitionStore *command.PartitionStore
+	turns          computeTurns
+	computeCmd     *computeLocal
 }
 
 // NewWorkerActor returns a new actor instance
 func NewWorkerActor(plugin plugin.Plugin, partitionProps *actor.Props, shutdown func(), logger *logrus.Logger) actor.Actor {
 	ar := &util.AckRecorder{}
 	ar.Clear()
-	mar := &util.AckRecorder{}
-	mar.Clear()
 	a := &workerActor{
 		ActorUtil: util.ActorUtil{
 			Logger: logger,
 		},
-		plugin:              plugin,
-		partitions:          make(map[uint64]*actor.PID),
-		partitionProps:      partitionProps,
-		ackRecorder:         ar,
-		combinedMessagesAck: mar,
-		ssMessageBuf:        newSuperStepMsgBuf(plugin),
-		shutdownHandler:     shutdown,
+		plugin:          plugin,
+		partitions:      make(map[uint64]*actor.PID),
+		partitionProps:  partitionProps,
+		router:          newRouter(plugin, 0),
+		ackRecorder:     ar,
+		messageAcks:     &util.SeqAckRecorder{},
+		ssMessageBuf:    newSuperStepMsgBuf(plugin),
+		shutdownHandler: shutdown,
+		inboundAcks:     &util.SeqAckRecorder{},
 	}
 	a.behavior.Become(a.waitInit)
 	return a
@@ -69,6 +113,10 @@ func (state *workerActor) Receive(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.ClusterInfo:
 		state.clusterInfo = cmd
+		state.router.update(cmd)
+		if err := state.checkClusterInfo(cmd, context.Self()); err != nil {
+			state.ActorUtil.LogError(context, err.Error())
+		}
 		state.broadcastToPartitions(context, cmd)
 		return
 
@@ -78,7 +126,7 @@ func (state *workerActor) Receive(context actor.Context) {
 		return
 
 	case *command.GetVertexValue:
-		p, err := state.plugin.Partition(plugin.VertexID(cmd.VertexId), state.clusterInfo.NumOfPartitions())
+		p, err := state.router.partitionOf(plugin.VertexID(cmd.VertexId))
 		if err != nil {
 			state.ActorUtil.LogWarn(context, fmt.Sprintf("failed to Partition(): %v", err))
 			context.Respond(&command.GetVertexValueAck{VertexId: cmd.VertexId})
@@ -101,6 +149,17 @@ func (state *workerActor) waitInit(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.InitWorker:
 		state.coordinatorPID = cmd.Coordinator
+		state.router.setCacheSize(int(cmd.VertexCacheSize))
+		state.router.numericIDs = cmd.NumericVertexIds
+		state.ssMessageBuf.numericIDs = cmd.NumericVertexIds
+		state.messageBatch = cmd.MessageBatch
+		state.messageCompression = cmd.MessageCompression
+		if state.spill == nil {
+			state.spill = &messageSpill{}
+		}
+		state.spill.configure(cmd.MessageSpill)
+		state.ssMessageBuf.spill = state.spill
+		state.partitionStore = cmd.PartitionStore
 		for _, partition := range cmd.Partitions {
 			if _, ok := state.partitions[partition]; ok {
 				state.ActorUtil.LogWarn(context, fmt.Sprintf("partition=%v has already created", partition))
@@ -114,10 +173,17 @@ func (state *workerActor) waitInit(context actor.Context) {
 			}
 			state.partitions[partition] = pid
 			context.Request(pid, &command.InitPartition{
-				PartitionId: partition,
+				PartitionId:      partition,
+				NumericVertexIds: cmd.NumericVertexIds,
+				Store:            cmd.PartitionStore,
 			})
 		}
 		state.resetAckRecorder()
+		if len(state.partitions) == 0 {
+			// a worker added to the running cluster has no partitions until they are moved to it
+			state.initWorkerAckAndBecomeIdle(context)
+			return
+		}
 		state.behavior.Become(state.waitPartitionInitAck)
 		state.ActorUtil.LogDebug(context, "become waitPartitionInitAck")
 		return
@@ -136,12 +202,7 @@ func (state *workerActor) waitPartitionInitAck(context actor.Context) {
 			state.ActorUtil.LogWarn(context, fmt.Sprintf("InitPartitionAck duplicated: id=%v", cmd.PartitionId))
 		}
 		if state.ackRecorder.HasCompleted() {
-			context.Send(state.coordinatorPID, &command.InitWorkerAck{
-				WorkerPid: context.Self(),
-			})
-			state.resetAckRecorder()
-			state.behavior.Become(state.idle)
-			state.ActorUtil.LogDebug(context, "become idle")
+			state.initWorkerAckAndBecomeIdle(context)
 		}
 		return
 	default:
@@ -150,10 +211,21 @@ func (state *workerActor) waitPartitionInitAck(context actor.Context) {
 	}
 }
 
+func (state *workerActor) initWorkerAckAndBecomeIdle(context actor.Context) {
+	context.Send(state.coordinatorPID, &command.InitWorkerAck{
+		WorkerPid:   context.Self(),
+		Algorithms:  algorithmsOf(state.plugin),
+		Fingerprint: fingerprintOf(state.plugin),
+	})
+	state.resetAckRecorder()
+	state.behavior.Become(state.idle)
+	state.ActorUtil.LogDebug(context, "become idle")
+}
+
 func (state *workerActor) idle(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.LoadVertex:
-		destPartition, err := state.plugin.Partition(plugin.VertexID(cmd.VertexId), state.clusterInfo.NumOfPartitions())
+		destPartition, err := state.router.partitionOf(plugin.VertexID(cmd.VertexId))
 		if err != nil {
 			err := fmt.Sprintf("failed to find partition: vertex id=%s err=%v", cmd.VertexId, err)
 			state.ActorUtil.LogError(context, err)
@@ -181,20 +253,125 @@ func (state *workerActor) idle(context actor.Context) {
 
 	case *command.SuperStepBarrier:
 		state.ActorUtil.LogDebug(context, "super step barrier")
-		state.ssMessageBuf.clear()
+		state.beginSuperStep()
 		state.broadcastToPartitions(context, cmd)
 		state.resetAckRecorder()
-		state.aggregatedCurrentStep = make(map[string]*types.Any)
-		state.combinedMessagesAck.Clear()
 		state.behavior.Become(state.waitSuperStepBarrierAck)
 		state.ActorUtil.LogDebug(context, "become waitSuperStepBarrierAck")
 		return
 
+	case *command.Compute:
+		// the barrier is implied by Compute unless the coordinator sent it
+		state.beginSuperStep()
+		state.behavior.Become(state.superstep)
+		state.superstep(context)
+		return
+
 	case *command.SuperStepMessage:
 		// need to handle because other workers might still run super-step
 		state.handleSuperStepMessage(context, cmd)
 		return
 
+	case *command.SuperStepMessageBatch:
+		state.handleMessageBatch(context, cmd)
+		return
+
+	case *command.SuperStepMessageAck:
+		// acks of messages in batches from other workers
+		if !state.ackInboundBatch(context, cmd) {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("unknown message ack: seq=%v", cmd.Seq))
+		}
+		return
+
+	case *command.SelectAlgorithm:
+		ack := &command.SelectAlgorithmAck{WorkerPid: context.Self()}
+		if sel, ok := state.plugin.(algorithmSelector); !ok {
+			ack.Error = "algorithm registry is not used"
+		} else if err := sel.selectAlgorithm(cmd.Name); err != nil {
+			ack.Error = err.Error()
+		}
+		// the algorithm may partition vertices differently
+		state.router.clearCache()
+		if ack.Error != "" {
+			state.ActorUtil.LogError(context, fmt.Sprintf("failed to select algorithm: %s", ack.Error))
+		}
+		context.Respond(ack)
+		return
+
+	case *command.ExportPartition:
+		pid, ok := state.partitions[cmd.PartitionId]
+		if !ok {
+			err := fmt.Sprintf("partition %v is not found", cmd.PartitionId)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.ExportPartitionAck{PartitionId: cmd.PartitionId, Error: err})
+			return
+		}
+		// the partition is kept until another worker imports it
+		context.Forward(pid)
+		return
+
+	case *command.ReleasePartition:
+		pid, ok := state.partitions[cmd.PartitionId]
+		if !ok {
+			state.ActorUtil.LogWarn(context, fmt.Sprintf("released partition %v is not found", cmd.PartitionId))
+			return
+		}
+		delete(state.partitions, cmd.PartitionId)
+		context.Stop(pid)
+		state.ActorUtil.LogInfo(context, fmt.Sprintf("partition %v has been released", cmd.PartitionId))
+		return
+
+	case *command.ExportVertices:
+		pid, ok := state.partitions[cmd.PartitionId]
+		if !ok {
+			err := fmt.Sprintf("partition %v is not found", cmd.PartitionId)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.ExportVerticesAck{PartitionId: cmd.PartitionId, Error: err})
+			return
+		}
+		context.Forward(pid)
+		return
+
+	case *command.ImportVertices:
+		pid, ok := state.partitions[cmd.PartitionId]
+		if !ok {
+			err := fmt.Sprintf("partition %v is not found", cmd.PartitionId)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.ImportVerticesAck{PartitionId: cmd.PartitionId, Error: err})
+			return
+		}
+		context.Forward(pid)
+		return
+
+	case *command.ImportPartition:
+		if _, ok := state.partitions[cmd.PartitionId]; ok {
+			err := fmt.Sprintf("partition %v already exists", cmd.PartitionId)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.ImportPartitionAck{PartitionId: cmd.PartitionId, Error: err})
+			return
+		}
+		pid, err := context.SpawnNamed(state.partitionProps, fmt.Sprintf("p%v", cmd.PartitionId))
+		if err != nil {
+			err := fmt.Sprintf("spawn partition failed %v: %v", cmd.PartitionId, err)
+			state.ActorUtil.LogError(context, err)
+			context.Respond(&command.ImportPartitionAck{PartitionId: cmd.PartitionId, Error: err})
+			return
+		}
+		state.partitions[cmd.PartitionId] = pid
+		context.Request(pid, cmd)
+		return
+
+	case *command.ImportPartitionAck:
+		if cmd.Error != "" {
+			// vertices imported partially are dropped, the partition stays with the exporting worker
+			if pid, ok := state.partitions[cmd.PartitionId]; ok {
+				delete(state.partitions, cmd.PartitionId)
+				context.Stop(pid)
+			}
+		}
+		context.Send(state.coordinatorPID, cmd)
+		return
+
 	default:
 		state.ActorUtil.Fail(context, fmt.Errorf("[idle] unhandled worker command: command=%#v", cmd))
 		return
@@ -208,10 +385,16 @@ func (state *workerActor) waitLoadPartitionVertices(context actor.Context) {
 		if !state.ackRecorder.Ack(strconv.FormatUint(cmd.PartitionId, 10)) {
 			state.ActorUtil.LogWarn(context, fmt.Sprintf("LoadPartitionVertcies duplicated: id=%v", cmd.PartitionId))
 		}
+		if cmd.Stats != nil {
+			state.partitionStats = append(state.partitionStats, cmd.Stats)
+			state.turns.setBytes(cmd.PartitionId, cmd.Stats.VertexBytes)
+		}
 		if state.ackRecorder.HasCompleted() {
 			context.Send(state.coordinatorPID, &command.LoadPartitionVerticesWorkerAck{
-				WorkerPid: context.Self(),
+				WorkerPid:  context.Self(),
+				Partitions: state.partitionStats,
 			})
+			state.partitionStats = nil
 			state.resetAckRecorder()
 			state.behavior.Become(state.idle)
 			state.ActorUtil.LogDebug(context, "worker waitLoadPartitionVertices has completed")
@@ -223,6 +406,15 @@ func (state *workerActor) waitLoadPartitionVertices(context actor.Context) {
 	}
 }
 
+// beginSuperStep clears states of the previous superstep
+func (state *workerActor) beginSuperStep() {
+	state.ssMessageBuf.clear()
+	state.aggregatedCurrentStep = make(map[string]plugin.AggregatableValue)
+	state.messageAcks.Clear()
+	state.batchStats = nil
+	state.traffic = make(map[partitionPair]*command.PartitionTraffic)
+}
+
 func (state *workerActor) waitSuperStepBarrierAck(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.SuperStepBarrierPartitionAck:
@@ -249,35 +441,86 @@ func (state *workerActor) superstep(context actor.Context) {
 	switch cmd := context.Message().(type) {
 	case *command.Compute: // sent from parent
 		state.resetAckRecorder()
-		state.broadcastToPartitions(context, cmd)
+		state.trackVertexTraffic = cmd.TrackVertexTraffic
+		state.vertexTraffic = nil
+		state.internalMessages = nil
+		state.flowControl = cmd.FlowControl
+		state.releaseOutboxes()
+		state.messageDest = nil
+		state.flowStats = nil
+		state.computeErr = ""
+		aggregated, err := unmarshalAggregatedValues(state.plugin.GetAggregators(), cmd.AggregatedValues)
+		if err != nil {
+			state.ActorUtil.Fail(context, err)
+			return
+		}
+		state.computeCmd = &computeLocal{Compute: cmd, aggregated: aggregated}
+		partitions := make([]uint64, 0, len(state.partitions))
+		for p := range state.partitions {
+			partitions = append(partitions, p)
+		}
+		state.turns.start(partitions, state.partitionStore.GetMemoryLimit())
+		state.computeInTurns(context)
 		return
 
-	case *command.ComputePartitionAck:
+	case *computePartitionAckLocal:
 		// TODO: aggregate halted status
-		if cmd.AggregatedValues != nil {
-			if err := aggregateValueMap(state.plugin.GetAggregators(), state.aggregatedCurrentStep, cmd.AggregatedValues); err != nil {
-				state.ActorUtil.Fail(context, err)
-				return
-			}
+		if err := aggregateValues(state.plugin.GetAggregators(), state.aggregatedCurrentStep, cmd.aggregated); err != nil {
+			state.ActorUtil.Fail(context, err)
+			return
 		}
 		if !state.ackRecorder.Ack(strconv.FormatUint(cmd.PartitionId, 10)) {
 			state.ActorUtil.LogWarn(context, fmt.Sprintf("ComputeAck duplicated: id=%v", cmd.PartitionId))
 		}
+		if cmd.flow != nil {
+			if state.flowStats == nil {
+				state.flowStats = &command.FlowControlStats{}
+			}
+			state.flowStats.Add(cmd.flow)
+		}
+		if cmd.Stats != nil {
+			state.partitionStats = append(state.partitionStats, cmd.Stats)
+		}
+		if cmd.Error != "" && state.computeErr == "" {
+			state.computeErr = cmd.Error
+		}
+		if cmd.LocalMessages > 0 {
+			state.countTraffic(cmd.PartitionId, cmd.PartitionId, cmd.LocalMessages, false)
+		}
+		state.turns.done(cmd.PartitionId, cmd.Stats.GetVertexBytes())
+		state.computeInTurns(context)
+		for vid, n := range cmd.InternalMessages {
+			if state.internalMessages == nil {
+				state.internalMessages = make(map[string]uint64)
+			}
+			state.internalMessages[vid] += n
+		}
 		if state.ackRecorder.HasCompleted() {
 			if state.ssMessageBuf.numOfMessage() > 0 {
 				if err := state.ssMessageBuf.combine(); err != nil {
 					state.ActorUtil.LogError(context, fmt.Sprintf("failed to combine: %v", err))
 				}
-				// TODO: it can reduce messages by aggregating by each destination worker
-				for dest, msgs := range state.ssMessageBuf.buf {
-					destWorker := state.findWorkerInfoByVertex(context, dest)
+				if state.messageBatch {
+					state.sendMessageBatches(context)
+					return
+				}
+				if err := state.ssMessageBuf.forEach(func(dest vertexKey, msgs []*command.SuperStepMessage) error {
+					destWorker := state.findWorkerInfoByKey(context, dest)
 					if destWorker == nil || destWorker.WorkerPid.GetId() == context.Self().GetId() {
-						state.ActorUtil.Fail(context, fmt.Errorf("failed to find worker: %v", dest))
-						return
+						return fmt.Errorf("failed to find worker: %v", dest)
 					}
+					o := state.outboxOf(destWorker.WorkerPid.GetId(), state.flowControl.GetWorkerInflight())
 					for _, m := range msgs {
-						context.Request(destWorker.WorkerPid, m)
+						// acks come back to the worker, which has already acked the vertex
+						m.Seq = state.issueMessageSeq(destWorker.WorkerPid.GetId())
+						if err := o.sendProto(context.Request, destWorker.WorkerPid, m); err != nil {
+							return err
+						}
 					}
+					return nil
+				}); err != nil {
+					state.ActorUtil.Fail(context, err)
+					return
 				}
 				// wait for SuperStepMessageAck from other workers
 			} else {
@@ -290,12 +533,22 @@ func (state *workerActor) superstep(context actor.Context) {
 		state.handleSuperStepMessage(context, cmd)
 		return
 
+	case *command.SuperStepMessageBatch:
+		state.handleMessageBatch(context, cmd)
+		return
+
 	case *command.SuperStepMessageAck:
-		state.ssMessageBuf.remove(cmd)
-		if state.ssMessageBuf.numOfMessage() == 0 {
-			state.computeAckAndBecomeIdle(context)
+		if isInboundSeq(cmd.Seq) {
+			if !state.ackInboundBatch(context, cmd) {
+				state.ActorUtil.LogWarn(context, fmt.Sprintf("unknown message ack: seq=%v", cmd.Seq))
+			}
+			return
 		}
+		state.ackMessage(context, cmd.Seq)
+		return
 
+	case *command.SuperStepMessageBatchAck:
+		state.ackMessage(context, cmd.Seq)
 		return
 
 	default:
@@ -305,35 +558,50 @@ func (state *workerActor) superstep(context actor.Context) {
 }
 
 func (state *workerActor) handleSuperStepMessage(context actor.Context, cmd *command.SuperStepMessage) {
-	srcWorker := state.findWorkerInfoByVertex(context, plugin.VertexID(cmd.SrcVertexId))
+	src, dest := srcKeyOf(cmd), destKeyOf(cmd)
+	srcPartition, err := state.router.partitionOfKey(src)
+	if err != nil {
+		state.ActorUtil.LogError(context, fmt.Sprintf("failed to Partition(): %v", err))
+		return
+	}
+	srcWorker := state.router.workerOfPartition(srcPartition)
 	if srcWorker == nil {
 		state.ActorUtil.LogError(context, fmt.Sprintf("[superstep] message from unknown worker: command=%#v", cmd))
 		return
 	}
 
 	if srcWorker.WorkerPid.GetId() == context.Self().GetId() {
-		destPartition, err := state.plugin.Partition(plugin.VertexID(cmd.DestVertexId), state.clusterInfo.NumOfPartitions())
+		destPartition, err := state.router.partitionOfKey(dest)
 		if err != nil {
 			state.ActorUtil.Fail(context, fmt.Errorf("failed to find partition for message: %#v", cmd))
 			return
 		}
 
+		if state.trackVertexTraffic {
+			state.countVertexTraffic(src.vertexID(state.router.numericIDs), destPartition)
+		}
+
 		destPid, ok := state.partitions[destPartition]
 		if ok {
 			// when sent from local partition to local partition, forward it
+			state.countTraffic(srcPartition, destPartition, 1, false)
 			context.Forward(destPid)
 
 		} else {
 			// when sent from local partition to other worker's partition, saves to buffer then responds Ack to vertex
-			state.ssMessageBuf.add(cmd)
+			state.countTraffic(srcPartition, destPartition, 1, true)
+			if err := state.ssMessageBuf.add(cmd); err != nil {
+				state.ActorUtil.Fail(context, errors.Wrap(err, "failed to buffer message"))
+				return
+			}
 			context.Respond(&command.SuperStepMessageAck{
-				Uuid: cmd.Uuid,
+				Seq: cmd.Seq,
 			})
 		}
 
 	} else {
 		// when sent from other worker, route it to vertex
-		p, err := state.plugin.Partition(plugin.VertexID(cmd.DestVertexId), state.clusterInfo.NumOfPartitions())
+		p, err := state.router.partitionOfKey(dest)
 		if err != nil {
 			state.ActorUtil.Fail(context, errors.Wrap(err, "failed to Partition()"))
 			return
@@ -348,15 +616,186 @@ func (state *workerActor) handleSuperStepMessage(context actor.Context, cmd *com
 	return
 }
 
+// sendMessageBatches encodes buffered messages into batches for each destination worker as they are read.
+// Messages read back from spilled runs are batched by the spill chunk, and batches past the flow control limit are held on disk.
+func (state *workerActor) sendMessageBatches(context actor.Context) {
+	// batches are split by the limit of messages in flight, and sent one by one
+	batcher := &messageBatcher{size: int(state.flowControl.GetWorkerInflight())}
+	var window uint32
+	if batcher.size > 0 {
+		window = 1
+	}
+	if len(state.ssMessageBuf.runs) > 0 {
+		batcher.maxBytes = state.spill.chunk()
+	}
+	stats := &command.MessageBatchStats{}
+	batcher.flush = func(to *actor.PID, msgs []*command.SuperStepMessage) error {
+		batch, err := encodeMessageBatch(msgs, state.ssMessageBuf.numericIDs, state.messageCompression)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode message batch")
+		}
+		batch.Seq = state.issueMessageSeq(to.GetId())
+		var raw uint64
+		for _, m := range msgs {
+			raw += uint64(m.Size())
+		}
+		stats.Add(&command.MessageBatchStats{
+			Batches:          1,
+			Messages:         uint64(len(msgs)),
+			RawBytes:         raw,
+			EncodedBytes:     uint64(batch.Size()),
+			MaxBatchMessages: uint64(len(msgs)),
+			MaxBatchBytes:    uint64(batch.Size()),
+		})
+		return state.outboxOf(to.GetId(), window).sendProto(context.Request, to, batch)
+	}
+	if err := state.ssMessageBuf.forEach(func(key vertexKey, msgs []*command.SuperStepMessage) error {
+		destWorker := state.findWorkerInfoByKey(context, key)
+		if destWorker == nil || destWorker.WorkerPid.GetId() == context.Self().GetId() {
+			return fmt.Errorf("failed to find worker: %v", key)
+		}
+		return batcher.add(destWorker.WorkerPid, msgs)
+	}); err != nil {
+		state.ActorUtil.Fail(context, err)
+		return
+	}
+	if err := batcher.close(); err != nil {
+		state.ActorUtil.Fail(context, err)
+		return
+	}
+	state.batchStats = stats
+	state.ssMessageBuf.clear()
+	// wait for SuperStepMessageBatchAck from other workers
+}
+
+// ackMessage counts an ack of a message or a batch sent to another worker, compute completes once all of them are acked
+func (state *workerActor) ackMessage(context actor.Context, seq uint64) {
+	if !state.messageAcks.Ack(seq) {
+		state.ActorUtil.LogWarn(context, fmt.Sprintf("duplicated or unknown message ack: seq=%v", seq))
+		return
+	}
+	if dest, ok := state.messageDest[seq]; ok {
+		delete(state.messageDest, seq)
+		if err := state.outboxes[dest].ack(context.Request); err != nil {
+			state.ActorUtil.Fail(context, err)
+			return
+		}
+	}
+	if state.messageAcks.HasCompleted() {
+		state.computeAckAndBecomeIdle(context)
+	}
+}
+
+// outboxOf returns the outbox of the destination worker
+func (state *workerActor) outboxOf(dest string, limit uint32) *outbox {
+	if state.outboxes == nil {
+		state.outboxes = make(map[string]*outbox)
+	}
+	o, ok := state.outboxes[dest]
+	if !ok {
+		o = &outbox{}
+		o.reset(limit)
+		o.spill = state.spill
+		state.outboxes[dest] = o
+	}
+	return o
+}
+
+// releaseOutboxes forgets outboxes, messages held on disk are removed
+func (state *workerActor) releaseOutboxes() {
+	for _, o := range state.outboxes {
+		o.release()
+	}
+	state.outboxes = nil
+}
+
+// issueMessageSeq numbers a message or a batch sent to another worker, the destination is kept to release held messages by its ack
+func (state *workerActor) issueMessageSeq(dest string) uint64 {
+	seq := state.messageAcks.Issue()
+	if state.flowControl.GetWorkerInflight() > 0 {
+		if state.messageDest == nil {
+			state.messageDest = make(map[uint64]string)
+		}
+		state.messageDest[seq] = dest
+	}
+	return seq
+}
+
+// flowControlStats returns stats of held messages and mailboxes in the superstep
+func (state *workerActor) flowControlStats() *command.FlowControlStats {
+	s := state.flowStats
+	for _, o := range state.outboxes {
+		s = o.addStats(s)
+	}
+	if state.mailboxes != nil {
+		s = state.mailboxes.addStats(s)
+	}
+	return s
+}
+
+// handleMessageBatch delivers messages in a batch from another worker to partitions, the batch is acked once vertices ack all of them
+func (state *workerActor) handleMessageBatch(context actor.Context, cmd *command.SuperStepMessageBatch) {
+	msgs, err := decodeMessageBatch(cmd)
+	if err != nil {
+		state.ActorUtil.Fail(context, errors.Wrapf(err, "failed to decode message batch from %v", context.Sender()))
+		return
+	}
+	if len(msgs) == 0 {
+		context.Respond(&command.SuperStepMessageBatchAck{Seq: cmd.Seq})
+		return
+	}
+	b := &inboundBatch{sender: context.Sender(), seq: cmd.Seq, pending: len(msgs)}
+	for _, m := range msgs {
+		p, err := state.router.partitionOfKey(destKeyOf(m))
+		if err != nil {
+			state.ActorUtil.Fail(context, errors.Wrap(err, "failed to Partition()"))
+			return
+		}
+		pid, ok := state.partitions[p]
+		if !ok {
+			state.ActorUtil.Fail(context, fmt.Errorf("[superstep] destination partition(%v) is not found: command=%#v", p, m))
+			return
+		}
+		b.last = state.inboundAcks.Issue()
+		m.Seq = inboundSeq(b.last)
+		context.Request(pid, m)
+	}
+	state.inboundBatches = append(state.inboundBatches, b)
+}
+
+// ackInboundBatch counts an ack of a message delivered from a batch, it returns false if the message is unknown
+func (state *workerActor) ackInboundBatch(context actor.Context, ack *command.SuperStepMessageAck) bool {
+	seq := ack.Seq &^ inboundSeqFlag
+	if !state.inboundAcks.Ack(seq) {
+		return false
+	}
+	// batches are numbered in ascending order
+	i := sort.Search(len(state.inboundBatches), func(i int) bool { return state.inboundBatches[i].last >= seq })
+	if i == len(state.inboundBatches) {
+		return false
+	}
+	b := state.inboundBatches[i]
+	b.pending--
+	if b.pending == 0 {
+		context.Send(b.sender, &command.SuperStepMessageBatchAck{Seq: b.seq})
+		state.inboundBatches = append(state.inboundBatches[:i], state.inboundBatches[i+1:]...)
+	}
+	return true
+}
+
+// checkClusterInfo verifies the worker owns partitions assigned by ClusterInfo.
+// Partitions are moved by ExportPartition and ImportPartition before the new ClusterInfo is broadcast.
 func (state *workerActor) checkClusterInfo(clusterInfo *command.ClusterInfo, self *actor.PID) error {
 	var cmdPartitions []uint64
+	found := false
 	for _, i := range clusterInfo.WorkerInfo {
 		if i.WorkerPid.Id == self.GetId() {
-			cmdPartitions = i.Partitions
+			cmdPartitions = append(cmdPartitions, i.Partitions...)
+			found = true
 			break
 		}
 	}
-	if cmdPartitions == nil {
+	if !found {
 		return fmt.Errorf("worker not found in WorkerInfo: me=%v", self)
 	}
 	var currentPartitions []uint64
@@ -366,13 +805,23 @@ func (state *workerActor) checkClusterInfo(clusterInfo *command.ClusterInfo, sel
 	sort.Slice(cmdPartitions, func(i, j int) bool { return cmdPartitions[i] < cmdPartitions[j] })
 	sort.Slice(currentPartitions, func(i, j int) bool { return currentPartitions[i] < currentPartitions[j] })
 
-	if !reflect.DeepEqual(cmdPartitions, currentPartitions) {
-		// TODO: reconcile partitions
+	if len(cmdPartitions) != len(currentPartitions) || (len(cmdPartitions) > 0 && !reflect.DeepEqual(cmdPartitions, currentPartitions)) {
 		return fmt.Errorf("assigned partitions has changed: %v -> %v", currentPartitions, cmdPartitions)
 	}
 	return nil
 }
 
+// computeInTurns sends Compute to partitions while their vertices fit the memory limit, the rest wait for ComputePartitionAck
+func (state *workerActor) computeInTurns(context actor.Context) {
+	for {
+		p, ok := state.turns.next()
+		if !ok {
+			return
+		}
+		context.Request(state.partitions[p], state.computeCmd)
+	}
+}
+
 func (state *workerActor) broadcastToPartitions(context actor.Context, msg proto.Message) {
 	state.LogDebug(context, fmt.Sprintf("broadcast %#v", msg))
 	for _, pid := range state.partitions {
@@ -387,107 +836,203 @@ func (state *workerActor) resetAckRecorder() {
 	}
 }
 
-func (state *workerActor) findWorkerInfoByVertex(context actor.Context, vid plugin.VertexID) *command.ClusterInfo_WorkerInfo {
-	p, err := state.plugin.Partition(vid, state.clusterInfo.NumOfPartitions())
+func (state *workerActor) findWorkerInfoByKey(context actor.Context, k vertexKey) *command.ClusterInfo_WorkerInfo {
+	w, err := state.router.workerOfKey(k)
 	if err != nil {
 		state.ActorUtil.LogError(context, fmt.Sprintf("failed to Partition(): %v", err))
 		return nil
 	}
-	return state.clusterInfo.FindWoerkerInfoByPartition(p)
+	return w
 }
 
 func (state *workerActor) computeAckAndBecomeIdle(context actor.Context) {
-	context.Send(state.coordinatorPID, &command.ComputeWorkerAck{
+	// the coordinator may be in another process
+	aggregated, err := marshalAggregatedValues(state.plugin.GetAggregators(), state.aggregatedCurrentStep)
+	if err != nil {
+		state.ActorUtil.Fail(context, err)
+		return
+	}
+	ack := &command.ComputeWorkerAck{
 		WorkerPid:        context.Self(),
-		AggregatedValues: state.aggregatedCurrentStep,
-	})
+		AggregatedValues: aggregated,
+		Partitions:       state.partitionStats,
+		Traffic:          state.trafficList(),
+		MessageBatches:   state.batchStats,
+		FlowControl:      state.flowControlStats(),
+		Error:            state.computeErr,
+	}
+	if state.trackVertexTraffic {
+		ack.VertexMoves = vertexMoveCandidates(state.router.partitionOf, state.vertexTraffic, state.internalMessages)
+	}
+	context.Send(state.coordinatorPID, ack)
 	state.aggregatedCurrentStep = nil
+	state.batchStats = nil
+	state.releaseOutboxes()
+	state.messageDest = nil
+	state.flowStats = nil
+	state.partitionStats = nil
+	state.traffic = nil
+	state.vertexTraffic = nil
+	state.internalMessages = nil
 	state.resetAckRecorder()
 	state.behavior.Become(state.idle)
 	state.ActorUtil.LogDebug(context, "worker: compute has completed")
 }
 
+// countTraffic counts n messages sent from the local partition
+func (state *workerActor) countTraffic(src uint64, dest uint64, n uint64, remote bool) {
+	if state.traffic == nil {
+		state.traffic = make(map[partitionPair]*command.PartitionTraffic)
+	}
+	t, ok := state.traffic[partitionPair{src: src, dest: dest}]
+	if !ok {
+		t = &command.PartitionTraffic{SrcPartition: src, DestPartition: dest}
+		state.traffic[partitionPair{src: src, dest: dest}] = t
+	}
+	if remote {
+		t.RemoteMessages += n
+	} else {
+		t.LocalMessages += n
+	}
+}
+
+// countVertexTraffic counts a message sent from the local vertex to another partition
+func (state *workerActor) countVertexTraffic(src plugin.VertexID, dest uint64) {
+	if state.vertexTraffic == nil {
+		state.vertexTraffic = make(map[plugin.VertexID]map[uint64]uint64)
+	}
+	sent, ok := state.vertexTraffic[src]
+	if !ok {
+		sent = make(map[uint64]uint64)
+		state.vertexTraffic[src] = sent
+	}
+	sent[dest]++
+}
+
+// trafficList returns counted traffic ordered by partitions
+func (state *workerActor) trafficList() []*command.PartitionTraffic {
+	var list []*command.PartitionTraffic
+	for _, t := range state.traffic {
+		list = append(list, t)
+	}
+	sortTraffic(list)
+	return list
+}
+
+func sortTraffic(list []*command.PartitionTraffic) {
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].SrcPartition == list[j].SrcPartition {
+			return list[i].DestPartition < list[j].DestPartition
+		}
+		return list[i].SrcPartition < list[j].SrcPartition
+	})
+}
+
 // newSuperStepMsgBuf creates a new super step message buffer instance
 func newSuperStepMsgBuf(plg plugin.Plugin) *superStepMsgBuf {
 	return &superStepMsgBuf{
-		buf:    make(map[plugin.VertexID][]*command.SuperStepMessage),
+		buf:    make(map[vertexKey][]*command.SuperStepMessage),
 		plugin: plg,
 	}
 }
 
 func (buf *superStepMsgBuf) clear() {
-	buf.buf = make(map[plugin.VertexID][]*command.SuperStepMessage)
+	buf.buf = make(map[vertexKey][]*command.SuperStepMessage)
+	buf.removeRuns()
+	if buf.bytes > 0 {
+		buf.spill.release(buf.bytes)
+		buf.bytes = 0
+	}
 }
 
 func (buf *superStepMsgBuf) numOfMessage() int {
-	l := 0
+	l := buf.spilled
 	for _, s := range buf.buf {
 		l += len(s)
 	}
 	return l
 }
 
-func (buf *superStepMsgBuf) add(m *command.SuperStepMessage) {
-	buf.buf[plugin.VertexID(m.DestVertexId)] = append(buf.buf[plugin.VertexID(m.DestVertexId)], m)
+// add buffers the message, the buffer is spilled to disk if messages exceed the memory limit
+func (buf *superStepMsgBuf) add(m *command.SuperStepMessage) error {
+	dest := destKeyOf(m)
+	buf.buf[dest] = append(buf.buf[dest], m)
+	if !buf.spill.enabled() {
+		return nil
+	}
+	n := m.Size()
+	buf.bytes += n
+	if buf.spill.reserve(n) && buf.bytes >= buf.spill.chunk() {
+		return buf.spillRun()
+	}
+	return nil
 }
 
-func (buf *superStepMsgBuf) remove(ack *command.SuperStepMessageAck) {
-	for vid, msgs := range buf.buf {
-		for i, m := range msgs {
-			if m.Uuid == ack.Uuid {
-				removed := append(msgs[:i], msgs[i+1:]...)
-				if len(removed) == 0 {
-					delete(buf.buf, vid)
-				} else {
-					buf.buf[vid] = removed
-				}
-				return
+// forEach calls fn with messages of each destination, spilled messages are read back in order of destinations
+func (buf *superStepMsgBuf) forEach(fn func(dest vertexKey, msgs []*command.SuperStepMessage) error) error {
+	if len(buf.runs) == 0 {
+		for dest, msgs := range buf.buf {
+			if err := fn(dest, msgs); err != nil {
+				return err
 			}
 		}
+		return nil
+	}
+	if err := buf.spillRun(); err != nil {
+		return err
 	}
+	return buf.mergeRuns(fn)
 }
 
 func (buf *superStepMsgBuf) combine() error {
-	combiner := buf.plugin.GetCombiner()
-	if combiner == nil {
-		return nil
-	}
-
 	for dest, ssMsgs := range buf.buf {
-		if len(ssMsgs) <= 1 {
-			continue
+		combined, err := buf.combineMessages(dest, ssMsgs)
+		if err != nil {
+			return err
 		}
+		buf.buf[dest] = combined
+	}
+	return nil
+}
 
-		var msgs []plugin.Message
-		for _, ss := range ssMsgs {
-			m, err := buf.plugin.UnmarshalMessage(ss.Message)
-			if err != nil {
-				return errors.Wrapf(err, "failed to unmarshal message: %#v", ss)
-			}
-			msgs = append(msgs, m)
-		}
+// combineMessages combines messages to the destination by the combiner of the plugin if any
+func (buf *superStepMsgBuf) combineMessages(dest vertexKey, ssMsgs []*command.SuperStepMessage) ([]*command.SuperStepMessage, error) {
+	combiner := buf.plugin.GetCombiner()
+	if combiner == nil || len(ssMsgs) <= 1 {
+		return ssMsgs, nil
+	}
 
-		combined, err := combiner(dest, msgs)
+	var msgs []plugin.Message
+	for _, ss := range ssMsgs {
+		m, err := buf.plugin.UnmarshalMessage(ss.Message)
 		if err != nil {
-			return errors.Wrapf(err, "failed to combine message: dest=%v", dest)
+			return nil, errors.Wrapf(err, "failed to unmarshal message: %#v", ss)
 		}
+		msgs = append(msgs, m)
+	}
 
-		var newMsgs []*command.SuperStepMessage
-		for _, c := range combined {
-			pb, err := buf.plugin.MarshalMessage(c)
-			if err != nil {
-				return errors.Wrapf(err, "failed to marshal combined message: %#v", c)
-			}
-			newMsgs = append(newMsgs, &command.SuperStepMessage{
-				Uuid:         uuid.New().String(),
-				SuperStep:    ssMsgs[0].SuperStep,
-				SrcVertexId:  "",
-				DestVertexId: string(dest),
-				Message:      pb,
-			})
-		}
+	combined, err := combiner(dest.vertexID(buf.numericIDs), msgs)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to combine message: dest=%v", dest)
+	}
 
-		buf.buf[dest] = newMsgs
+	var newMsgs []*command.SuperStepMessage
+	for _, c := range combined {
+		pb, err := buf.plugin.MarshalMessage(c)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal combined message: %#v", c)
+		}
+		// The combined messages may come from different vertices, the source is of the first one.
+		// The receiver only looks up the partition of the source to tell the worker it comes from and to count traffic,
+		// every message in the buffer is sent by a vertex of this worker, so any of them is as good. Compute() never sees it.
+		newMsgs = append(newMsgs, &command.SuperStepMessage{
+			SuperStep:     ssMsgs[0].SuperStep,
+			SrcVertexId:   ssMsgs[0].SrcVertexId,
+			SrcNumericId:  ssMsgs[0].SrcNumericId,
+			DestVertexId:  string(dest.id),
+			DestNumericId: dest.num,
+			Message:       pb,
+		})
 	}
-	return nil
+	return newMsgs, nil
 }
@@ -3,7 +3,6 @@ package worker
 import (
 	"fmt"
 	"strconv"
-	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -19,24 +18,24 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 )
 
-func Test_superStepMsgBuf_add_remove(t *testing.T) {
+func Test_superStepMsgBuf_add_clear(t *testing.T) {
 	buf := newSuperStepMsgBuf(nil)
 
 	// add
 	m1 := &command.SuperStepMessage{
-		Uuid:         "uuid1",
+		Seq:          1,
 		SrcVertexId:  "s1",
 		DestVertexId: "d1",
 		Message:      anyOf("m1"),
 	}
 	m2 := &command.SuperStepMessage{
-		Uuid:         "uuid2",
+		Seq:          2,
 		SrcVertexId:  "s2",
 		DestVertexId: "d1",
 		Message:      anyOf("m2"),
 	}
 	m3 := &command.SuperStepMessage{
-		Uuid:         "uuid3",
+		Seq:          3,
 		SrcVertexId:  "s3",
 		DestVertexId: "d2",
 		Message:      anyOf("m3"),
@@ -46,9 +45,9 @@ func Test_superStepMsgBuf_add_remove(t *testing.T) {
 	buf.add(m1)
 	buf.add(m2)
 	buf.add(m3)
-	expected := map[plugin.VertexID][]*command.SuperStepMessage{
-		plugin.VertexID("d1"): {m1, m2},
-		plugin.VertexID("d2"): {m3},
+	expected := map[vertexKey][]*command.SuperStepMessage{
+		{id: "d1"}: {m1, m2},
+		{id: "d2"}: {m3},
 	}
 	if diff := cmp.Diff(expected, buf.buf); diff != "" {
 		t.Fatalf("not match: %s", diff)
@@ -57,43 +56,51 @@ func Test_superStepMsgBuf_add_remove(t *testing.T) {
 		t.Fatal("unexpected number")
 	}
 
-	// remove 1
-	buf.remove(&command.SuperStepMessageAck{
-		Uuid: "uuid2",
-	})
-	expected = map[plugin.VertexID][]*command.SuperStepMessage{
-		plugin.VertexID("d1"): {m1},
-		plugin.VertexID("d2"): {m3},
-	}
+	// Clear
+	buf.clear()
+	expected = map[vertexKey][]*command.SuperStepMessage{}
 	if diff := cmp.Diff(expected, buf.buf); diff != "" {
 		t.Fatalf("not match: %s", diff)
 	}
-	if buf.numOfMessage() != 2 {
+	if buf.numOfMessage() != 0 {
 		t.Fatal("unexpected number")
 	}
+}
 
-	// remove 2
-	buf.remove(&command.SuperStepMessageAck{
-		Uuid: "uuid1",
+func Test_superStepMsgBuf_combine_numericIDs(t *testing.T) {
+	buf := newSuperStepMsgBuf(&MockedPlugin{
+		MarshalMessageMock: func(msg plugin.Message) (*types.Any, error) {
+			return anyOf(msg.(string)), nil
+		},
+		UnmarshalMessageMock: func(a *types.Any) (plugin.Message, error) {
+			return string(a.Value), nil
+		},
+		GetCombinerMock: func() func(plugin.VertexID, []plugin.Message) ([]plugin.Message, error) {
+			return func(id plugin.VertexID, msgs []plugin.Message) ([]plugin.Message, error) {
+				return msgs[len(msgs)-1:], nil
+			}
+		},
 	})
-	expected = map[plugin.VertexID][]*command.SuperStepMessage{
-		plugin.VertexID("d2"): {m3},
-	}
-	if diff := cmp.Diff(expected, buf.buf); diff != "" {
-		t.Fatalf("not match: %s", diff)
+	buf.numericIDs = true
+	for i, src := range []uint64{11, 12, 13} {
+		if err := buf.add(&command.SuperStepMessage{
+			Seq:           uint64(i + 1),
+			SrcNumericId:  src,
+			DestNumericId: 7,
+			Message:       anyOf(fmt.Sprintf("m%d", i)),
+		}); err != nil {
+			t.Fatal(err)
+		}
 	}
-	if buf.numOfMessage() != 1 {
-		t.Fatal("unexpected number")
+	if err := buf.combine(); err != nil {
+		t.Fatal(err)
 	}
 
-	// Clear
-	buf.clear()
-	expected = map[plugin.VertexID][]*command.SuperStepMessage{}
-	if diff := cmp.Diff(expected, buf.buf); diff != "" {
-		t.Fatalf("not match: %s", diff)
-	}
-	if buf.numOfMessage() != 0 {
-		t.Fatal("unexpected number")
+	// the combined message takes the source of the first message so that the receiver can find the worker it comes from
+	if diff := cmp.Diff(map[vertexKey][]*command.SuperStepMessage{
+		{num: 7}: {{SrcNumericId: 11, DestNumericId: 7, Message: anyOf("m2")}},
+	}, buf.buf); diff != "" {
+		t.Fatalf("unexpected combined messages: %s", diff)
 	}
 }
 
@@ -120,37 +127,37 @@ func Test_superStepMsgBuf_combine(t *testing.T) {
 	})
 
 	m1 := &command.SuperStepMessage{
-		Uuid:         "uuid1",
+		Seq:          1,
 		SrcVertexId:  "s1",
 		DestVertexId: "d1",
 		Message:      anyOf("m1"),
 	}
 	m2 := &command.SuperStepMessage{
-		Uuid:         "uuid2",
+		Seq:          2,
 		SrcVertexId:  "s2",
 		DestVertexId: "d1",
 		Message:      anyOf("m2_middle"),
 	}
 	m3 := &command.SuperStepMessage{
-		Uuid:         "uuid3",
+		Seq:          3,
 		SrcVertexId:  "s2",
 		DestVertexId: "d1",
 		Message:      anyOf("m2_looooooooooooooooong"),
 	}
 	m4 := &command.SuperStepMessage{
-		Uuid:         "uuid4",
+		Seq:          4,
 		SrcVertexId:  "s3",
 		DestVertexId: "d2",
 		Message:      anyOf("m4_long"),
 	}
 	m5 := &command.SuperStepMessage{
-		Uuid:         "uuid5",
+		Seq:          5,
 		SrcVertexId:  "s3",
 		DestVertexId: "d2",
 		Message:      anyOf("m5"),
 	}
 	m6 := &command.SuperStepMessage{
-		Uuid:         "uuid6",
+		Seq:          6,
 		SrcVertexId:  "s1",
 		DestVertexId: "d3",
 		Message:      anyOf("m6"),
@@ -166,20 +173,19 @@ func Test_superStepMsgBuf_combine(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	expected := map[plugin.VertexID][]*command.SuperStepMessage{
-		plugin.VertexID("d1"): {&command.SuperStepMessage{
-			Uuid:         "",
-			SrcVertexId:  "",
+	expected := map[vertexKey][]*command.SuperStepMessage{
+		// combined messages take the source of the first message
+		{id: "d1"}: {&command.SuperStepMessage{
+			SrcVertexId:  "s1",
 			DestVertexId: "d1",
 			Message:      anyOf("m2_looooooooooooooooong"),
 		}},
-		plugin.VertexID("d2"): {&command.SuperStepMessage{
-			Uuid:         "",
-			SrcVertexId:  "",
+		{id: "d2"}: {&command.SuperStepMessage{
+			SrcVertexId:  "s3",
 			DestVertexId: "d2",
 			Message:      anyOf("m4_long"),
 		}},
-		plugin.VertexID("d3"): {m6},
+		{id: "d3"}: {m6},
 	}
 
 	if len(expected) != len(buf.buf) {
@@ -235,6 +241,9 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 		UnmarshalMessageMock: func(a *types.Any) (plugin.Message, error) {
 			return a, nil
 		},
+		GetAggregatorsMock: func() []plugin.Aggregator {
+			return nil
+		},
 		GetCombinerMock: func() func(plugin.VertexID, []plugin.Message) ([]plugin.Message, error) {
 			return func(id plugin.VertexID, messages []plugin.Message) ([]plugin.Message, error) {
 				return messages, nil
@@ -251,11 +260,11 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 		case *command.SuperStepBarrier:
 			i := atomic.AddInt32(&called, 1)
 			c.Send(c.Parent(), &command.SuperStepBarrierPartitionAck{PartitionId: partitions[i-1]})
-		case *command.Compute:
+		case *computeLocal:
 			i := atomic.AddInt32(&called, 1)
 			// internal message
 			c.Request(c.Parent(), &command.SuperStepMessage{
-				Uuid:         fmt.Sprintf("uuid-internal-%v", partitions[i-1]),
+				Seq:          partitions[i-1]*10 + 1,
 				SuperStep:    cmd.SuperStep,
 				SrcVertexId:  "vertex-dummy-1",
 				DestVertexId: fmt.Sprintf("dest-internal-%v", partitions[i-1]),
@@ -263,7 +272,7 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 			})
 			// external message
 			c.Request(c.Parent(), &command.SuperStepMessage{
-				Uuid:         fmt.Sprintf("uuid-external-%v", partitions[i-1]),
+				Seq:          partitions[i-1]*10 + 2,
 				SuperStep:    cmd.SuperStep,
 				SrcVertexId:  "vertex-dummy-2",
 				DestVertexId: fmt.Sprintf("dest-external-%v", partitions[i-1]+3),
@@ -271,25 +280,23 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 			})
 		case *command.SuperStepMessage:
 			c.Respond(&command.SuperStepMessageAck{
-				Uuid: cmd.Uuid,
+				Seq: cmd.Seq,
 			})
 		case *command.SuperStepMessageAck:
-			id, err := strconv.Atoi(cmd.Uuid[len(cmd.Uuid)-1:])
-			if err != nil {
-				t.Fatal(err)
-			}
+			id := int(cmd.Seq / 10)
 			messageAckMux.Lock()
 			defer messageAckMux.Unlock()
-			switch {
-			case strings.Contains(cmd.Uuid, "uuid-internal-"):
+			switch cmd.Seq % 10 {
+			case 1: // internal
 				messageAck[id] = messageAck[id] + 1
-			case strings.Contains(cmd.Uuid, "uuid-external-"):
+			case 2: // external
 				messageAck[id] = messageAck[id] + 1
 			default:
 				t.Fatalf("unexpected ack: %#v", cmd)
 			}
 			if messageAck[id] == 2 {
-				c.Send(c.Parent(), &command.ComputePartitionAck{PartitionId: partitions[id-1]})
+				// each partition has delivered 2 messages between its vertices
+				c.Send(c.Parent(), &computePartitionAckLocal{ComputePartitionAck: &command.ComputePartitionAck{PartitionId: partitions[id-1], LocalMessages: 2}})
 			}
 		}
 	})
@@ -312,14 +319,14 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 		switch cmd := c.Message().(type) {
 		case *command.SuperStepMessage:
 			c.Respond(&command.SuperStepMessageAck{
-				Uuid: cmd.Uuid,
+				Seq: cmd.Seq,
 			})
 		case *command.SuperStepMessageAck:
 			extMessageAckCh <- cmd
 		case string:
 			// external message
 			c.Request(proxy.Underlying(), &command.SuperStepMessage{
-				Uuid:         "uuid-ext-worker",
+				Seq:          100,
 				SuperStep:    1,
 				SrcVertexId:  "src-" + cmd,
 				DestVertexId: "dest-internal-2",
@@ -363,7 +370,20 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 	messageAck = make(map[int]int)
 	proxy.Send(context, &command.Compute{SuperStep: 0})
 	t.Log("wait for complete computation 0")
-	<-computeAckCh
+	computeAck := <-computeAckCh
+	// messages are counted by source and destination partitions, including ones delivered within partitions
+	if diff := cmp.Diff([]*command.PartitionTraffic{
+		{SrcPartition: 1, DestPartition: 1, LocalMessages: 3},
+		{SrcPartition: 1, DestPartition: 2, LocalMessages: 1},
+		{SrcPartition: 1, DestPartition: 3, LocalMessages: 1},
+		{SrcPartition: 2, DestPartition: 2, LocalMessages: 2},
+		{SrcPartition: 2, DestPartition: 4, RemoteMessages: 1},
+		{SrcPartition: 2, DestPartition: 5, RemoteMessages: 1},
+		{SrcPartition: 2, DestPartition: 6, RemoteMessages: 1},
+		{SrcPartition: 3, DestPartition: 3, LocalMessages: 2},
+	}, computeAck.Traffic); diff != "" {
+		t.Fatalf("unexpected traffic: %s", diff)
+	}
 
 	// step 1
 	called = 0
@@ -379,7 +399,7 @@ func TestNewWorkerActor_routesMessages(t *testing.T) {
 	<-computeAckCh
 	t.Log("wait for message ack")
 	ack := <-extMessageAckCh
-	if ack.Uuid != "uuid-ext-worker" {
+	if ack.Seq != 100 {
 		t.Fatal("unexpected ack")
 	}
 